@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"errors"
+	"fund/service"
+	"net/http"
+	"strings"
+)
+
+// AdminHandler 管理接口处理器（需要管理员权限）
+type AdminHandler struct {
+	intradayService *service.IntradayService
+}
+
+// NewAdminHandler 创建管理接口处理器实例
+func NewAdminHandler(intradayService *service.IntradayService) *AdminHandler {
+	return &AdminHandler{
+		intradayService: intradayService,
+	}
+}
+
+// WatchConfig 监控配置接口
+// GET 获取当前监控列表和采集周期，PUT 修改配置（未提供的字段保持不变，watch_list 为空数组时切换为全量模式）
+// 修改会写回配置文件并立即生效，无需重启
+func (h *AdminHandler) WatchConfig(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+	switch r.Method {
+	case http.MethodGet:
+		responseSuccess(w, h.intradayService.GetWatchConfig())
+
+	case http.MethodPut:
+		var body service.WatchConfig
+		if !decodeJSONBody(w, r, &body) {
+			return
+		}
+		config, err := h.intradayService.UpdateWatchConfig(body)
+		if err != nil {
+			responseWatchConfigError(w, err)
+			return
+		}
+		responseSuccess(w, config)
+
+	default:
+		responseError(w, http.StatusMethodNotAllowed, "不支持的请求方法")
+	}
+}
+
+// WatchCodes 监控列表基金接口
+// POST {"codes": [...]} 添加基金，DELETE ?codes=000001,110022 移除基金
+func (h *AdminHandler) WatchCodes(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+	var (
+		config service.WatchConfig
+		err    error
+	)
+	switch r.Method {
+	case http.MethodPost:
+		body := struct {
+			Codes []string `json:"codes"`
+		}{}
+		if !decodeJSONBody(w, r, &body) {
+			return
+		}
+		config, err = h.intradayService.AddWatchCodes(body.Codes)
+
+	case http.MethodDelete:
+		var codes []string
+		for _, code := range strings.Split(r.URL.Query().Get("codes"), ",") {
+			if code = strings.TrimSpace(code); code != "" {
+				codes = append(codes, code)
+			}
+		}
+		config, err = h.intradayService.RemoveWatchCodes(codes)
+
+	default:
+		responseError(w, http.StatusMethodNotAllowed, "不支持的请求方法")
+		return
+	}
+
+	if err != nil {
+		responseWatchConfigError(w, err)
+		return
+	}
+	responseSuccess(w, config)
+}
+
+// responseWatchConfigError 将监控配置错误映射为 HTTP 状态码
+func responseWatchConfigError(w http.ResponseWriter, err error) {
+	if errors.Is(err, service.ErrInvalidWatchConfig) {
+		responseError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	responseError(w, http.StatusInternalServerError, err.Error())
+}
@@ -0,0 +1,176 @@
+package handler
+
+import (
+	"errors"
+	"fund/model"
+	"fund/service"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// AlertHandler 提醒处理器（需要认证）
+type AlertHandler struct {
+	alertService *service.AlertService
+}
+
+// NewAlertHandler 创建提醒处理器实例
+func NewAlertHandler(alertService *service.AlertService) *AlertHandler {
+	return &AlertHandler{
+		alertService: alertService,
+	}
+}
+
+// alertRuleBody 新建、修改规则请求体，enabled 省略时为启用
+type alertRuleBody struct {
+	Code      string  `json:"code"`
+	Type      string  `json:"type"`
+	Threshold float64 `json:"threshold"`
+	Enabled   *bool   `json:"enabled"`
+	Note      string  `json:"note"`
+}
+
+// rule 转换为规则
+func (b alertRuleBody) rule() model.AlertRule {
+	return model.AlertRule{
+		Code:      b.Code,
+		Type:      b.Type,
+		Threshold: b.Threshold,
+		Enabled:   b.Enabled == nil || *b.Enabled,
+		Note:      b.Note,
+	}
+}
+
+// GetAlerts 已触发的提醒列表，GET ?date=YYYY-MM-DD&limit=100（最新的在前）
+func (h *AlertHandler) GetAlerts(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	user, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+	if r.Method != http.MethodGet {
+		responseError(w, http.StatusMethodNotAllowed, "不支持的请求方法")
+		return
+	}
+
+	query := r.URL.Query()
+	date := query.Get("date")
+	if date != "" {
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			responseError(w, http.StatusBadRequest, "日期格式错误,应为 YYYY-MM-DD")
+			return
+		}
+	}
+	limit := 0
+	if value := query.Get("limit"); value != "" {
+		var err error
+		if limit, err = strconv.Atoi(value); err != nil || limit <= 0 || limit > 1000 {
+			responseError(w, http.StatusBadRequest, "limit 应为 1-1000")
+			return
+		}
+	}
+
+	alerts, err := h.alertService.ListAlerts(user.ID, date, limit)
+	if err != nil {
+		responseError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	responseSuccess(w, alerts)
+}
+
+// Rules 提醒规则接口
+// GET 获取全部规则（提供 id 时只返回该规则），POST 新建规则，PUT ?id= 修改规则，DELETE ?id= 删除规则
+func (h *AlertHandler) Rules(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	user, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if r.URL.Query().Get("id") == "" {
+			rules, err := h.alertService.ListRules(user.ID)
+			if err != nil {
+				responseError(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+			responseSuccess(w, rules)
+			return
+		}
+		id, ok := parseRuleID(w, r)
+		if !ok {
+			return
+		}
+		rule, err := h.alertService.GetRule(user.ID, id)
+		if err != nil {
+			responseAlertError(w, err)
+			return
+		}
+		responseSuccess(w, rule)
+
+	case http.MethodPost:
+		var body alertRuleBody
+		if !decodeJSONBody(w, r, &body) {
+			return
+		}
+		rule, err := h.alertService.CreateRule(user.ID, body.rule())
+		if err != nil {
+			responseAlertError(w, err)
+			return
+		}
+		responseSuccess(w, rule)
+
+	case http.MethodPut:
+		id, ok := parseRuleID(w, r)
+		if !ok {
+			return
+		}
+		var body alertRuleBody
+		if !decodeJSONBody(w, r, &body) {
+			return
+		}
+		rule, err := h.alertService.UpdateRule(user.ID, id, body.rule())
+		if err != nil {
+			responseAlertError(w, err)
+			return
+		}
+		responseSuccess(w, rule)
+
+	case http.MethodDelete:
+		id, ok := parseRuleID(w, r)
+		if !ok {
+			return
+		}
+		if err := h.alertService.DeleteRule(user.ID, id); err != nil {
+			responseAlertError(w, err)
+			return
+		}
+		responseSuccess(w, map[string]interface{}{"id": id, "status": "deleted"})
+
+	default:
+		responseError(w, http.StatusMethodNotAllowed, "不支持的请求方法")
+	}
+}
+
+// parseRuleID 读取规则 id 参数，无效时写入 400 响应并返回 false
+func parseRuleID(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	id, err := strconv.ParseInt(r.URL.Query().Get("id"), 10, 64)
+	if err != nil || id <= 0 {
+		responseError(w, http.StatusBadRequest, "请提供规则 id")
+		return 0, false
+	}
+	return id, true
+}
+
+// responseAlertError 将提醒服务错误映射为 HTTP 状态码
+func responseAlertError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrAlertRuleNotFound):
+		responseError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, service.ErrInvalidAlertRule):
+		responseError(w, http.StatusBadRequest, err.Error())
+	default:
+		responseError(w, http.StatusInternalServerError, err.Error())
+	}
+}
@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"errors"
+	"fund/middleware"
+	"fund/model"
+	"fund/service"
+	"net/http"
+	"strconv"
+)
+
+// AuthHandler 用户和访问令牌处理器
+type AuthHandler struct {
+	userService *service.UserService
+}
+
+// NewAuthHandler 创建用户处理器实例
+func NewAuthHandler(userService *service.UserService) *AuthHandler {
+	return &AuthHandler{
+		userService: userService,
+	}
+}
+
+// credentials 注册、登录请求体
+type credentials struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// Register 用户注册接口
+func (h *AuthHandler) Register(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	if r.Method != http.MethodPost {
+		responseError(w, http.StatusMethodNotAllowed, "不支持的请求方法")
+		return
+	}
+
+	var body credentials
+	if !decodeJSONBody(w, r, &body) {
+		return
+	}
+	user, err := h.userService.Register(body.Username, body.Password)
+	if err != nil {
+		responseUserError(w, err)
+		return
+	}
+	responseSuccess(w, user)
+}
+
+// Login 用户登录接口，返回登录令牌（不限制登录失败次数，公网部署时应在反向代理上限流）
+func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	if r.Method != http.MethodPost {
+		responseError(w, http.StatusMethodNotAllowed, "不支持的请求方法")
+		return
+	}
+
+	var body credentials
+	if !decodeJSONBody(w, r, &body) {
+		return
+	}
+	user, plain, token, err := h.userService.Login(body.Username, body.Password)
+	if err != nil {
+		responseUserError(w, err)
+		return
+	}
+	responseSuccess(w, map[string]interface{}{
+		"user":      user,
+		"token":     plain,
+		"expiresAt": token.ExpiresAt,
+	})
+}
+
+// Me 当前用户接口
+func (h *AuthHandler) Me(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	user, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+	responseSuccess(w, user)
+}
+
+// Tokens 访问令牌接口
+// GET 获取当前用户的令牌列表，POST 创建长期有效的 API 令牌（明文只返回一次），DELETE 按 id 吊销令牌
+func (h *AuthHandler) Tokens(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	user, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		tokens, err := h.userService.ListTokens(user.ID)
+		if err != nil {
+			responseError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		responseSuccess(w, tokens)
+
+	case http.MethodPost:
+		var body struct {
+			Name string `json:"name"`
+		}
+		if !decodeJSONBody(w, r, &body) {
+			return
+		}
+		plain, token, err := h.userService.CreateToken(user.ID, body.Name)
+		if err != nil {
+			responseUserError(w, err)
+			return
+		}
+		responseSuccess(w, map[string]interface{}{
+			"token": plain,
+			"info":  token,
+		})
+
+	case http.MethodDelete:
+		id, err := strconv.ParseInt(r.URL.Query().Get("id"), 10, 64)
+		if err != nil || id <= 0 {
+			responseError(w, http.StatusBadRequest, "请提供令牌 id")
+			return
+		}
+		if err := h.userService.RevokeToken(user.ID, id); err != nil {
+			responseUserError(w, err)
+			return
+		}
+		responseSuccess(w, map[string]interface{}{"id": id, "status": "revoked"})
+
+	default:
+		responseError(w, http.StatusMethodNotAllowed, "不支持的请求方法")
+	}
+}
+
+// currentUser 获取认证中间件写入的当前用户，未认证时写入 401 响应并返回 false
+func currentUser(w http.ResponseWriter, r *http.Request) (*model.User, bool) {
+	user := middleware.UserFromContext(r.Context())
+	if user == nil {
+		responseError(w, http.StatusUnauthorized, "请先登录")
+		return nil, false
+	}
+	return user, true
+}
+
+// responseUserError 将用户服务错误映射为 HTTP 状态码
+func responseUserError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrInvalidUser):
+		responseError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, service.ErrInvalidCredentials):
+		responseError(w, http.StatusUnauthorized, err.Error())
+	case errors.Is(err, service.ErrRegistrationClosed):
+		responseError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, service.ErrTokenNotFound):
+		responseError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, service.ErrUserExists):
+		responseError(w, http.StatusConflict, err.Error())
+	default:
+		responseError(w, http.StatusInternalServerError, err.Error())
+	}
+}
@@ -2,17 +2,30 @@ package handler
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"fund/service"
 	"net/http"
+	"net/url"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
 )
 
+// maxRequestBody JSON 请求体大小上限
+const maxRequestBody = 1 << 20
+
+const (
+	defaultStreamHeartbeat = 15 * time.Second // 推送连接默认心跳间隔
+	streamRetry            = 3000             // 建议客户端断线重连等待时间（毫秒）
+)
+
 // FundHandler 基金处理器
 type FundHandler struct {
 	fundService     *service.FundService
 	intradayService *service.IntradayService
+	streamHeartbeat time.Duration // 推送连接心跳间隔
 }
 
 // NewFundHandler 创建基金处理器实例
@@ -20,9 +33,15 @@ func NewFundHandler(fundService *service.FundService, intradayService *service.I
 	return &FundHandler{
 		fundService:     fundService,
 		intradayService: intradayService,
+		streamHeartbeat: defaultStreamHeartbeat,
 	}
 }
 
+// SetStreamHeartbeat 设置推送连接的心跳间隔（防止代理因空闲断开连接）
+func (h *FundHandler) SetStreamHeartbeat(interval time.Duration) {
+	h.streamHeartbeat = interval
+}
+
 // GetFundDetail 获取基金详情接口
 func (h *FundHandler) GetFundDetail(w http.ResponseWriter, r *http.Request) {
 	// 设置响应头
@@ -31,25 +50,25 @@ func (h *FundHandler) GetFundDetail(w http.ResponseWriter, r *http.Request) {
 	// 获取基金代码参数
 	fundCode := r.URL.Query().Get("code")
 	if fundCode == "" {
-		h.responseError(w, http.StatusBadRequest, "请提供基金代码参数 code")
+		responseError(w, http.StatusBadRequest, "请提供基金代码参数 code")
 		return
 	}
 
 	// 验证基金代码格式(6位数字)
-	if !h.isValidFundCode(fundCode) {
-		h.responseError(w, http.StatusBadRequest, "基金代码格式错误,应为6位数字")
+	if !isValidFundCode(fundCode) {
+		responseError(w, http.StatusBadRequest, "基金代码格式错误,应为6位数字")
 		return
 	}
 
 	// 获取基金详情
 	fundDetail, err := h.fundService.GetFundDetail(fundCode)
 	if err != nil {
-		h.responseError(w, http.StatusInternalServerError, err.Error())
+		responseError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
 
 	// 返回成功响应
-	h.responseSuccess(w, fundDetail)
+	responseSuccess(w, fundDetail)
 }
 
 // GetFundTrend 获取基金走势接口
@@ -60,46 +79,298 @@ func (h *FundHandler) GetFundTrend(w http.ResponseWriter, r *http.Request) {
 	// 获取基金代码参数
 	fundCode := r.URL.Query().Get("code")
 	if fundCode == "" {
-		h.responseError(w, http.StatusBadRequest, "请提供基金代码参数 code")
+		responseError(w, http.StatusBadRequest, "请提供基金代码参数 code")
 		return
 	}
 
 	// 验证基金代码格式
-	if !h.isValidFundCode(fundCode) {
-		h.responseError(w, http.StatusBadRequest, "基金代码格式错误,应为6位数字")
+	if !isValidFundCode(fundCode) {
+		responseError(w, http.StatusBadRequest, "基金代码格式错误,应为6位数字")
 		return
 	}
 
-	// 获取周期参数
-	period := r.URL.Query().Get("period")
-	if period == "" {
-		period = "month" // 默认一个月
+	// 获取查询区间参数
+	trendRange, err := h.parseTrendRange(r, "month")
+	if err != nil {
+		responseError(w, http.StatusBadRequest, err.Error())
+		return
 	}
 
-	// 验证周期参数
-	validPeriods := map[string]bool{
-		"week":        true,
-		"month":       true,
-		"quarter":     true,
-		"half_year":   true,
-		"year":        true,
-		"three_years": true,
-		"all":         true,
+	// 获取基金走势
+	fundTrend, err := h.fundService.GetFundTrendInRange(fundCode, trendRange)
+	if err != nil {
+		responseError(w, http.StatusInternalServerError, err.Error())
+		return
 	}
-	if !validPeriods[period] {
-		h.responseError(w, http.StatusBadRequest, "周期参数无效,可选值: week/month/quarter/half_year/year/three_years/all")
+
+	// 返回成功响应
+	responseSuccess(w, fundTrend)
+}
+
+// GetFundAnalytics 获取基金业绩与风险指标接口
+func (h *FundHandler) GetFundAnalytics(w http.ResponseWriter, r *http.Request) {
+	// 设置响应头
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+	// 获取基金代码参数
+	fundCode := r.URL.Query().Get("code")
+	if fundCode == "" {
+		responseError(w, http.StatusBadRequest, "请提供基金代码参数 code")
 		return
 	}
 
-	// 获取基金走势
-	fundTrend, err := h.fundService.GetFundTrend(fundCode, period)
+	// 验证基金代码格式
+	if !isValidFundCode(fundCode) {
+		responseError(w, http.StatusBadRequest, "基金代码格式错误,应为6位数字")
+		return
+	}
+
+	// 获取查询区间参数（默认最近一年）
+	trendRange, err := h.parseTrendRange(r, "year")
+	if err != nil {
+		responseError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 计算业绩与风险指标
+	analytics, err := h.fundService.GetFundAnalytics(fundCode, trendRange)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, service.ErrInsufficientData) {
+			status = http.StatusBadRequest
+		}
+		responseError(w, status, err.Error())
+		return
+	}
+
+	// 返回成功响应
+	responseSuccess(w, analytics)
+}
+
+// GetFundBenchmark 基金与业绩比较基准对比接口（超额收益、跟踪误差、阿尔法、贝塔、信息比率）
+func (h *FundHandler) GetFundBenchmark(w http.ResponseWriter, r *http.Request) {
+	// 设置响应头
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+	// 获取基金代码参数
+	fundCode := r.URL.Query().Get("code")
+	if fundCode == "" {
+		responseError(w, http.StatusBadRequest, "请提供基金代码参数 code")
+		return
+	}
+
+	// 验证基金代码格式
+	if !isValidFundCode(fundCode) {
+		responseError(w, http.StatusBadRequest, "基金代码格式错误,应为6位数字")
+		return
+	}
+
+	// 获取查询区间参数（默认最近一年）
+	trendRange, err := h.parseTrendRange(r, "year")
+	if err != nil {
+		responseError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 对比业绩比较基准
+	benchmark, err := h.fundService.GetFundBenchmark(fundCode, trendRange)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, service.ErrInsufficientData) {
+			status = http.StatusBadRequest
+		}
+		responseError(w, status, err.Error())
+		return
+	}
+
+	// 返回成功响应
+	responseSuccess(w, benchmark)
+}
+
+// Backtest 定投回测接口
+// 参数: code、start（必填）、end、amount（每期金额）、frequency（weekly/biweekly/monthly）、day（周几或几号）、fee（申购费率%）
+func (h *FundHandler) Backtest(w http.ResponseWriter, r *http.Request) {
+	// 设置响应头
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+	query := r.URL.Query()
+
+	// 获取基金代码参数
+	fundCode := query.Get("code")
+	if fundCode == "" {
+		responseError(w, http.StatusBadRequest, "请提供基金代码参数 code")
+		return
+	}
+
+	// 验证基金代码格式
+	if !isValidFundCode(fundCode) {
+		responseError(w, http.StatusBadRequest, "基金代码格式错误,应为6位数字")
+		return
+	}
+
+	plan, err := h.parseBacktestPlan(query)
+	if err != nil {
+		responseError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 回测
+	result, err := h.fundService.Backtest(fundCode, plan)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, service.ErrInsufficientData) {
+			status = http.StatusBadRequest
+		}
+		responseError(w, status, err.Error())
+		return
+	}
+
+	// 返回成功响应
+	responseSuccess(w, result)
+}
+
+// parseBacktestPlan 解析并验证定投计划参数
+func (h *FundHandler) parseBacktestPlan(query url.Values) (service.BacktestPlan, error) {
+	plan := service.BacktestPlan{
+		Start:     query.Get("start"),
+		End:       query.Get("end"),
+		Frequency: query.Get("frequency"),
+		Amount:    1000,
+	}
+
+	// 验证日期参数
+	if plan.Start == "" {
+		return plan, fmt.Errorf("请提供开始日期 start")
+	}
+	for name, date := range map[string]string{"start": plan.Start, "end": plan.End} {
+		if date == "" {
+			continue
+		}
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			return plan, fmt.Errorf("%s 日期格式错误,应为 YYYY-MM-DD", name)
+		}
+	}
+	if plan.End != "" && plan.Start > plan.End {
+		return plan, fmt.Errorf("开始日期不能晚于结束日期")
+	}
+
+	// 验证金额和费率
+	if amountStr := query.Get("amount"); amountStr != "" {
+		amount, err := strconv.ParseFloat(amountStr, 64)
+		if err != nil || amount <= 0 || amount > 1e8 {
+			return plan, fmt.Errorf("定投金额无效,应为 0~100000000 之间的数字")
+		}
+		plan.Amount = amount
+	}
+	if feeStr := query.Get("fee"); feeStr != "" {
+		fee, err := strconv.ParseFloat(feeStr, 64)
+		if err != nil || fee < 0 || fee > 5 {
+			return plan, fmt.Errorf("申购费率无效,应为 0~5 之间的百分比")
+		}
+		plan.FeeRate = fee
+	}
+
+	// 验证定投频率和日期
+	if plan.Frequency == "" {
+		plan.Frequency = "monthly"
+	}
+	maxDay := 31
+	switch plan.Frequency {
+	case "weekly", "biweekly":
+		maxDay = 5
+	case "monthly":
+	default:
+		return plan, fmt.Errorf("定投频率无效,可选值: weekly/biweekly/monthly")
+	}
+	plan.Day = 1
+	if dayStr := query.Get("day"); dayStr != "" {
+		day, err := strconv.Atoi(dayStr)
+		if err != nil || day < 1 || day > maxDay {
+			return plan, fmt.Errorf("定投日无效,%s 应为 1~%d", plan.Frequency, maxDay)
+		}
+		plan.Day = day
+	}
+
+	return plan, nil
+}
+
+// maxCompareFunds 单次对比的基金数量上限
+const maxCompareFunds = 10
+
+// CompareFunds 多基金对比接口
+func (h *FundHandler) CompareFunds(w http.ResponseWriter, r *http.Request) {
+	// 设置响应头
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+	// 获取基金代码列表（逗号分隔，重复的代码只保留一个）
+	var fundCodes []string
+	seen := make(map[string]bool)
+	for _, code := range strings.Split(r.URL.Query().Get("codes"), ",") {
+		code = strings.TrimSpace(code)
+		if code == "" || seen[code] {
+			continue
+		}
+		if !isValidFundCode(code) {
+			responseError(w, http.StatusBadRequest, fmt.Sprintf("基金代码格式错误,应为6位数字: %s", code))
+			return
+		}
+		seen[code] = true
+		fundCodes = append(fundCodes, code)
+	}
+	if len(fundCodes) < 2 || len(fundCodes) > maxCompareFunds {
+		responseError(w, http.StatusBadRequest, fmt.Sprintf("请提供 2~%d 个基金代码参数 codes（逗号分隔）", maxCompareFunds))
+		return
+	}
+
+	// 获取查询区间参数（默认最近一年）
+	trendRange, err := h.parseTrendRange(r, "year")
+	if err != nil {
+		responseError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 对比基金走势
+	comparison, err := h.fundService.CompareFunds(fundCodes, trendRange)
 	if err != nil {
-		h.responseError(w, http.StatusInternalServerError, err.Error())
+		status := http.StatusInternalServerError
+		if errors.Is(err, service.ErrInsufficientData) {
+			status = http.StatusBadRequest
+		}
+		responseError(w, status, err.Error())
 		return
 	}
 
 	// 返回成功响应
-	h.responseSuccess(w, fundTrend)
+	responseSuccess(w, comparison)
+}
+
+// GetFundProfile 获取基金档案接口（持仓、资产配置、规模、基金经理、费率）
+func (h *FundHandler) GetFundProfile(w http.ResponseWriter, r *http.Request) {
+	// 设置响应头
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+	// 获取基金代码参数
+	fundCode := r.URL.Query().Get("code")
+	if fundCode == "" {
+		responseError(w, http.StatusBadRequest, "请提供基金代码参数 code")
+		return
+	}
+
+	// 验证基金代码格式
+	if !isValidFundCode(fundCode) {
+		responseError(w, http.StatusBadRequest, "基金代码格式错误,应为6位数字")
+		return
+	}
+
+	// 获取基金档案
+	profile, err := h.fundService.GetFundProfile(fundCode)
+	if err != nil {
+		responseError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// 返回成功响应
+	responseSuccess(w, profile)
 }
 
 // GetIntradayData 获取基金日内实时数据接口
@@ -110,25 +381,119 @@ func (h *FundHandler) GetIntradayData(w http.ResponseWriter, r *http.Request) {
 	// 获取基金代码参数
 	fundCode := r.URL.Query().Get("code")
 	if fundCode == "" {
-		h.responseError(w, http.StatusBadRequest, "请提供基金代码参数 code")
+		responseError(w, http.StatusBadRequest, "请提供基金代码参数 code")
 		return
 	}
 
 	// 验证基金代码格式
-	if !h.isValidFundCode(fundCode) {
-		h.responseError(w, http.StatusBadRequest, "基金代码格式错误,应为6位数字")
+	if !isValidFundCode(fundCode) {
+		responseError(w, http.StatusBadRequest, "基金代码格式错误,应为6位数字")
 		return
 	}
 
+	// 获取日期参数（为空时返回当日数据）
+	date := r.URL.Query().Get("date")
+	if date != "" {
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			responseError(w, http.StatusBadRequest, "日期格式错误,应为 YYYY-MM-DD")
+			return
+		}
+	}
+
 	// 获取日内数据
-	intradayData, err := h.intradayService.GetIntradayData(fundCode)
+	intradayData, err := h.intradayService.GetIntradayDataByDate(fundCode, date)
 	if err != nil {
-		h.responseError(w, http.StatusNotFound, err.Error())
+		responseError(w, http.StatusNotFound, err.Error())
 		return
 	}
 
 	// 返回成功响应
-	h.responseSuccess(w, intradayData)
+	responseSuccess(w, intradayData)
+}
+
+// StreamIntraday 日内实时数据推送接口（Server-Sent Events）
+// GET ?codes=000001,110022 订阅基金，每写入一个日内数据点推送一条 point 事件；
+// 新连接或无法续传时先推送各基金当日已有数据（snapshot 事件）。
+// 断线重连时浏览器自动携带 Last-Event-ID 请求头（也可通过 lastEventId 参数提交）续传断线期间的数据点
+func (h *FundHandler) StreamIntraday(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		responseError(w, http.StatusMethodNotAllowed, "不支持的请求方法")
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		responseError(w, http.StatusInternalServerError, "连接不支持流式响应")
+		return
+	}
+
+	// 获取基金代码列表（逗号分隔，重复的代码只保留一个）
+	fundCodes := splitList(r.URL.Query().Get("codes"))
+	lastEventID := r.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = r.URL.Query().Get("lastEventId")
+	}
+
+	subscription, err := h.intradayService.SubscribePoints(fundCodes, lastEventID)
+	if err != nil {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		switch {
+		case errors.Is(err, service.ErrInvalidSubscription):
+			responseError(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, service.ErrTooManySubscribers):
+			responseError(w, http.StatusServiceUnavailable, err.Error())
+		default:
+			responseError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+	defer subscription.Close()
+
+	w.Header().Set("Content-Type", "text/event-stream; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no") // 关闭 Nginx 缓冲
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, "retry: %d\n\n", streamRetry)
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(h.streamHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-subscription.Ready():
+			for _, event := range subscription.Drain() {
+				if err := writeStreamEvent(w, event); err != nil {
+					return
+				}
+			}
+			flusher.Flush()
+
+		case <-subscription.Done():
+			// 订阅已结束（消费过慢或服务停止），客户端重连后续传
+			return
+
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+// writeStreamEvent 写入一条 SSE 事件
+func writeStreamEvent(w http.ResponseWriter, event service.StreamEvent) error {
+	data, err := json.Marshal(event.Data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+	return err
 }
 
 // GetFundList 获取基金列表接口
@@ -184,7 +549,7 @@ func (h *FundHandler) GetFundList(w http.ResponseWriter, r *http.Request) {
 		"data":     filteredList,
 	}
 
-	h.responseSuccess(w, response)
+	responseSuccess(w, response)
 }
 
 // filterFunds 过滤基金列表
@@ -238,10 +603,12 @@ func (h *FundHandler) GetServiceStatus(w http.ResponseWriter, r *http.Request) {
 		"status":      "running",
 		"fundCount":   len(h.intradayService.GetFundList()),
 		"dataCount":   h.intradayService.GetDataCount(),
+		"collector":   h.intradayService.CollectorStatus(),
+		"subscribers": h.intradayService.StreamSubscribers(),
 		"currentTime": time.Now().Format("2006-01-02 15:04:05"),
 	}
 
-	h.responseSuccess(w, status)
+	responseSuccess(w, status)
 }
 
 // Health 健康检查接口
@@ -253,14 +620,87 @@ func (h *FundHandler) Health(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// parseTrendRange 解析并验证走势查询区间参数
+// period: week/month/quarter/half_year/year/three_years/all/ytd/since/custom，默认 defaultPeriod（指定 start/end 时默认 custom）
+// start/end: YYYY-MM-DD，start 仅用于 since/custom（since 必填），end 为空表示今天
+// adjust: 为 dividend 时返回分红再投资的复权净值
+func (h *FundHandler) parseTrendRange(r *http.Request, defaultPeriod string) (service.TrendRange, error) {
+	query := r.URL.Query()
+	trendRange := service.TrendRange{
+		Period: query.Get("period"),
+		Start:  query.Get("start"),
+		End:    query.Get("end"),
+		Adjust: query.Get("adjust"),
+	}
+	if trendRange.Period == "" {
+		trendRange.Period = defaultPeriod
+		if trendRange.Start != "" || trendRange.End != "" {
+			trendRange.Period = "custom"
+		}
+	}
+
+	// 验证周期参数
+	validPeriods := map[string]bool{
+		"week":        true,
+		"month":       true,
+		"quarter":     true,
+		"half_year":   true,
+		"year":        true,
+		"three_years": true,
+		"all":         true,
+		"ytd":         true,
+		"since":       true,
+		"custom":      true,
+	}
+	if !validPeriods[trendRange.Period] {
+		return trendRange, fmt.Errorf("周期参数无效,可选值: week/month/quarter/half_year/year/three_years/all/ytd/since/custom")
+	}
+
+	// 验证复权参数
+	if trendRange.Adjust != "" && trendRange.Adjust != "dividend" {
+		return trendRange, fmt.Errorf("复权参数无效,可选值: dividend")
+	}
+
+	// 验证日期参数
+	for name, date := range map[string]string{"start": trendRange.Start, "end": trendRange.End} {
+		if date == "" {
+			continue
+		}
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			return trendRange, fmt.Errorf("%s 日期格式错误,应为 YYYY-MM-DD", name)
+		}
+	}
+	switch {
+	case trendRange.Period == "since" && trendRange.Start == "":
+		return trendRange, fmt.Errorf("period=since 时请提供开始日期 start")
+	case trendRange.Start != "" && trendRange.Period != "since" && trendRange.Period != "custom":
+		return trendRange, fmt.Errorf("start 仅可用于 period=since/custom")
+	case trendRange.Start != "" && trendRange.End != "" && trendRange.Start > trendRange.End:
+		return trendRange, fmt.Errorf("开始日期不能晚于结束日期")
+	}
+
+	return trendRange, nil
+}
+
+// decodeJSONBody 解析 JSON 请求体，失败时写入 400 响应并返回 false
+func decodeJSONBody(w http.ResponseWriter, r *http.Request, out interface{}) bool {
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBody))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(out); err != nil {
+		responseError(w, http.StatusBadRequest, "请求体 JSON 格式错误: "+err.Error())
+		return false
+	}
+	return true
+}
+
 // isValidFundCode 验证基金代码格式
-func (h *FundHandler) isValidFundCode(code string) bool {
+func isValidFundCode(code string) bool {
 	matched, _ := regexp.MatchString(`^\d{6}$`, code)
 	return matched
 }
 
 // responseError 返回错误响应
-func (h *FundHandler) responseError(w http.ResponseWriter, statusCode int, message string) {
+func responseError(w http.ResponseWriter, statusCode int, message string) {
 	w.WriteHeader(statusCode)
 	json.NewEncoder(w).Encode(map[string]string{
 		"error": message,
@@ -268,7 +708,7 @@ func (h *FundHandler) responseError(w http.ResponseWriter, statusCode int, messa
 }
 
 // responseSuccess 返回成功响应
-func (h *FundHandler) responseSuccess(w http.ResponseWriter, data interface{}) {
+func responseSuccess(w http.ResponseWriter, data interface{}) {
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(data)
 }
@@ -0,0 +1,216 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fund/middleware"
+	"fund/service"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	liveWriteWait      = 10 * time.Second // 单条消息写入超时，超时视为客户端已无法接收并断开
+	livePongWait       = 60 * time.Second // 等待客户端响应 pong 的时间
+	livePingPeriod     = 30 * time.Second // 服务端发送 ping 的间隔
+	liveMaxMessageSize = 4096             // 客户端消息大小上限
+	liveReplyBuffer    = 16               // 待发送的回复数量上限，超出时视为客户端刷屏并断开
+)
+
+// LiveHandler 实时推送处理器（WebSocket）
+// 未登录可订阅行情和采集状态，携带访问令牌时还可订阅本人触发的提醒
+type LiveHandler struct {
+	intradayService *service.IntradayService
+	upgrader        websocket.Upgrader
+}
+
+// NewLiveHandler 创建实时推送处理器实例
+func NewLiveHandler(intradayService *service.IntradayService) *LiveHandler {
+	return &LiveHandler{
+		intradayService: intradayService,
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:  1024,
+			WriteBufferSize: 4096,
+			// 与其他接口一致允许跨域访问，个人数据只能通过访问令牌订阅
+			CheckOrigin: func(r *http.Request) bool { return true },
+		},
+	}
+}
+
+// liveRequest 客户端消息
+// {"action": "subscribe"/"unsubscribe", "codes": ["000001"], "topics": ["status", "alerts"]}，{"action": "ping"}
+type liveRequest struct {
+	Action string   `json:"action"`
+	Codes  []string `json:"codes"`
+	Topics []string `json:"topics"`
+}
+
+// liveMessage 服务端消息
+// type 为 point/snapshot/status/alert 时 data 为推送数据（同 SSE 接口），
+// subscribed 的 data 为订阅变更后的当前订阅 {"codes": [...], "topics": [...]}，error 为请求错误，pong 为 ping 的响应
+type liveMessage struct {
+	Type  string      `json:"type"`
+	ID    string      `json:"id,omitempty"`
+	Data  interface{} `json:"data,omitempty"`
+	Error string      `json:"error,omitempty"`
+}
+
+// Connect 实时推送接口，GET /api/ws?codes=000001,110022&topics=status,alerts 建立连接并可选地同时订阅，
+// 连接期间通过 subscribe/unsubscribe 消息调整订阅。
+// 客户端消费过慢时同一基金未发送的数据点只保留最新一条
+func (h *LiveHandler) Connect(w http.ResponseWriter, r *http.Request) {
+	var userID int64
+	if user := middleware.UserFromContext(r.Context()); user != nil {
+		userID = user.ID
+	}
+
+	subscription, err := h.intradayService.SubscribeLive(userID)
+	if err != nil {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		status := http.StatusInternalServerError
+		if errors.Is(err, service.ErrTooManySubscribers) {
+			status = http.StatusServiceUnavailable
+		}
+		responseError(w, status, err.Error())
+		return
+	}
+	defer subscription.Close()
+
+	// 连接参数中的订阅在升级前校验，参数错误直接返回 400
+	query := r.URL.Query()
+	initial := liveRequest{Action: "subscribe", Codes: splitList(query.Get("codes")), Topics: splitList(query.Get("topics"))}
+	if len(initial.Codes) > 0 || len(initial.Topics) > 0 {
+		if err := subscription.Subscribe(initial.Codes, initial.Topics); err != nil {
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			responseError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// Upgrade 已写入错误响应
+		return
+	}
+	defer conn.Close()
+
+	if err := writeLiveMessage(conn, subscribedMessage(subscription)); err != nil {
+		return
+	}
+	replies := make(chan liveMessage, liveReplyBuffer)
+	readDone := make(chan struct{})
+	go func() {
+		defer close(readDone)
+		h.readLoop(conn, subscription, replies)
+	}()
+
+	ping := time.NewTicker(livePingPeriod)
+	defer ping.Stop()
+	for {
+		select {
+		case <-subscription.Ready():
+			for _, event := range subscription.Drain() {
+				if err := writeLiveMessage(conn, liveMessage{Type: event.Type, ID: event.ID, Data: event.Data}); err != nil {
+					return
+				}
+			}
+
+		case reply := <-replies:
+			if err := writeLiveMessage(conn, reply); err != nil {
+				return
+			}
+
+		case <-ping.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(liveWriteWait)); err != nil {
+				return
+			}
+
+		case <-subscription.Done():
+			closeMessage := websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "subscription closed")
+			conn.WriteControl(websocket.CloseMessage, closeMessage, time.Now().Add(liveWriteWait))
+			return
+
+		case <-readDone:
+			return
+		}
+	}
+}
+
+// readLoop 读取客户端消息并处理订阅变更，连接关闭或出错时返回
+func (h *LiveHandler) readLoop(conn *websocket.Conn, subscription *service.StreamSubscription, replies chan<- liveMessage) {
+	conn.SetReadLimit(liveMaxMessageSize)
+	conn.SetReadDeadline(time.Now().Add(livePongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(livePongWait))
+	})
+
+	for {
+		var request liveRequest
+		if err := conn.ReadJSON(&request); err != nil {
+			var syntaxErr *json.SyntaxError
+			var typeErr *json.UnmarshalTypeError
+			if !errors.As(err, &syntaxErr) && !errors.As(err, &typeErr) {
+				if websocket.IsUnexpectedCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+					log.Printf("⚠️  实时推送连接异常断开: %v", err)
+				}
+				return
+			}
+			request = liveRequest{Action: "invalid"}
+		}
+		conn.SetReadDeadline(time.Now().Add(livePongWait))
+
+		var reply liveMessage
+		switch request.Action {
+		case "subscribe":
+			if err := subscription.Subscribe(request.Codes, request.Topics); err != nil {
+				reply = liveMessage{Type: "error", Error: err.Error()}
+			} else {
+				reply = subscribedMessage(subscription)
+			}
+		case "unsubscribe":
+			subscription.Unsubscribe(request.Codes, request.Topics)
+			reply = subscribedMessage(subscription)
+		case "ping":
+			reply = liveMessage{Type: "pong"}
+		default:
+			reply = liveMessage{Type: "error", Error: "消息格式错误, action 应为 subscribe/unsubscribe/ping"}
+		}
+
+		select {
+		case replies <- reply:
+		default:
+			log.Printf("⚠️  实时推送客户端请求过于频繁, 断开连接")
+			return
+		}
+	}
+}
+
+// subscribedMessage 当前订阅
+func subscribedMessage(subscription *service.StreamSubscription) liveMessage {
+	codes, topics := subscription.Subscriptions()
+	return liveMessage{Type: "subscribed", Data: map[string][]string{"codes": codes, "topics": topics}}
+}
+
+// writeLiveMessage 写入一条消息
+func writeLiveMessage(conn *websocket.Conn, message liveMessage) error {
+	conn.SetWriteDeadline(time.Now().Add(liveWriteWait))
+	return conn.WriteJSON(message)
+}
+
+// splitList 解析逗号分隔的参数（去除空白和重复项）
+func splitList(value string) []string {
+	var result []string
+	seen := make(map[string]bool)
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" && !seen[item] {
+			seen[item] = true
+			result = append(result, item)
+		}
+	}
+	return result
+}
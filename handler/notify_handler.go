@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"errors"
+	"fund/service"
+	"net/http"
+	"strconv"
+)
+
+// NotifyHandler 提醒通知处理器（需要认证）
+type NotifyHandler struct {
+	notifyService *service.NotifyService
+}
+
+// NewNotifyHandler 创建提醒通知处理器实例
+func NewNotifyHandler(notifyService *service.NotifyService) *NotifyHandler {
+	return &NotifyHandler{
+		notifyService: notifyService,
+	}
+}
+
+// Channels 通知渠道接口
+// GET 获取全部渠道（提供 id 时只返回该渠道），POST 新建渠道，PUT ?id= 修改渠道，DELETE ?id= 删除渠道
+// 签名密钥只写不读，返回中以 hasSecret 表示是否已配置
+func (h *NotifyHandler) Channels(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	user, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if r.URL.Query().Get("id") == "" {
+			channels, err := h.notifyService.ListChannels(user.ID)
+			if err != nil {
+				responseError(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+			responseSuccess(w, channels)
+			return
+		}
+		id, ok := parseChannelID(w, r)
+		if !ok {
+			return
+		}
+		channel, err := h.notifyService.GetChannel(user.ID, id)
+		if err != nil {
+			responseNotifyError(w, err)
+			return
+		}
+		responseSuccess(w, channel)
+
+	case http.MethodPost:
+		var body service.ChannelInput
+		if !decodeJSONBody(w, r, &body) {
+			return
+		}
+		channel, err := h.notifyService.CreateChannel(user.ID, body)
+		if err != nil {
+			responseNotifyError(w, err)
+			return
+		}
+		responseSuccess(w, channel)
+
+	case http.MethodPut:
+		id, ok := parseChannelID(w, r)
+		if !ok {
+			return
+		}
+		var body service.ChannelInput
+		if !decodeJSONBody(w, r, &body) {
+			return
+		}
+		channel, err := h.notifyService.UpdateChannel(user.ID, id, body)
+		if err != nil {
+			responseNotifyError(w, err)
+			return
+		}
+		responseSuccess(w, channel)
+
+	case http.MethodDelete:
+		id, ok := parseChannelID(w, r)
+		if !ok {
+			return
+		}
+		if err := h.notifyService.DeleteChannel(user.ID, id); err != nil {
+			responseNotifyError(w, err)
+			return
+		}
+		responseSuccess(w, map[string]interface{}{"id": id, "status": "deleted"})
+
+	default:
+		responseError(w, http.StatusMethodNotAllowed, "不支持的请求方法")
+	}
+}
+
+// TestChannel 发送测试通知，POST ?id=，返回投递结果（发送失败时 status 为 failed）
+func (h *NotifyHandler) TestChannel(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	user, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+	if r.Method != http.MethodPost {
+		responseError(w, http.StatusMethodNotAllowed, "不支持的请求方法")
+		return
+	}
+
+	id, ok := parseChannelID(w, r)
+	if !ok {
+		return
+	}
+	delivery, err := h.notifyService.TestChannel(user.ID, id)
+	if err != nil {
+		responseNotifyError(w, err)
+		return
+	}
+	responseSuccess(w, delivery)
+}
+
+// GetDeliveries 通知投递记录，GET ?limit=100（最新的在前）
+func (h *NotifyHandler) GetDeliveries(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	user, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+	if r.Method != http.MethodGet {
+		responseError(w, http.StatusMethodNotAllowed, "不支持的请求方法")
+		return
+	}
+
+	limit := 0
+	if value := r.URL.Query().Get("limit"); value != "" {
+		var err error
+		if limit, err = strconv.Atoi(value); err != nil || limit <= 0 || limit > 1000 {
+			responseError(w, http.StatusBadRequest, "limit 应为 1-1000")
+			return
+		}
+	}
+	deliveries, err := h.notifyService.ListDeliveries(user.ID, limit)
+	if err != nil {
+		responseError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	responseSuccess(w, deliveries)
+}
+
+// parseChannelID 读取渠道 id 参数，无效时写入 400 响应并返回 false
+func parseChannelID(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	id, err := strconv.ParseInt(r.URL.Query().Get("id"), 10, 64)
+	if err != nil || id <= 0 {
+		responseError(w, http.StatusBadRequest, "请提供渠道 id")
+		return 0, false
+	}
+	return id, true
+}
+
+// responseNotifyError 将通知服务错误映射为 HTTP 状态码
+func responseNotifyError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrNotifyChannelNotFound):
+		responseError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, service.ErrInvalidNotifyChannel):
+		responseError(w, http.StatusBadRequest, err.Error())
+	default:
+		responseError(w, http.StatusInternalServerError, err.Error())
+	}
+}
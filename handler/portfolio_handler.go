@@ -0,0 +1,186 @@
+package handler
+
+import (
+	"errors"
+	"fund/model"
+	"fund/service"
+	"net/http"
+	"time"
+)
+
+// PortfolioHandler 持仓处理器（需要认证，每个用户只能访问自己的持仓）
+type PortfolioHandler struct {
+	portfolioService *service.PortfolioService
+}
+
+// NewPortfolioHandler 创建持仓处理器实例
+func NewPortfolioHandler(portfolioService *service.PortfolioService) *PortfolioHandler {
+	return &PortfolioHandler{
+		portfolioService: portfolioService,
+	}
+}
+
+// Holdings 持仓接口
+// GET 获取全部持仓（提供 code 时只返回该基金），POST/PUT 新增或修改持仓，DELETE 删除持仓及其交易记录
+func (h *PortfolioHandler) Holdings(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	user, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		fundCode := r.URL.Query().Get("code")
+		if fundCode == "" {
+			holdings, err := h.portfolioService.ListHoldings(user.ID)
+			if err != nil {
+				responseError(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+			responseSuccess(w, holdings)
+			return
+		}
+		if !isValidFundCode(fundCode) {
+			responseError(w, http.StatusBadRequest, "基金代码格式错误,应为6位数字")
+			return
+		}
+		holding, err := h.portfolioService.GetHolding(user.ID, fundCode)
+		if err != nil {
+			responsePortfolioError(w, err)
+			return
+		}
+		responseSuccess(w, holding)
+
+	case http.MethodPost, http.MethodPut:
+		var holding model.Holding
+		if !decodeJSONBody(w, r, &holding) {
+			return
+		}
+		if !isValidFundCode(holding.Code) {
+			responseError(w, http.StatusBadRequest, "基金代码格式错误,应为6位数字")
+			return
+		}
+		saved, err := h.portfolioService.SaveHolding(user.ID, holding)
+		if err != nil {
+			responsePortfolioError(w, err)
+			return
+		}
+		responseSuccess(w, saved)
+
+	case http.MethodDelete:
+		fundCode := r.URL.Query().Get("code")
+		if !isValidFundCode(fundCode) {
+			responseError(w, http.StatusBadRequest, "请提供6位数字基金代码参数 code")
+			return
+		}
+		if err := h.portfolioService.DeleteHolding(user.ID, fundCode); err != nil {
+			responsePortfolioError(w, err)
+			return
+		}
+		responseSuccess(w, map[string]string{"code": fundCode, "status": "deleted"})
+
+	default:
+		responseError(w, http.StatusMethodNotAllowed, "不支持的请求方法")
+	}
+}
+
+// Transactions 交易记录接口
+// GET 获取交易记录（可按 code 过滤），POST 记录一笔买入/卖出/分红并更新持仓
+func (h *PortfolioHandler) Transactions(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	user, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		fundCode := r.URL.Query().Get("code")
+		if fundCode != "" && !isValidFundCode(fundCode) {
+			responseError(w, http.StatusBadRequest, "基金代码格式错误,应为6位数字")
+			return
+		}
+		transactions, err := h.portfolioService.ListTransactions(user.ID, fundCode)
+		if err != nil {
+			responseError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		responseSuccess(w, transactions)
+
+	case http.MethodPost:
+		var tx model.Transaction
+		if !decodeJSONBody(w, r, &tx) {
+			return
+		}
+		if !isValidFundCode(tx.Code) {
+			responseError(w, http.StatusBadRequest, "基金代码格式错误,应为6位数字")
+			return
+		}
+		recorded, holding, err := h.portfolioService.AddTransaction(user.ID, tx)
+		if err != nil {
+			responsePortfolioError(w, err)
+			return
+		}
+		responseSuccess(w, map[string]interface{}{
+			"transaction": recorded,
+			"holding":     holding,
+		})
+
+	default:
+		responseError(w, http.StatusMethodNotAllowed, "不支持的请求方法")
+	}
+}
+
+// GetValuation 组合估值接口
+func (h *PortfolioHandler) GetValuation(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	user, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	valuation, err := h.portfolioService.Valuate(user.ID)
+	if err != nil {
+		responseError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	responseSuccess(w, valuation)
+}
+
+// GetIntraday 组合日内估值曲线接口
+func (h *PortfolioHandler) GetIntraday(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	user, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	// 获取日期参数（为空时返回当日曲线）
+	date := r.URL.Query().Get("date")
+	if date != "" {
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			responseError(w, http.StatusBadRequest, "日期格式错误,应为 YYYY-MM-DD")
+			return
+		}
+	}
+
+	curve, err := h.portfolioService.GetIntradayCurve(user.ID, date)
+	if err != nil {
+		responseError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	responseSuccess(w, curve)
+}
+
+// responsePortfolioError 将持仓服务错误映射为 HTTP 状态码
+func responsePortfolioError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrHoldingNotFound):
+		responseError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, service.ErrInvalidPortfolio):
+		responseError(w, http.StatusBadRequest, err.Error())
+	default:
+		responseError(w, http.StatusInternalServerError, err.Error())
+	}
+}
@@ -0,0 +1,196 @@
+package handler
+
+import (
+	"errors"
+	"fund/service"
+	"net/http"
+	"strconv"
+)
+
+// WatchlistHandler 自选分组处理器（需要认证）
+type WatchlistHandler struct {
+	watchlistService *service.WatchlistService
+}
+
+// NewWatchlistHandler 创建自选分组处理器实例
+func NewWatchlistHandler(watchlistService *service.WatchlistService) *WatchlistHandler {
+	return &WatchlistHandler{
+		watchlistService: watchlistService,
+	}
+}
+
+// watchlistBody 新建、修改分组请求体
+type watchlistBody struct {
+	Name  string   `json:"name"`
+	Codes []string `json:"codes"`
+}
+
+// Watchlists 自选分组接口
+// GET 获取全部分组（提供 id 时只返回该分组），POST 新建分组，PUT ?id= 修改名称和基金列表，DELETE ?id= 删除分组
+func (h *WatchlistHandler) Watchlists(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	user, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if r.URL.Query().Get("id") == "" {
+			watchlists, err := h.watchlistService.List(user.ID)
+			if err != nil {
+				responseError(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+			responseSuccess(w, watchlists)
+			return
+		}
+		id, ok := parseWatchlistID(w, r)
+		if !ok {
+			return
+		}
+		watchlist, err := h.watchlistService.Get(user.ID, id)
+		if err != nil {
+			responseWatchlistError(w, err)
+			return
+		}
+		responseSuccess(w, watchlist)
+
+	case http.MethodPost:
+		var body watchlistBody
+		if !decodeJSONBody(w, r, &body) {
+			return
+		}
+		watchlist, err := h.watchlistService.Create(user.ID, body.Name, body.Codes)
+		if err != nil {
+			responseWatchlistError(w, err)
+			return
+		}
+		responseSuccess(w, watchlist)
+
+	case http.MethodPut:
+		id, ok := parseWatchlistID(w, r)
+		if !ok {
+			return
+		}
+		var body watchlistBody
+		if !decodeJSONBody(w, r, &body) {
+			return
+		}
+		watchlist, err := h.watchlistService.Update(user.ID, id, body.Name, body.Codes)
+		if err != nil {
+			responseWatchlistError(w, err)
+			return
+		}
+		responseSuccess(w, watchlist)
+
+	case http.MethodDelete:
+		id, ok := parseWatchlistID(w, r)
+		if !ok {
+			return
+		}
+		if err := h.watchlistService.Delete(user.ID, id); err != nil {
+			responseWatchlistError(w, err)
+			return
+		}
+		responseSuccess(w, map[string]interface{}{"id": id, "status": "deleted"})
+
+	default:
+		responseError(w, http.StatusMethodNotAllowed, "不支持的请求方法")
+	}
+}
+
+// Codes 分组内基金接口
+// POST 添加基金（可指定插入位置 position，已存在时移动），DELETE ?id=&code= 移除基金
+func (h *WatchlistHandler) Codes(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	user, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPost:
+		body := struct {
+			ID       int64  `json:"id"`
+			Code     string `json:"code"`
+			Position *int   `json:"position"`
+		}{}
+		if !decodeJSONBody(w, r, &body) {
+			return
+		}
+		position := -1
+		if body.Position != nil {
+			position = *body.Position
+		}
+		watchlist, err := h.watchlistService.AddCode(user.ID, body.ID, body.Code, position)
+		if err != nil {
+			responseWatchlistError(w, err)
+			return
+		}
+		responseSuccess(w, watchlist)
+
+	case http.MethodDelete:
+		id, ok := parseWatchlistID(w, r)
+		if !ok {
+			return
+		}
+		watchlist, err := h.watchlistService.RemoveCode(user.ID, id, r.URL.Query().Get("code"))
+		if err != nil {
+			responseWatchlistError(w, err)
+			return
+		}
+		responseSuccess(w, watchlist)
+
+	default:
+		responseError(w, http.StatusMethodNotAllowed, "不支持的请求方法")
+	}
+}
+
+// Order 分组排序接口，PUT 请求体 {"ids": [...]} 为全部分组的新顺序
+func (h *WatchlistHandler) Order(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	user, ok := currentUser(w, r)
+	if !ok {
+		return
+	}
+	if r.Method != http.MethodPut && r.Method != http.MethodPost {
+		responseError(w, http.StatusMethodNotAllowed, "不支持的请求方法")
+		return
+	}
+
+	var body struct {
+		IDs []int64 `json:"ids"`
+	}
+	if !decodeJSONBody(w, r, &body) {
+		return
+	}
+	watchlists, err := h.watchlistService.Reorder(user.ID, body.IDs)
+	if err != nil {
+		responseWatchlistError(w, err)
+		return
+	}
+	responseSuccess(w, watchlists)
+}
+
+// parseWatchlistID 读取分组 id 参数，无效时写入 400 响应并返回 false
+func parseWatchlistID(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	id, err := strconv.ParseInt(r.URL.Query().Get("id"), 10, 64)
+	if err != nil || id <= 0 {
+		responseError(w, http.StatusBadRequest, "请提供分组 id")
+		return 0, false
+	}
+	return id, true
+}
+
+// responseWatchlistError 将自选分组服务错误映射为 HTTP 状态码
+func responseWatchlistError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrWatchlistNotFound):
+		responseError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, service.ErrInvalidWatchlist):
+		responseError(w, http.StatusBadRequest, err.Error())
+	default:
+		responseError(w, http.StatusInternalServerError, err.Error())
+	}
+}
@@ -0,0 +1,126 @@
+package fakeupstream
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// navRecord 历史净值分页接口中的一条记录
+type navRecord struct {
+	FSRQ  string `json:"FSRQ"`  // 净值日期
+	DWJZ  string `json:"DWJZ"`  // 单位净值
+	LJJZ  string `json:"LJJZ"`  // 累计净值
+	JZZZL string `json:"JZZZL"` // 日增长率
+	FHSP  string `json:"FHSP"`  // 分红送配
+}
+
+// serveNAVHistory 模拟历史净值分页接口，数据取自录制的 pingzhongdata
+// 按日期倒序返回 startDate（含）之后的净值
+func (s *Server) serveNAVHistory(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	records, err := navRecords(query.Get("fundCode"))
+	if err != nil {
+		s.notFound(w, r, RouteNAVHistory)
+		return
+	}
+
+	startDate := query.Get("startDate")
+	filtered := records[:0:0]
+	for _, record := range records {
+		if record.FSRQ >= startDate {
+			filtered = append(filtered, record)
+		}
+	}
+
+	pageIndex, _ := strconv.Atoi(query.Get("pageIndex"))
+	pageSize, _ := strconv.Atoi(query.Get("pageSize"))
+	if pageIndex < 1 {
+		pageIndex = 1
+	}
+	if pageSize < 1 {
+		pageSize = 20
+	}
+	start := (pageIndex - 1) * pageSize
+	end := start + pageSize
+	if start > len(filtered) {
+		start = len(filtered)
+	}
+	if end > len(filtered) {
+		end = len(filtered)
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"Data":       map[string]interface{}{"LSJZList": filtered[start:end]},
+		"ErrCode":    0,
+		"ErrMsg":     nil,
+		"TotalCount": len(filtered),
+		"PageSize":   pageSize,
+		"PageIndex":  pageIndex,
+	})
+}
+
+// navRecords 从录制的 pingzhongdata 生成倒序的历史净值记录
+func navRecords(fundCode string) ([]navRecord, error) {
+	body, err := Payload("pingzhongdata/" + fundCode + ".js")
+	if err != nil {
+		return nil, err
+	}
+
+	var trend []struct {
+		X            int64       `json:"x"`
+		Y            float64     `json:"y"`
+		EquityReturn float64     `json:"equityReturn"`
+		UnitMoney    interface{} `json:"unitMoney"`
+	}
+	if err := json.Unmarshal(jsVar(body, "Data_netWorthTrend"), &trend); err != nil {
+		return nil, err
+	}
+	var accTrend [][2]float64
+	json.Unmarshal(jsVar(body, "Data_ACWorthTrend"), &accTrend)
+	accValues := make(map[int64]float64, len(accTrend))
+	for _, item := range accTrend {
+		accValues[int64(item[0])] = item[1]
+	}
+
+	zone := time.FixedZone("CST", 8*3600)
+	records := make([]navRecord, 0, len(trend))
+	for _, item := range trend {
+		record := navRecord{
+			FSRQ:  time.UnixMilli(item.X).In(zone).Format("2006-01-02"),
+			DWJZ:  strconv.FormatFloat(item.Y, 'f', 4, 64),
+			LJJZ:  strconv.FormatFloat(accValues[item.X], 'f', 4, 64),
+			JZZZL: strconv.FormatFloat(item.EquityReturn, 'f', 2, 64),
+		}
+		if unitMoney, ok := item.UnitMoney.(string); ok {
+			record.FHSP = unitMoney
+		}
+		records = append(records, record)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].FSRQ > records[j].FSRQ
+	})
+	return records, nil
+}
+
+// jsVar 截取 `var name = <JSON>;` 中的 JSON 部分（录制数据中每个变量以 ";" 结尾）
+func jsVar(body []byte, name string) []byte {
+	marker := []byte("var " + name + " = ")
+	start := bytes.Index(body, marker)
+	if start < 0 {
+		return nil
+	}
+	rest := body[start+len(marker):]
+	end := bytes.Index(rest, []byte(";/*"))
+	if end < 0 {
+		end = bytes.IndexByte(rest, ';')
+	}
+	if end < 0 {
+		return nil
+	}
+	return rest[:end]
+}
@@ -0,0 +1 @@
+﻿var r = [["000001","HXCZHH","华夏成长混合","混合型-偏股","HUAXIACHENGZHANGHUNHE"],["000003","ZHKZZZQA","中海可转债债券A","债券型-混合二级","ZHONGHAIKEZHUANZHAIZHAIQUANA"],["005827","YFDLCJXHH","易方达蓝筹精选混合","混合型-偏股","YIFANGDALANCHOUJINGXUANHUNHE"],["110022","YFDXFHYGP","易方达消费行业股票","股票型","YIFANGDAXIAOFEIHANGYEGUPIAO"],["161725","ZSZZBJZSLOFA","招商中证白酒指数(LOF)A","指数型-股票","ZHAOSHANGZHONGZHENGBAIJIUZHISHULOFA"]];
\ No newline at end of file
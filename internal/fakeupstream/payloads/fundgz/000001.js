@@ -0,0 +1 @@
+jsonpgz({"fundcode":"000001","name":"华夏成长混合","jzrq":"2025-06-30","dwjz":"1.1112","gsz":"1.1166","gszzl":"0.49","gztime":"2025-07-01 15:00"});
\ No newline at end of file
@@ -0,0 +1 @@
+jsonpgz({"fundcode":"110022","name":"易方达消费行业股票","jzrq":"2025-06-30","dwjz":"6.1765","gsz":"6.2068","gszzl":"0.49","gztime":"2025-07-01 15:00"});
\ No newline at end of file
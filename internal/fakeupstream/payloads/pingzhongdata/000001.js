@@ -0,0 +1 @@
+/*Create By xxx 2025/6/30 20:30:12*/var ishb=false;/*基金或股票信息*/var fS_name = "华夏成长混合";var fS_code = "000001";/*原费率*/var fund_sourceRate="1.50";/*现费率*/var fund_Rate="0.15";/*最小申购金额*/var fund_minsg="10";/*基金持仓股票代码*/var stockCodes=["6005191", "0008582", "3007501", "6000361", "0020271"];/*基金持仓债券代码*/var zqCodes = "";/*基金持仓股票代码(新市场号)*/var stockCodesNew =["1.600519", "0.000858", "0.300750", "1.600036", "0.002027"];/*基金持仓债券代码（新市场号）*/var zqCodesNew = "";/*收益率*//*近一年收益率*/var syl_1n="13.03";/*近6月收益率*/var syl_6y="13.29";/*近三月收益率*/var syl_3y="1.67";/*近一月收益率*/var syl_1y="1.30";/*股票仓位测算图*/var Data_fundSharesPositions = [[1748880000000,88.69],[1749484800000,84.11],[1750089600000,85.29],[1750694400000,85.03]];/*单位净值走势 equityReturn-净值回报 unitMoney-每份派送金*/var Data_netWorthTrend = [{"x":1672675200000,"y":1.123,"equityReturn":0,"unitMoney":""},{"x":1672761600000,"y":1.1505,"equityReturn":2.44,"unitMoney":""},{"x":1672848000000,"y":1.1459,"equityReturn":-0.4,"unitMoney":""},{"x":1672934400000,"y":1.1496,"equityReturn":0.32,"unitMoney":""},{"x":1673193600000,"y":1.1496,"equityReturn":-0.0,"unitMoney":""},{"x":1673280000000,"y":1.154,"equityReturn":0.39,"unitMoney":""},{"x":1673366400000,"y":1.135,"equityReturn":-1.64,"unitMoney":""},{"x":1673452800000,"y":1.1377,"equityReturn":0.24,"unitMoney":""},{"x":1673539200000,"y":1.1315,"equityReturn":-0.55,"unitMoney":""},{"x":1673798400000,"y":1.1255,"equityReturn":-0.53,"unitMoney":""},{"x":1673884800000,"y":1.117,"equityReturn":-0.75,"unitMoney":""},{"x":1673971200000,"y":1.1136,"equityReturn":-0.3,"unitMoney":""},{"x":1674057600000,"y":1.1116,"equityReturn":-0.18,"unitMoney":""},{"x":1674144000000,"y":1.0897,"equityReturn":-1.97,"unitMoney":""},{"x":1674403200000,"y":1.1006,"equityReturn":1.0,"unitMoney":""},{"x":1674489600000,"y":1.0976,"equityReturn":-0.27,"unitMoney":""},{"x":1674576000000,"y":1.0626,"equityReturn":-3.19,"unitMoney":""},{"x":1674662400000,"y":1.066,"equityReturn":0.32,"unitMoney":""},{"x":1674748800000,"y":1.0505,"equityReturn":-1.45,"unitMoney":""},{"x":1675008000000,"y":1.0366,"equityReturn":-1.33,"unitMoney":""},{"x":1675094400000,"y":1.037,"equityReturn":0.04,"unitMoney":""},{"x":1675180800000,"y":1.0418,"equityReturn":0.46,"unitMoney":""},{"x":1675267200000,"y":1.0424,"equityReturn":0.06,"unitMoney":""},{"x":1675353600000,"y":1.0361,"equityReturn":-0.6,"unitMoney":""},{"x":1675612800000,"y":1.0346,"equityReturn":-0.15,"unitMoney":""},{"x":1675699200000,"y":1.0193,"equityReturn":-1.48,"unitMoney":""},{"x":1675785600000,"y":1.0382,"equityReturn":1.85,"unitMoney":""},{"x":1675872000000,"y":1.0199,"equityReturn":-1.76,"unitMoney":""},{"x":1675958400000,"y":1.0284,"equityReturn":0.83,"unitMoney":""},{"x":1676217600000,"y":1.0323,"equityReturn":0.38,"unitMoney":""},{"x":1676304000000,"y":1.0425,"equityReturn":0.99,"unitMoney":""},{"x":1676390400000,"y":1.0361,"equityReturn":-0.62,"unitMoney":""},{"x":1676476800000,"y":1.0373,"equityReturn":0.11,"unitMoney":""},{"x":1676563200000,"y":0.994,"equityReturn":-4.18,"unitMoney":""},{"x":1676822400000,"y":0.9911,"equityReturn":-0.3,"unitMoney":""},{"x":1676908800000,"y":0.992,"equityReturn":0.09,"unitMoney":""},{"x":1676995200000,"y":0.9876,"equityReturn":-0.44,"unitMoney":""},{"x":1677081600000,"y":1.0004,"equityReturn":1.3,"unitMoney":""},{"x":1677168000000,"y":0.9827,"equityReturn":-1.77,"unitMoney":""},{"x":1677427200000,"y":0.9776,"equityReturn":-0.52,"unitMoney":""},{"x":1677513600000,"y":0.9617,"equityReturn":-1.63,"unitMoney":""},{"x":1677600000000,"y":0.9588,"equityReturn":-0.3,"unitMoney":""},{"x":1677686400000,"y":0.9419,"equityReturn":-1.76,"unitMoney":""},{"x":1677772800000,"y":0.9269,"equityReturn":-1.6,"unitMoney":""},{"x":1678032000000,"y":0.9417,"equityReturn":1.59,"unitMoney":""},{"x":1678118400000,"y":0.9482,"equityReturn":0.69,"unitMoney":""},{"x":1678204800000,"y":0.9544,"equityReturn":0.66,"unitMoney":""},{"x":1678291200000,"y":0.9435,"equityReturn":-1.14,"unitMoney":""},{"x":1678377600000,"y":0.9255,"equityReturn":-1.91,"unitMoney":""},{"x":1678636800000,"y":0.913,"equityReturn":-1.35,"unitMoney":""},{"x":1678723200000,"y":0.9117,"equityReturn":-0.14,"unitMoney":""},{"x":1678809600000,"y":0.8919,"equityReturn":-2.17,"unitMoney":""},{"x":1678896000000,"y":0.8932,"equityReturn":0.15,"unitMoney":""},{"x":1678982400000,"y":0.867,"equityReturn":-2.93,"unitMoney":""},{"x":1679241600000,"y":0.8714,"equityReturn":0.51,"unitMoney":""},{"x":1679328000000,"y":0.8631,"equityReturn":-0.96,"unitMoney":""},{"x":1679414400000,"y":0.8838,"equityReturn":2.4,"unitMoney":""},{"x":1679500800000,"y":0.9004,"equityReturn":1.88,"unitMoney":""},{"x":1679587200000,"y":0.8961,"equityReturn":-0.48,"unitMoney":""},{"x":1679846400000,"y":0.8768,"equityReturn":-2.16,"unitMoney":""},{"x":1679932800000,"y":0.8612,"equityReturn":-1.77,"unitMoney":""},{"x":1680019200000,"y":0.8628,"equityReturn":0.19,"unitMoney":""},{"x":1680105600000,"y":0.849,"equityReturn":-1.59,"unitMoney":""},{"x":1680192000000,"y":0.8484,"equityReturn":-0.07,"unitMoney":""},{"x":1680451200000,"y":0.8503,"equityReturn":0.23,"unitMoney":""},{"x":1680537600000,"y":0.8438,"equityReturn":-0.76,"unitMoney":""},{"x":1680624000000,"y":0.836,"equityReturn":-0.92,"unitMoney":""},{"x":1680710400000,"y":0.8488,"equityReturn":1.53,"unitMoney":""},{"x":1680796800000,"y":0.8346,"equityReturn":-1.67,"unitMoney":""},{"x":1681056000000,"y":0.8342,"equityReturn":-0.04,"unitMoney":""},{"x":1681142400000,"y":0.8314,"equityReturn":-0.33,"unitMoney":""},{"x":1681228800000,"y":0.8526,"equityReturn":2.55,"unitMoney":""},{"x":1681315200000,"y":0.8519,"equityReturn":-0.08,"unitMoney":""},{"x":1681401600000,"y":0.8311,"equityReturn":-2.44,"unitMoney":""},{"x":1681660800000,"y":0.8185,"equityReturn":-1.52,"unitMoney":""},{"x":1681747200000,"y":0.8135,"equityReturn":-0.61,"unitMoney":""},{"x":1681833600000,"y":0.8004,"equityReturn":-1.61,"unitMoney":""},{"x":1681920000000,"y":0.793,"equityReturn":-0.93,"unitMoney":""},{"x":1682006400000,"y":0.8034,"equityReturn":1.31,"unitMoney":""},{"x":1682265600000,"y":0.7884,"equityReturn":-1.87,"unitMoney":""},{"x":1682352000000,"y":0.7878,"equityReturn":-0.07,"unitMoney":""},{"x":1682438400000,"y":0.7867,"equityReturn":-0.14,"unitMoney":""},{"x":1682524800000,"y":0.7866,"equityReturn":-0.02,"unitMoney":""},{"x":1682611200000,"y":0.8003,"equityReturn":1.74,"unitMoney":""},{"x":1682870400000,"y":0.7907,"equityReturn":-1.2,"unitMoney":""},{"x":1682956800000,"y":0.7981,"equityReturn":0.94,"unitMoney":""},{"x":1683043200000,"y":0.7978,"equityReturn":-0.04,"unitMoney":""},{"x":1683129600000,"y":0.7904,"equityReturn":-0.93,"unitMoney":""},{"x":1683216000000,"y":0.7937,"equityReturn":0.41,"unitMoney":""},{"x":1683475200000,"y":0.7945,"equityReturn":0.1,"unitMoney":""},{"x":1683561600000,"y":0.7915,"equityReturn":-0.38,"unitMoney":""},{"x":1683648000000,"y":0.7849,"equityReturn":-0.83,"unitMoney":""},{"x":1683734400000,"y":0.7996,"equityReturn":1.87,"unitMoney":""},{"x":1683820800000,"y":0.8122,"equityReturn":1.58,"unitMoney":""},{"x":1684080000000,"y":0.8103,"equityReturn":-0.23,"unitMoney":""},{"x":1684166400000,"y":0.8137,"equityReturn":0.42,"unitMoney":""},{"x":1684252800000,"y":0.8136,"equityReturn":-0.02,"unitMoney":""},{"x":1684339200000,"y":0.8119,"equityReturn":-0.2,"unitMoney":""},{"x":1684425600000,"y":0.8378,"equityReturn":3.19,"unitMoney":""},{"x":1684684800000,"y":0.8278,"equityReturn":-1.19,"unitMoney":""},{"x":1684771200000,"y":0.834,"equityReturn":0.75,"unitMoney":""},{"x":1684857600000,"y":0.8444,"equityReturn":1.24,"unitMoney":""},{"x":1684944000000,"y":0.8497,"equityReturn":0.62,"unitMoney":""},{"x":1685030400000,"y":0.8571,"equityReturn":0.87,"unitMoney":""},{"x":1685289600000,"y":0.8756,"equityReturn":2.16,"unitMoney":""},{"x":1685376000000,"y":0.8931,"equityReturn":2.0,"unitMoney":""},{"x":1685462400000,"y":0.9033,"equityReturn":1.14,"unitMoney":""},{"x":1685548800000,"y":0.925,"equityReturn":2.41,"unitMoney":""},{"x":1685635200000,"y":0.928,"equityReturn":0.32,"unitMoney":""},{"x":1685894400000,"y":0.9311,"equityReturn":0.34,"unitMoney":""},{"x":1685980800000,"y":0.9377,"equityReturn":0.71,"unitMoney":""},{"x":1686067200000,"y":0.9485,"equityReturn":1.15,"unitMoney":""},{"x":1686153600000,"y":0.9406,"equityReturn":-0.83,"unitMoney":""},{"x":1686240000000,"y":0.9395,"equityReturn":-0.12,"unitMoney":""},{"x":1686499200000,"y":0.9535,"equityReturn":1.49,"unitMoney":""},{"x":1686585600000,"y":0.9506,"equityReturn":-0.31,"unitMoney":""},{"x":1686672000000,"y":0.9512,"equityReturn":0.06,"unitMoney":""},{"x":1686758400000,"y":0.9654,"equityReturn":1.5,"unitMoney":""},{"x":1686844800000,"y":0.9593,"equityReturn":-0.63,"unitMoney":""},{"x":1687104000000,"y":0.9762,"equityReturn":1.77,"unitMoney":""},{"x":1687190400000,"y":0.9713,"equityReturn":-0.51,"unitMoney":""},{"x":1687276800000,"y":0.9538,"equityReturn":-1.8,"unitMoney":""},{"x":1687363200000,"y":0.9462,"equityReturn":-0.8,"unitMoney":""},{"x":1687449600000,"y":0.96,"equityReturn":1.46,"unitMoney":""},{"x":1687708800000,"y":0.9714,"equityReturn":1.19,"unitMoney":""},{"x":1687795200000,"y":0.9851,"equityReturn":1.41,"unitMoney":""},{"x":1687881600000,"y":0.9885,"equityReturn":0.34,"unitMoney":""},{"x":1687968000000,"y":0.9914,"equityReturn":0.3,"unitMoney":""},{"x":1688054400000,"y":0.9773,"equityReturn":-1.43,"unitMoney":""},{"x":1688313600000,"y":0.9913,"equityReturn":1.43,"unitMoney":""},{"x":1688400000000,"y":0.9826,"equityReturn":-0.87,"unitMoney":""},{"x":1688486400000,"y":0.9972,"equityReturn":1.49,"unitMoney":""},{"x":1688572800000,"y":0.9844,"equityReturn":-1.28,"unitMoney":""},{"x":1688659200000,"y":0.9815,"equityReturn":-0.29,"unitMoney":""},{"x":1688918400000,"y":0.9864,"equityReturn":0.5,"unitMoney":""},{"x":1689004800000,"y":0.9935,"equityReturn":0.72,"unitMoney":""},{"x":1689091200000,"y":0.9876,"equityReturn":-0.59,"unitMoney":""},{"x":1689177600000,"y":0.9948,"equityReturn":0.73,"unitMoney":""},{"x":1689264000000,"y":1.0,"equityReturn":0.52,"unitMoney":""},{"x":1689523200000,"y":1.0042,"equityReturn":0.42,"unitMoney":""},{"x":1689609600000,"y":1.0059,"equityReturn":0.17,"unitMoney":""},{"x":1689696000000,"y":0.9946,"equityReturn":-1.12,"unitMoney":""},{"x":1689782400000,"y":0.9916,"equityReturn":-0.3,"unitMoney":""},{"x":1689868800000,"y":0.9967,"equityReturn":0.52,"unitMoney":""},{"x":1690128000000,"y":0.9811,"equityReturn":-1.57,"unitMoney":""},{"x":1690214400000,"y":0.9828,"equityReturn":0.18,"unitMoney":""},{"x":1690300800000,"y":0.9824,"equityReturn":-0.04,"unitMoney":""},{"x":1690387200000,"y":0.9761,"equityReturn":-0.64,"unitMoney":""},{"x":1690473600000,"y":0.9708,"equityReturn":-0.54,"unitMoney":""},{"x":1690732800000,"y":0.9821,"equityReturn":1.16,"unitMoney":""},{"x":1690819200000,"y":0.9878,"equityReturn":0.58,"unitMoney":""},{"x":1690905600000,"y":0.9921,"equityReturn":0.43,"unitMoney":""},{"x":1690992000000,"y":0.9921,"equityReturn":0.0,"unitMoney":""},{"x":1691078400000,"y":1.0131,"equityReturn":2.12,"unitMoney":""},{"x":1691337600000,"y":1.0169,"equityReturn":0.38,"unitMoney":""},{"x":1691424000000,"y":1.0269,"equityReturn":0.98,"unitMoney":""},{"x":1691510400000,"y":1.0356,"equityReturn":0.85,"unitMoney":""},{"x":1691596800000,"y":1.0019,"equityReturn":-3.26,"unitMoney":""},{"x":1691683200000,"y":1.0001,"equityReturn":-0.18,"unitMoney":""},{"x":1691942400000,"y":1.0165,"equityReturn":1.64,"unitMoney":""},{"x":1692028800000,"y":0.9993,"equityReturn":-1.69,"unitMoney":""},{"x":1692115200000,"y":1.0069,"equityReturn":0.76,"unitMoney":""},{"x":1692201600000,"y":1.012,"equityReturn":0.51,"unitMoney":""},{"x":1692288000000,"y":1.0118,"equityReturn":-0.02,"unitMoney":""},{"x":1692547200000,"y":1.0327,"equityReturn":2.07,"unitMoney":""},{"x":1692633600000,"y":1.0238,"equityReturn":-0.86,"unitMoney":""},{"x":1692720000000,"y":1.019,"equityReturn":-0.47,"unitMoney":""},{"x":1692806400000,"y":1.0067,"equityReturn":-1.21,"unitMoney":""},{"x":1692892800000,"y":0.9966,"equityReturn":-1.01,"unitMoney":""},{"x":1693152000000,"y":0.983,"equityReturn":-1.37,"unitMoney":""},{"x":1693238400000,"y":0.9931,"equityReturn":1.03,"unitMoney":""},{"x":1693324800000,"y":1.0028,"equityReturn":0.98,"unitMoney":""},{"x":1693411200000,"y":0.987,"equityReturn":-1.57,"unitMoney":""},{"x":1693497600000,"y":0.9893,"equityReturn":0.23,"unitMoney":""},{"x":1693756800000,"y":0.9838,"equityReturn":-0.55,"unitMoney":""},{"x":1693843200000,"y":0.9868,"equityReturn":0.3,"unitMoney":""},{"x":1693929600000,"y":0.9703,"equityReturn":-1.67,"unitMoney":""},{"x":1694016000000,"y":0.9771,"equityReturn":0.7,"unitMoney":""},{"x":1694102400000,"y":0.9884,"equityReturn":1.15,"unitMoney":""},{"x":1694361600000,"y":0.9887,"equityReturn":0.03,"unitMoney":""},{"x":1694448000000,"y":0.9977,"equityReturn":0.91,"unitMoney":""},{"x":1694534400000,"y":0.9906,"equityReturn":-0.71,"unitMoney":""},{"x":1694620800000,"y":0.9901,"equityReturn":-0.05,"unitMoney":""},{"x":1694707200000,"y":0.9841,"equityReturn":-0.6,"unitMoney":""},{"x":1694966400000,"y":1.0078,"equityReturn":2.41,"unitMoney":""},{"x":1695052800000,"y":1.02,"equityReturn":1.21,"unitMoney":""},{"x":1695139200000,"y":1.0422,"equityReturn":2.18,"unitMoney":""},{"x":1695225600000,"y":1.0777,"equityReturn":3.4,"unitMoney":""},{"x":1695312000000,"y":1.0662,"equityReturn":-1.07,"unitMoney":""},{"x":1695571200000,"y":1.0595,"equityReturn":-0.63,"unitMoney":""},{"x":1695657600000,"y":1.0637,"equityReturn":0.4,"unitMoney":""},{"x":1695744000000,"y":1.0615,"equityReturn":-0.21,"unitMoney":""},{"x":1695830400000,"y":1.0644,"equityReturn":0.28,"unitMoney":""},{"x":1695916800000,"y":1.0537,"equityReturn":-1.01,"unitMoney":""},{"x":1696176000000,"y":1.065,"equityReturn":1.07,"unitMoney":""},{"x":1696262400000,"y":1.0577,"equityReturn":-0.69,"unitMoney":""},{"x":1696348800000,"y":1.0531,"equityReturn":-0.44,"unitMoney":""},{"x":1696435200000,"y":1.0609,"equityReturn":0.74,"unitMoney":""},{"x":1696521600000,"y":1.0445,"equityReturn":-1.55,"unitMoney":""},{"x":1696780800000,"y":1.0126,"equityReturn":-3.05,"unitMoney":""},{"x":1696867200000,"y":1.0009,"equityReturn":-1.15,"unitMoney":""},{"x":1696953600000,"y":1.0018,"equityReturn":0.09,"unitMoney":""},{"x":1697040000000,"y":1.0007,"equityReturn":-0.11,"unitMoney":""},{"x":1697126400000,"y":1.0204,"equityReturn":1.97,"unitMoney":""},{"x":1697385600000,"y":1.0138,"equityReturn":-0.65,"unitMoney":""},{"x":1697472000000,"y":1.0309,"equityReturn":1.69,"unitMoney":""},{"x":1697558400000,"y":1.0262,"equityReturn":-0.46,"unitMoney":""},{"x":1697644800000,"y":1.0389,"equityReturn":1.24,"unitMoney":""},{"x":1697731200000,"y":1.0549,"equityReturn":1.54,"unitMoney":""},{"x":1697990400000,"y":1.0589,"equityReturn":0.38,"unitMoney":""},{"x":1698076800000,"y":1.0544,"equityReturn":-0.42,"unitMoney":""},{"x":1698163200000,"y":1.0736,"equityReturn":1.82,"unitMoney":""},{"x":1698249600000,"y":1.074,"equityReturn":0.04,"unitMoney":""},{"x":1698336000000,"y":1.05,"equityReturn":-2.23,"unitMoney":""},{"x":1698595200000,"y":1.0662,"equityReturn":1.55,"unitMoney":""},{"x":1698681600000,"y":1.0699,"equityReturn":0.35,"unitMoney":""},{"x":1698768000000,"y":1.0815,"equityReturn":1.08,"unitMoney":""},{"x":1698854400000,"y":1.0931,"equityReturn":1.07,"unitMoney":""},{"x":1698940800000,"y":1.1005,"equityReturn":0.67,"unitMoney":""},{"x":1699200000000,"y":1.0909,"equityReturn":-0.87,"unitMoney":""},{"x":1699286400000,"y":1.1046,"equityReturn":1.26,"unitMoney":""},{"x":1699372800000,"y":1.1305,"equityReturn":2.34,"unitMoney":""},{"x":1699459200000,"y":1.1505,"equityReturn":1.77,"unitMoney":""},{"x":1699545600000,"y":1.1667,"equityReturn":1.4,"unitMoney":""},{"x":1699804800000,"y":1.1814,"equityReturn":1.26,"unitMoney":""},{"x":1699891200000,"y":1.1652,"equityReturn":-1.37,"unitMoney":""},{"x":1699977600000,"y":1.1816,"equityReturn":1.41,"unitMoney":""},{"x":1700064000000,"y":1.183,"equityReturn":0.12,"unitMoney":""},{"x":1700150400000,"y":1.1711,"equityReturn":-1.0,"unitMoney":""},{"x":1700409600000,"y":1.1861,"equityReturn":1.28,"unitMoney":""},{"x":1700496000000,"y":1.1891,"equityReturn":0.26,"unitMoney":""},{"x":1700582400000,"y":1.1953,"equityReturn":0.52,"unitMoney":""},{"x":1700668800000,"y":1.2052,"equityReturn":0.83,"unitMoney":""},{"x":1700755200000,"y":1.1709,"equityReturn":-2.85,"unitMoney":""},{"x":1701014400000,"y":1.1517,"equityReturn":-1.64,"unitMoney":""},{"x":1701100800000,"y":1.1532,"equityReturn":0.13,"unitMoney":""},{"x":1701187200000,"y":1.1466,"equityReturn":-0.57,"unitMoney":""},{"x":1701273600000,"y":1.135,"equityReturn":-1.01,"unitMoney":""},{"x":1701360000000,"y":1.1228,"equityReturn":-1.08,"unitMoney":""},{"x":1701619200000,"y":1.1212,"equityReturn":-0.14,"unitMoney":""},{"x":1701705600000,"y":1.1161,"equityReturn":-0.45,"unitMoney":""},{"x":1701792000000,"y":1.1075,"equityReturn":-0.77,"unitMoney":""},{"x":1701878400000,"y":1.1252,"equityReturn":1.6,"unitMoney":""},{"x":1701964800000,"y":1.1385,"equityReturn":1.18,"unitMoney":""},{"x":1702224000000,"y":1.1403,"equityReturn":0.16,"unitMoney":""},{"x":1702310400000,"y":1.1219,"equityReturn":-1.61,"unitMoney":""},{"x":1702396800000,"y":1.1258,"equityReturn":0.35,"unitMoney":""},{"x":1702483200000,"y":1.1349,"equityReturn":0.81,"unitMoney":""},{"x":1702569600000,"y":1.1215,"equityReturn":-1.18,"unitMoney":""},{"x":1702828800000,"y":1.1299,"equityReturn":0.75,"unitMoney":""},{"x":1702915200000,"y":1.1277,"equityReturn":-0.2,"unitMoney":""},{"x":1703001600000,"y":1.117,"equityReturn":-0.94,"unitMoney":""},{"x":1703088000000,"y":1.1257,"equityReturn":0.78,"unitMoney":""},{"x":1703174400000,"y":1.1151,"equityReturn":-0.94,"unitMoney":""},{"x":1703433600000,"y":1.1159,"equityReturn":0.07,"unitMoney":""},{"x":1703520000000,"y":1.0956,"equityReturn":-1.82,"unitMoney":""},{"x":1703606400000,"y":1.1054,"equityReturn":0.89,"unitMoney":""},{"x":1703692800000,"y":1.1316,"equityReturn":2.37,"unitMoney":""},{"x":1703779200000,"y":1.1241,"equityReturn":-0.66,"unitMoney":""},{"x":1704038400000,"y":1.1303,"equityReturn":0.55,"unitMoney":""},{"x":1704124800000,"y":1.1323,"equityReturn":0.18,"unitMoney":""},{"x":1704211200000,"y":1.1044,"equityReturn":-2.47,"unitMoney":""},{"x":1704297600000,"y":1.1297,"equityReturn":2.29,"unitMoney":""},{"x":1704384000000,"y":1.134,"equityReturn":0.38,"unitMoney":""},{"x":1704643200000,"y":1.1519,"equityReturn":1.58,"unitMoney":""},{"x":1704729600000,"y":1.1457,"equityReturn":-0.54,"unitMoney":""},{"x":1704816000000,"y":1.1441,"equityReturn":-0.14,"unitMoney":""},{"x":1704902400000,"y":1.1811,"equityReturn":3.24,"unitMoney":""},{"x":1704988800000,"y":1.1633,"equityReturn":-1.51,"unitMoney":""},{"x":1705248000000,"y":1.1596,"equityReturn":-0.31,"unitMoney":""},{"x":1705334400000,"y":1.1601,"equityReturn":0.05,"unitMoney":""},{"x":1705420800000,"y":1.1589,"equityReturn":-0.1,"unitMoney":""},{"x":1705507200000,"y":1.1099,"equityReturn":0.08,"unitMoney":"每份派现金0.0500元"},{"x":1705593600000,"y":1.091,"equityReturn":-1.71,"unitMoney":""},{"x":1705852800000,"y":1.0712,"equityReturn":-1.82,"unitMoney":""},{"x":1705939200000,"y":1.0641,"equityReturn":-0.66,"unitMoney":""},{"x":1706025600000,"y":1.0661,"equityReturn":0.19,"unitMoney":""},{"x":1706112000000,"y":1.0718,"equityReturn":0.53,"unitMoney":""},{"x":1706198400000,"y":1.0766,"equityReturn":0.45,"unitMoney":""},{"x":1706457600000,"y":1.0859,"equityReturn":0.86,"unitMoney":""},{"x":1706544000000,"y":1.0792,"equityReturn":-0.62,"unitMoney":""},{"x":1706630400000,"y":1.0836,"equityReturn":0.41,"unitMoney":""},{"x":1706716800000,"y":1.0944,"equityReturn":1.0,"unitMoney":""},{"x":1706803200000,"y":1.0772,"equityReturn":-1.57,"unitMoney":""},{"x":1707062400000,"y":1.0707,"equityReturn":-0.61,"unitMoney":""},{"x":1707148800000,"y":1.0772,"equityReturn":0.61,"unitMoney":""},{"x":1707235200000,"y":1.0568,"equityReturn":-1.89,"unitMoney":""},{"x":1707321600000,"y":1.0582,"equityReturn":0.14,"unitMoney":""},{"x":1707408000000,"y":1.0454,"equityReturn":-1.21,"unitMoney":""},{"x":1707667200000,"y":1.0518,"equityReturn":0.61,"unitMoney":""},{"x":1707753600000,"y":1.0459,"equityReturn":-0.56,"unitMoney":""},{"x":1707840000000,"y":1.0422,"equityReturn":-0.35,"unitMoney":""},{"x":1707926400000,"y":1.0367,"equityReturn":-0.52,"unitMoney":""},{"x":1708012800000,"y":1.0392,"equityReturn":0.24,"unitMoney":""},{"x":1708272000000,"y":1.0451,"equityReturn":0.57,"unitMoney":""},{"x":1708358400000,"y":1.0299,"equityReturn":-1.45,"unitMoney":""},{"x":1708444800000,"y":1.019,"equityReturn":-1.06,"unitMoney":""},{"x":1708531200000,"y":1.0331,"equityReturn":1.38,"unitMoney":""},{"x":1708617600000,"y":1.0498,"equityReturn":1.61,"unitMoney":""},{"x":1708876800000,"y":1.0412,"equityReturn":-0.82,"unitMoney":""},{"x":1708963200000,"y":1.0297,"equityReturn":-1.1,"unitMoney":""},{"x":1709049600000,"y":1.029,"equityReturn":-0.07,"unitMoney":""},{"x":1709136000000,"y":1.0116,"equityReturn":-1.69,"unitMoney":""},{"x":1709222400000,"y":1.0066,"equityReturn":-0.5,"unitMoney":""},{"x":1709481600000,"y":0.992,"equityReturn":-1.45,"unitMoney":""},{"x":1709568000000,"y":1.0133,"equityReturn":2.15,"unitMoney":""},{"x":1709654400000,"y":1.0005,"equityReturn":-1.26,"unitMoney":""},{"x":1709740800000,"y":1.0031,"equityReturn":0.26,"unitMoney":""},{"x":1709827200000,"y":1.0018,"equityReturn":-0.13,"unitMoney":""},{"x":1710086400000,"y":0.9981,"equityReturn":-0.37,"unitMoney":""},{"x":1710172800000,"y":0.9811,"equityReturn":-1.71,"unitMoney":""},{"x":1710259200000,"y":0.9948,"equityReturn":1.4,"unitMoney":""},{"x":1710345600000,"y":1.0094,"equityReturn":1.47,"unitMoney":""},{"x":1710432000000,"y":0.9996,"equityReturn":-0.97,"unitMoney":""},{"x":1710691200000,"y":1.0146,"equityReturn":1.5,"unitMoney":""},{"x":1710777600000,"y":1.025,"equityReturn":1.03,"unitMoney":""},{"x":1710864000000,"y":1.0224,"equityReturn":-0.25,"unitMoney":""},{"x":1710950400000,"y":1.037,"equityReturn":1.43,"unitMoney":""},{"x":1711036800000,"y":1.0433,"equityReturn":0.61,"unitMoney":""},{"x":1711296000000,"y":1.0377,"equityReturn":-0.54,"unitMoney":""},{"x":1711382400000,"y":1.0138,"equityReturn":-2.3,"unitMoney":""},{"x":1711468800000,"y":0.9958,"equityReturn":-1.77,"unitMoney":""},{"x":1711555200000,"y":0.9776,"equityReturn":-1.83,"unitMoney":""},{"x":1711641600000,"y":1.0087,"equityReturn":3.18,"unitMoney":""},{"x":1711900800000,"y":1.0124,"equityReturn":0.37,"unitMoney":""},{"x":1711987200000,"y":1.0055,"equityReturn":-0.68,"unitMoney":""},{"x":1712073600000,"y":0.9857,"equityReturn":-1.97,"unitMoney":""},{"x":1712160000000,"y":1.0204,"equityReturn":3.52,"unitMoney":""},{"x":1712246400000,"y":1.0146,"equityReturn":-0.57,"unitMoney":""},{"x":1712505600000,"y":1.0353,"equityReturn":2.04,"unitMoney":""},{"x":1712592000000,"y":1.0318,"equityReturn":-0.34,"unitMoney":""},{"x":1712678400000,"y":1.0367,"equityReturn":0.48,"unitMoney":""},{"x":1712764800000,"y":1.0324,"equityReturn":-0.42,"unitMoney":""},{"x":1712851200000,"y":1.0426,"equityReturn":0.98,"unitMoney":""},{"x":1713110400000,"y":1.0303,"equityReturn":-1.18,"unitMoney":""},{"x":1713196800000,"y":1.0375,"equityReturn":0.7,"unitMoney":""},{"x":1713283200000,"y":1.0603,"equityReturn":2.19,"unitMoney":""},{"x":1713369600000,"y":1.0586,"equityReturn":-0.16,"unitMoney":""},{"x":1713456000000,"y":1.0777,"equityReturn":1.8,"unitMoney":""},{"x":1713715200000,"y":1.0718,"equityReturn":-0.55,"unitMoney":""},{"x":1713801600000,"y":1.0712,"equityReturn":-0.06,"unitMoney":""},{"x":1713888000000,"y":1.0679,"equityReturn":-0.31,"unitMoney":""},{"x":1713974400000,"y":1.0745,"equityReturn":0.61,"unitMoney":""},{"x":1714060800000,"y":1.0743,"equityReturn":-0.01,"unitMoney":""},{"x":1714320000000,"y":1.0999,"equityReturn":2.38,"unitMoney":""},{"x":1714406400000,"y":1.103,"equityReturn":0.28,"unitMoney":""},{"x":1714492800000,"y":1.1049,"equityReturn":0.17,"unitMoney":""},{"x":1714579200000,"y":1.0795,"equityReturn":-2.3,"unitMoney":""},{"x":1714665600000,"y":1.0755,"equityReturn":-0.37,"unitMoney":""},{"x":1714924800000,"y":1.0787,"equityReturn":0.29,"unitMoney":""},{"x":1715011200000,"y":1.069,"equityReturn":-0.9,"unitMoney":""},{"x":1715097600000,"y":1.0441,"equityReturn":-2.33,"unitMoney":""},{"x":1715184000000,"y":1.0376,"equityReturn":-0.63,"unitMoney":""},{"x":1715270400000,"y":1.0439,"equityReturn":0.61,"unitMoney":""},{"x":1715529600000,"y":1.0582,"equityReturn":1.37,"unitMoney":""},{"x":1715616000000,"y":1.0673,"equityReturn":0.86,"unitMoney":""},{"x":1715702400000,"y":1.0727,"equityReturn":0.51,"unitMoney":""},{"x":1715788800000,"y":1.0847,"equityReturn":1.12,"unitMoney":""},{"x":1715875200000,"y":1.0963,"equityReturn":1.07,"unitMoney":""},{"x":1716134400000,"y":1.0916,"equityReturn":-0.42,"unitMoney":""},{"x":1716220800000,"y":1.1047,"equityReturn":1.2,"unitMoney":""},{"x":1716307200000,"y":1.1072,"equityReturn":0.23,"unitMoney":""},{"x":1716393600000,"y":1.0988,"equityReturn":-0.75,"unitMoney":""},{"x":1716480000000,"y":1.0846,"equityReturn":-1.29,"unitMoney":""},{"x":1716739200000,"y":1.0654,"equityReturn":-1.77,"unitMoney":""},{"x":1716825600000,"y":1.0728,"equityReturn":0.69,"unitMoney":""},{"x":1716912000000,"y":1.0707,"equityReturn":-0.19,"unitMoney":""},{"x":1716998400000,"y":1.0811,"equityReturn":0.97,"unitMoney":""},{"x":1717084800000,"y":1.0927,"equityReturn":1.07,"unitMoney":""},{"x":1717344000000,"y":1.0811,"equityReturn":-1.07,"unitMoney":""},{"x":1717430400000,"y":1.0887,"equityReturn":0.7,"unitMoney":""},{"x":1717516800000,"y":1.1046,"equityReturn":1.46,"unitMoney":""},{"x":1717603200000,"y":1.1004,"equityReturn":-0.38,"unitMoney":""},{"x":1717689600000,"y":1.1079,"equityReturn":0.68,"unitMoney":""},{"x":1717948800000,"y":1.1058,"equityReturn":-0.19,"unitMoney":""},{"x":1718035200000,"y":1.0938,"equityReturn":-1.09,"unitMoney":""},{"x":1718121600000,"y":1.0923,"equityReturn":-0.14,"unitMoney":""},{"x":1718208000000,"y":1.0698,"equityReturn":-2.06,"unitMoney":""},{"x":1718294400000,"y":1.0796,"equityReturn":0.91,"unitMoney":""},{"x":1718553600000,"y":1.0728,"equityReturn":-0.63,"unitMoney":""},{"x":1718640000000,"y":1.0809,"equityReturn":0.76,"unitMoney":""},{"x":1718726400000,"y":1.0692,"equityReturn":-1.08,"unitMoney":""},{"x":1718812800000,"y":1.0777,"equityReturn":0.8,"unitMoney":""},{"x":1718899200000,"y":1.0849,"equityReturn":0.66,"unitMoney":""},{"x":1719158400000,"y":1.0815,"equityReturn":-0.31,"unitMoney":""},{"x":1719244800000,"y":1.0892,"equityReturn":0.72,"unitMoney":""},{"x":1719331200000,"y":1.0741,"equityReturn":-1.39,"unitMoney":""},{"x":1719417600000,"y":1.0494,"equityReturn":-2.3,"unitMoney":""},{"x":1719504000000,"y":1.0337,"equityReturn":-1.5,"unitMoney":""},{"x":1719763200000,"y":1.0217,"equityReturn":-1.16,"unitMoney":""},{"x":1719849600000,"y":1.0173,"equityReturn":-0.43,"unitMoney":""},{"x":1719936000000,"y":1.0136,"equityReturn":-0.36,"unitMoney":""},{"x":1720022400000,"y":0.9937,"equityReturn":-1.96,"unitMoney":""},{"x":1720108800000,"y":0.9805,"equityReturn":-1.33,"unitMoney":""},{"x":1720368000000,"y":0.9821,"equityReturn":0.16,"unitMoney":""},{"x":1720454400000,"y":0.9594,"equityReturn":-2.31,"unitMoney":""},{"x":1720540800000,"y":0.9478,"equityReturn":-1.2,"unitMoney":""},{"x":1720627200000,"y":0.9481,"equityReturn":0.03,"unitMoney":""},{"x":1720713600000,"y":0.9373,"equityReturn":-1.14,"unitMoney":""},{"x":1720972800000,"y":0.9377,"equityReturn":0.04,"unitMoney":""},{"x":1721059200000,"y":0.946,"equityReturn":0.88,"unitMoney":""},{"x":1721145600000,"y":0.9474,"equityReturn":0.15,"unitMoney":""},{"x":1721232000000,"y":0.9537,"equityReturn":0.66,"unitMoney":""},{"x":1721318400000,"y":0.9493,"equityReturn":-0.46,"unitMoney":""},{"x":1721577600000,"y":0.9788,"equityReturn":3.11,"unitMoney":""},{"x":1721664000000,"y":1.0039,"equityReturn":2.57,"unitMoney":""},{"x":1721750400000,"y":1.0232,"equityReturn":1.92,"unitMoney":""},{"x":1721836800000,"y":1.022,"equityReturn":-0.12,"unitMoney":""},{"x":1721923200000,"y":1.023,"equityReturn":0.1,"unitMoney":""},{"x":1722182400000,"y":1.0205,"equityReturn":-0.25,"unitMoney":""},{"x":1722268800000,"y":1.0293,"equityReturn":0.87,"unitMoney":""},{"x":1722355200000,"y":1.0211,"equityReturn":-0.79,"unitMoney":""},{"x":1722441600000,"y":1.0297,"equityReturn":0.84,"unitMoney":""},{"x":1722528000000,"y":1.0249,"equityReturn":-0.47,"unitMoney":""},{"x":1722787200000,"y":1.0341,"equityReturn":0.9,"unitMoney":""},{"x":1722873600000,"y":1.0533,"equityReturn":1.86,"unitMoney":""},{"x":1722960000000,"y":1.0536,"equityReturn":0.03,"unitMoney":""},{"x":1723046400000,"y":1.0462,"equityReturn":-0.7,"unitMoney":""},{"x":1723132800000,"y":1.051,"equityReturn":0.46,"unitMoney":""},{"x":1723392000000,"y":1.0611,"equityReturn":0.96,"unitMoney":""},{"x":1723478400000,"y":1.0735,"equityReturn":1.16,"unitMoney":""},{"x":1723564800000,"y":1.0786,"equityReturn":0.48,"unitMoney":""},{"x":1723651200000,"y":1.0897,"equityReturn":1.03,"unitMoney":""},{"x":1723737600000,"y":1.1031,"equityReturn":1.23,"unitMoney":""},{"x":1723996800000,"y":1.1204,"equityReturn":1.57,"unitMoney":""},{"x":1724083200000,"y":1.1047,"equityReturn":-1.4,"unitMoney":""},{"x":1724169600000,"y":1.1144,"equityReturn":0.87,"unitMoney":""},{"x":1724256000000,"y":1.1196,"equityReturn":0.47,"unitMoney":""},{"x":1724342400000,"y":1.1189,"equityReturn":-0.07,"unitMoney":""},{"x":1724601600000,"y":1.1307,"equityReturn":1.06,"unitMoney":""},{"x":1724688000000,"y":1.1147,"equityReturn":-1.41,"unitMoney":""},{"x":1724774400000,"y":1.1022,"equityReturn":-1.12,"unitMoney":""},{"x":1724860800000,"y":1.1193,"equityReturn":1.55,"unitMoney":""},{"x":1724947200000,"y":1.1071,"equityReturn":-1.09,"unitMoney":""},{"x":1725206400000,"y":1.1287,"equityReturn":1.95,"unitMoney":""},{"x":1725292800000,"y":1.1405,"equityReturn":1.04,"unitMoney":""},{"x":1725379200000,"y":1.1388,"equityReturn":-0.15,"unitMoney":""},{"x":1725465600000,"y":1.1206,"equityReturn":-1.59,"unitMoney":""},{"x":1725552000000,"y":1.0887,"equityReturn":-2.84,"unitMoney":""},{"x":1725811200000,"y":1.0881,"equityReturn":-0.06,"unitMoney":""},{"x":1725897600000,"y":1.0589,"equityReturn":-2.68,"unitMoney":""},{"x":1725984000000,"y":1.075,"equityReturn":1.52,"unitMoney":""},{"x":1726070400000,"y":1.0421,"equityReturn":-3.06,"unitMoney":""},{"x":1726156800000,"y":1.0388,"equityReturn":-0.32,"unitMoney":""},{"x":1726416000000,"y":1.0108,"equityReturn":-2.69,"unitMoney":""},{"x":1726502400000,"y":1.0105,"equityReturn":-0.03,"unitMoney":""},{"x":1726588800000,"y":1.0254,"equityReturn":1.48,"unitMoney":""},{"x":1726675200000,"y":1.0191,"equityReturn":-0.62,"unitMoney":""},{"x":1726761600000,"y":1.0013,"equityReturn":-1.75,"unitMoney":""},{"x":1727020800000,"y":1.0071,"equityReturn":0.58,"unitMoney":""},{"x":1727107200000,"y":1.0147,"equityReturn":0.75,"unitMoney":""},{"x":1727193600000,"y":1.0317,"equityReturn":1.67,"unitMoney":""},{"x":1727280000000,"y":1.0237,"equityReturn":-0.77,"unitMoney":""},{"x":1727366400000,"y":0.9992,"equityReturn":-2.39,"unitMoney":""},{"x":1727625600000,"y":0.992,"equityReturn":-0.73,"unitMoney":""},{"x":1727712000000,"y":1.0077,"equityReturn":1.58,"unitMoney":""},{"x":1727798400000,"y":1.0396,"equityReturn":3.17,"unitMoney":""},{"x":1727884800000,"y":1.0299,"equityReturn":-0.93,"unitMoney":""},{"x":1727971200000,"y":1.0318,"equityReturn":0.18,"unitMoney":""},{"x":1728230400000,"y":1.0296,"equityReturn":-0.21,"unitMoney":""},{"x":1728316800000,"y":1.0276,"equityReturn":-0.19,"unitMoney":""},{"x":1728403200000,"y":1.037,"equityReturn":0.91,"unitMoney":""},{"x":1728489600000,"y":1.0189,"equityReturn":-1.74,"unitMoney":""},{"x":1728576000000,"y":1.0162,"equityReturn":-0.27,"unitMoney":""},{"x":1728835200000,"y":0.996,"equityReturn":-1.99,"unitMoney":""},{"x":1728921600000,"y":0.9887,"equityReturn":-0.73,"unitMoney":""},{"x":1729008000000,"y":0.9883,"equityReturn":-0.04,"unitMoney":""},{"x":1729094400000,"y":0.9977,"equityReturn":0.95,"unitMoney":""},{"x":1729180800000,"y":0.9927,"equityReturn":-0.5,"unitMoney":""},{"x":1729440000000,"y":0.9985,"equityReturn":0.59,"unitMoney":""},{"x":1729526400000,"y":1.021,"equityReturn":2.26,"unitMoney":""},{"x":1729612800000,"y":1.019,"equityReturn":-0.2,"unitMoney":""},{"x":1729699200000,"y":1.0241,"equityReturn":0.5,"unitMoney":""},{"x":1729785600000,"y":1.0224,"equityReturn":-0.17,"unitMoney":""},{"x":1730044800000,"y":1.0138,"equityReturn":-0.84,"unitMoney":""},{"x":1730131200000,"y":1.0183,"equityReturn":0.44,"unitMoney":""},{"x":1730217600000,"y":1.0245,"equityReturn":0.61,"unitMoney":""},{"x":1730304000000,"y":1.0277,"equityReturn":0.31,"unitMoney":""},{"x":1730390400000,"y":1.03,"equityReturn":0.22,"unitMoney":""},{"x":1730649600000,"y":1.0226,"equityReturn":-0.72,"unitMoney":""},{"x":1730736000000,"y":1.033,"equityReturn":1.02,"unitMoney":""},{"x":1730822400000,"y":1.0322,"equityReturn":-0.08,"unitMoney":""},{"x":1730908800000,"y":1.039,"equityReturn":0.65,"unitMoney":""},{"x":1730995200000,"y":1.0309,"equityReturn":-0.78,"unitMoney":""},{"x":1731254400000,"y":1.0199,"equityReturn":-1.07,"unitMoney":""},{"x":1731340800000,"y":1.0111,"equityReturn":-0.86,"unitMoney":""},{"x":1731427200000,"y":1.0271,"equityReturn":1.59,"unitMoney":""},{"x":1731513600000,"y":1.0327,"equityReturn":0.54,"unitMoney":""},{"x":1731600000000,"y":1.0312,"equityReturn":-0.15,"unitMoney":""},{"x":1731859200000,"y":1.0375,"equityReturn":0.61,"unitMoney":""},{"x":1731945600000,"y":1.0111,"equityReturn":-2.55,"unitMoney":""},{"x":1732032000000,"y":1.0065,"equityReturn":-0.46,"unitMoney":""},{"x":1732118400000,"y":1.0012,"equityReturn":-0.53,"unitMoney":""},{"x":1732204800000,"y":0.9714,"equityReturn":-2.98,"unitMoney":""},{"x":1732464000000,"y":0.9698,"equityReturn":-0.16,"unitMoney":""},{"x":1732550400000,"y":0.9613,"equityReturn":-0.87,"unitMoney":""},{"x":1732636800000,"y":0.969,"equityReturn":0.8,"unitMoney":""},{"x":1732723200000,"y":0.9596,"equityReturn":-0.97,"unitMoney":""},{"x":1732809600000,"y":0.9513,"equityReturn":-0.87,"unitMoney":""},{"x":1733068800000,"y":0.9646,"equityReturn":1.4,"unitMoney":""},{"x":1733155200000,"y":0.9682,"equityReturn":0.37,"unitMoney":""},{"x":1733241600000,"y":0.9536,"equityReturn":-1.5,"unitMoney":""},{"x":1733328000000,"y":0.9514,"equityReturn":-0.23,"unitMoney":""},{"x":1733414400000,"y":0.9427,"equityReturn":-0.92,"unitMoney":""},{"x":1733673600000,"y":0.9606,"equityReturn":1.9,"unitMoney":""},{"x":1733760000000,"y":0.9536,"equityReturn":-0.73,"unitMoney":""},{"x":1733846400000,"y":0.9475,"equityReturn":-0.64,"unitMoney":""},{"x":1733932800000,"y":0.9143,"equityReturn":-3.5,"unitMoney":""},{"x":1734019200000,"y":0.9001,"equityReturn":-1.55,"unitMoney":""},{"x":1734278400000,"y":0.9173,"equityReturn":1.91,"unitMoney":""},{"x":1734364800000,"y":0.9121,"equityReturn":-0.56,"unitMoney":""},{"x":1734451200000,"y":0.8963,"equityReturn":-1.73,"unitMoney":""},{"x":1734537600000,"y":0.8988,"equityReturn":0.28,"unitMoney":""},{"x":1734624000000,"y":0.8934,"equityReturn":-0.6,"unitMoney":""},{"x":1734883200000,"y":0.8937,"equityReturn":0.04,"unitMoney":""},{"x":1734969600000,"y":0.9217,"equityReturn":3.14,"unitMoney":""},{"x":1735056000000,"y":0.9405,"equityReturn":2.04,"unitMoney":""},{"x":1735142400000,"y":0.9707,"equityReturn":3.21,"unitMoney":""},{"x":1735228800000,"y":0.9872,"equityReturn":1.7,"unitMoney":""},{"x":1735488000000,"y":0.9837,"equityReturn":-0.36,"unitMoney":""},{"x":1735574400000,"y":0.9629,"equityReturn":-2.11,"unitMoney":""},{"x":1735660800000,"y":0.9776,"equityReturn":1.53,"unitMoney":""},{"x":1735747200000,"y":0.9682,"equityReturn":-0.96,"unitMoney":""},{"x":1735833600000,"y":0.9645,"equityReturn":-0.38,"unitMoney":""},{"x":1736092800000,"y":0.9648,"equityReturn":0.03,"unitMoney":""},{"x":1736179200000,"y":0.9763,"equityReturn":1.19,"unitMoney":""},{"x":1736265600000,"y":0.9964,"equityReturn":2.06,"unitMoney":""},{"x":1736352000000,"y":1.0015,"equityReturn":0.51,"unitMoney":""},{"x":1736438400000,"y":1.0149,"equityReturn":1.34,"unitMoney":""},{"x":1736697600000,"y":1.0175,"equityReturn":0.25,"unitMoney":""},{"x":1736784000000,"y":1.0192,"equityReturn":0.17,"unitMoney":""},{"x":1736870400000,"y":1.0386,"equityReturn":1.9,"unitMoney":""},{"x":1736956800000,"y":1.005,"equityReturn":-0.35,"unitMoney":"每份派现金0.0300元"},{"x":1737043200000,"y":1.0308,"equityReturn":2.57,"unitMoney":""},{"x":1737302400000,"y":1.0319,"equityReturn":0.1,"unitMoney":""},{"x":1737388800000,"y":1.0402,"equityReturn":0.8,"unitMoney":""},{"x":1737475200000,"y":1.0472,"equityReturn":0.67,"unitMoney":""},{"x":1737561600000,"y":1.0432,"equityReturn":-0.38,"unitMoney":""},{"x":1737648000000,"y":1.0545,"equityReturn":1.08,"unitMoney":""},{"x":1737907200000,"y":1.0493,"equityReturn":-0.5,"unitMoney":""},{"x":1737993600000,"y":1.0496,"equityReturn":0.03,"unitMoney":""},{"x":1738080000000,"y":1.0652,"equityReturn":1.49,"unitMoney":""},{"x":1738166400000,"y":1.0906,"equityReturn":2.38,"unitMoney":""},{"x":1738252800000,"y":1.092,"equityReturn":0.13,"unitMoney":""},{"x":1738512000000,"y":1.0808,"equityReturn":-1.02,"unitMoney":""},{"x":1738598400000,"y":1.0748,"equityReturn":-0.56,"unitMoney":""},{"x":1738684800000,"y":1.0577,"equityReturn":-1.59,"unitMoney":""},{"x":1738771200000,"y":1.0617,"equityReturn":0.38,"unitMoney":""},{"x":1738857600000,"y":1.0836,"equityReturn":2.06,"unitMoney":""},{"x":1739116800000,"y":1.0987,"equityReturn":1.4,"unitMoney":""},{"x":1739203200000,"y":1.1035,"equityReturn":0.44,"unitMoney":""},{"x":1739289600000,"y":1.1121,"equityReturn":0.78,"unitMoney":""},{"x":1739376000000,"y":1.1148,"equityReturn":0.25,"unitMoney":""},{"x":1739462400000,"y":1.1326,"equityReturn":1.6,"unitMoney":""},{"x":1739721600000,"y":1.1309,"equityReturn":-0.15,"unitMoney":""},{"x":1739808000000,"y":1.1232,"equityReturn":-0.68,"unitMoney":""},{"x":1739894400000,"y":1.1321,"equityReturn":0.8,"unitMoney":""},{"x":1739980800000,"y":1.1447,"equityReturn":1.11,"unitMoney":""},{"x":1740067200000,"y":1.1288,"equityReturn":-1.39,"unitMoney":""},{"x":1740326400000,"y":1.1351,"equityReturn":0.56,"unitMoney":""},{"x":1740412800000,"y":1.1208,"equityReturn":-1.26,"unitMoney":""},{"x":1740499200000,"y":1.1229,"equityReturn":0.19,"unitMoney":""},{"x":1740585600000,"y":1.125,"equityReturn":0.19,"unitMoney":""},{"x":1740672000000,"y":1.143,"equityReturn":1.6,"unitMoney":""},{"x":1740931200000,"y":1.1597,"equityReturn":1.46,"unitMoney":""},{"x":1741017600000,"y":1.1645,"equityReturn":0.41,"unitMoney":""},{"x":1741104000000,"y":1.1664,"equityReturn":0.16,"unitMoney":""},{"x":1741190400000,"y":1.1553,"equityReturn":-0.95,"unitMoney":""},{"x":1741276800000,"y":1.1789,"equityReturn":2.04,"unitMoney":""},{"x":1741536000000,"y":1.1668,"equityReturn":-1.03,"unitMoney":""},{"x":1741622400000,"y":1.1783,"equityReturn":0.99,"unitMoney":""},{"x":1741708800000,"y":1.1554,"equityReturn":-1.94,"unitMoney":""},{"x":1741795200000,"y":1.169,"equityReturn":1.17,"unitMoney":""},{"x":1741881600000,"y":1.1633,"equityReturn":-0.49,"unitMoney":""},{"x":1742140800000,"y":1.1608,"equityReturn":-0.21,"unitMoney":""},{"x":1742227200000,"y":1.1603,"equityReturn":-0.04,"unitMoney":""},{"x":1742313600000,"y":1.1539,"equityReturn":-0.55,"unitMoney":""},{"x":1742400000000,"y":1.1474,"equityReturn":-0.57,"unitMoney":""},{"x":1742486400000,"y":1.0996,"equityReturn":-4.17,"unitMoney":""},{"x":1742745600000,"y":1.1101,"equityReturn":0.96,"unitMoney":""},{"x":1742832000000,"y":1.1262,"equityReturn":1.45,"unitMoney":""},{"x":1742918400000,"y":1.1204,"equityReturn":-0.52,"unitMoney":""},{"x":1743004800000,"y":1.1014,"equityReturn":-1.69,"unitMoney":""},{"x":1743091200000,"y":1.116,"equityReturn":1.32,"unitMoney":""},{"x":1743350400000,"y":1.112,"equityReturn":-0.36,"unitMoney":""},{"x":1743436800000,"y":1.1148,"equityReturn":0.26,"unitMoney":""},{"x":1743523200000,"y":1.1132,"equityReturn":-0.15,"unitMoney":""},{"x":1743609600000,"y":1.0976,"equityReturn":-1.41,"unitMoney":""},{"x":1743696000000,"y":1.0916,"equityReturn":-0.55,"unitMoney":""},{"x":1743955200000,"y":1.081,"equityReturn":-0.97,"unitMoney":""},{"x":1744041600000,"y":1.0659,"equityReturn":-1.4,"unitMoney":""},{"x":1744128000000,"y":1.0565,"equityReturn":-0.88,"unitMoney":""},{"x":1744214400000,"y":1.0452,"equityReturn":-1.07,"unitMoney":""},{"x":1744300800000,"y":1.0613,"equityReturn":1.54,"unitMoney":""},{"x":1744560000000,"y":1.0775,"equityReturn":1.53,"unitMoney":""},{"x":1744646400000,"y":1.0927,"equityReturn":1.41,"unitMoney":""},{"x":1744732800000,"y":1.0718,"equityReturn":-1.92,"unitMoney":""},{"x":1744819200000,"y":1.0664,"equityReturn":-0.51,"unitMoney":""},{"x":1744905600000,"y":1.0603,"equityReturn":-0.57,"unitMoney":""},{"x":1745164800000,"y":1.0565,"equityReturn":-0.36,"unitMoney":""},{"x":1745251200000,"y":1.0598,"equityReturn":0.31,"unitMoney":""},{"x":1745337600000,"y":1.0523,"equityReturn":-0.7,"unitMoney":""},{"x":1745424000000,"y":1.0449,"equityReturn":-0.7,"unitMoney":""},{"x":1745510400000,"y":1.0599,"equityReturn":1.44,"unitMoney":""},{"x":1745769600000,"y":1.1004,"equityReturn":3.82,"unitMoney":""},{"x":1745856000000,"y":1.1217,"equityReturn":1.94,"unitMoney":""},{"x":1745942400000,"y":1.1184,"equityReturn":-0.29,"unitMoney":""},{"x":1746028800000,"y":1.1275,"equityReturn":0.81,"unitMoney":""},{"x":1746115200000,"y":1.1175,"equityReturn":-0.89,"unitMoney":""},{"x":1746374400000,"y":1.1202,"equityReturn":0.24,"unitMoney":""},{"x":1746460800000,"y":1.1438,"equityReturn":2.11,"unitMoney":""},{"x":1746547200000,"y":1.1421,"equityReturn":-0.15,"unitMoney":""},{"x":1746633600000,"y":1.1355,"equityReturn":-0.57,"unitMoney":""},{"x":1746720000000,"y":1.1474,"equityReturn":1.05,"unitMoney":""},{"x":1746979200000,"y":1.1408,"equityReturn":-0.58,"unitMoney":""},{"x":1747065600000,"y":1.1304,"equityReturn":-0.91,"unitMoney":""},{"x":1747152000000,"y":1.1426,"equityReturn":1.08,"unitMoney":""},{"x":1747238400000,"y":1.1274,"equityReturn":-1.33,"unitMoney":""},{"x":1747324800000,"y":1.1083,"equityReturn":-1.7,"unitMoney":""},{"x":1747584000000,"y":1.1052,"equityReturn":-0.28,"unitMoney":""},{"x":1747670400000,"y":1.0934,"equityReturn":-1.07,"unitMoney":""},{"x":1747756800000,"y":1.084,"equityReturn":-0.86,"unitMoney":""},{"x":1747843200000,"y":1.0793,"equityReturn":-0.44,"unitMoney":""},{"x":1747929600000,"y":1.0823,"equityReturn":0.28,"unitMoney":""},{"x":1748188800000,"y":1.0823,"equityReturn":-0.0,"unitMoney":""},{"x":1748275200000,"y":1.08,"equityReturn":-0.21,"unitMoney":""},{"x":1748361600000,"y":1.0951,"equityReturn":1.39,"unitMoney":""},{"x":1748448000000,"y":1.0872,"equityReturn":-0.72,"unitMoney":""},{"x":1748534400000,"y":1.0959,"equityReturn":0.8,"unitMoney":""},{"x":1748793600000,"y":1.0941,"equityReturn":-0.17,"unitMoney":""},{"x":1748880000000,"y":1.0905,"equityReturn":-0.33,"unitMoney":""},{"x":1748966400000,"y":1.0963,"equityReturn":0.53,"unitMoney":""},{"x":1749052800000,"y":1.0747,"equityReturn":-1.97,"unitMoney":""},{"x":1749139200000,"y":1.0772,"equityReturn":0.24,"unitMoney":""},{"x":1749398400000,"y":1.0697,"equityReturn":-0.69,"unitMoney":""},{"x":1749484800000,"y":1.0539,"equityReturn":-1.47,"unitMoney":""},{"x":1749571200000,"y":1.0612,"equityReturn":0.7,"unitMoney":""},{"x":1749657600000,"y":1.0645,"equityReturn":0.31,"unitMoney":""},{"x":1749744000000,"y":1.0627,"equityReturn":-0.17,"unitMoney":""},{"x":1750003200000,"y":1.0687,"equityReturn":0.57,"unitMoney":""},{"x":1750089600000,"y":1.0805,"equityReturn":1.1,"unitMoney":""},{"x":1750176000000,"y":1.0955,"equityReturn":1.39,"unitMoney":""},{"x":1750262400000,"y":1.1105,"equityReturn":1.37,"unitMoney":""},{"x":1750348800000,"y":1.1074,"equityReturn":-0.28,"unitMoney":""},{"x":1750608000000,"y":1.0984,"equityReturn":-0.81,"unitMoney":""},{"x":1750694400000,"y":1.1065,"equityReturn":0.74,"unitMoney":""},{"x":1750780800000,"y":1.1213,"equityReturn":1.34,"unitMoney":""},{"x":1750867200000,"y":1.1152,"equityReturn":-0.54,"unitMoney":""},{"x":1750953600000,"y":1.1184,"equityReturn":0.29,"unitMoney":""},{"x":1751212800000,"y":1.1112,"equityReturn":-0.64,"unitMoney":""}];/*累计净值走势*/var Data_ACWorthTrend = [[1672675200000,1.123],[1672761600000,1.1505],[1672848000000,1.1459],[1672934400000,1.1496],[1673193600000,1.1496],[1673280000000,1.154],[1673366400000,1.135],[1673452800000,1.1377],[1673539200000,1.1315],[1673798400000,1.1255],[1673884800000,1.117],[1673971200000,1.1136],[1674057600000,1.1116],[1674144000000,1.0897],[1674403200000,1.1006],[1674489600000,1.0976],[1674576000000,1.0626],[1674662400000,1.066],[1674748800000,1.0505],[1675008000000,1.0366],[1675094400000,1.037],[1675180800000,1.0418],[1675267200000,1.0424],[1675353600000,1.0361],[1675612800000,1.0346],[1675699200000,1.0193],[1675785600000,1.0382],[1675872000000,1.0199],[1675958400000,1.0284],[1676217600000,1.0323],[1676304000000,1.0425],[1676390400000,1.0361],[1676476800000,1.0373],[1676563200000,0.994],[1676822400000,0.9911],[1676908800000,0.992],[1676995200000,0.9876],[1677081600000,1.0004],[1677168000000,0.9827],[1677427200000,0.9776],[1677513600000,0.9617],[1677600000000,0.9588],[1677686400000,0.9419],[1677772800000,0.9269],[1678032000000,0.9417],[1678118400000,0.9482],[1678204800000,0.9544],[1678291200000,0.9435],[1678377600000,0.9255],[1678636800000,0.913],[1678723200000,0.9117],[1678809600000,0.8919],[1678896000000,0.8932],[1678982400000,0.867],[1679241600000,0.8714],[1679328000000,0.8631],[1679414400000,0.8838],[1679500800000,0.9004],[1679587200000,0.8961],[1679846400000,0.8768],[1679932800000,0.8612],[1680019200000,0.8628],[1680105600000,0.849],[1680192000000,0.8484],[1680451200000,0.8503],[1680537600000,0.8438],[1680624000000,0.836],[1680710400000,0.8488],[1680796800000,0.8346],[1681056000000,0.8342],[1681142400000,0.8314],[1681228800000,0.8526],[1681315200000,0.8519],[1681401600000,0.8311],[1681660800000,0.8185],[1681747200000,0.8135],[1681833600000,0.8004],[1681920000000,0.793],[1682006400000,0.8034],[1682265600000,0.7884],[1682352000000,0.7878],[1682438400000,0.7867],[1682524800000,0.7866],[1682611200000,0.8003],[1682870400000,0.7907],[1682956800000,0.7981],[1683043200000,0.7978],[1683129600000,0.7904],[1683216000000,0.7937],[1683475200000,0.7945],[1683561600000,0.7915],[1683648000000,0.7849],[1683734400000,0.7996],[1683820800000,0.8122],[1684080000000,0.8103],[1684166400000,0.8137],[1684252800000,0.8136],[1684339200000,0.8119],[1684425600000,0.8378],[1684684800000,0.8278],[1684771200000,0.834],[1684857600000,0.8444],[1684944000000,0.8497],[1685030400000,0.8571],[1685289600000,0.8756],[1685376000000,0.8931],[1685462400000,0.9033],[1685548800000,0.925],[1685635200000,0.928],[1685894400000,0.9311],[1685980800000,0.9377],[1686067200000,0.9485],[1686153600000,0.9406],[1686240000000,0.9395],[1686499200000,0.9535],[1686585600000,0.9506],[1686672000000,0.9512],[1686758400000,0.9654],[1686844800000,0.9593],[1687104000000,0.9762],[1687190400000,0.9713],[1687276800000,0.9538],[1687363200000,0.9462],[1687449600000,0.96],[1687708800000,0.9714],[1687795200000,0.9851],[1687881600000,0.9885],[1687968000000,0.9914],[1688054400000,0.9773],[1688313600000,0.9913],[1688400000000,0.9826],[1688486400000,0.9972],[1688572800000,0.9844],[1688659200000,0.9815],[1688918400000,0.9864],[1689004800000,0.9935],[1689091200000,0.9876],[1689177600000,0.9948],[1689264000000,1.0],[1689523200000,1.0042],[1689609600000,1.0059],[1689696000000,0.9946],[1689782400000,0.9916],[1689868800000,0.9967],[1690128000000,0.9811],[1690214400000,0.9828],[1690300800000,0.9824],[1690387200000,0.9761],[1690473600000,0.9708],[1690732800000,0.9821],[1690819200000,0.9878],[1690905600000,0.9921],[1690992000000,0.9921],[1691078400000,1.0131],[1691337600000,1.0169],[1691424000000,1.0269],[1691510400000,1.0356],[1691596800000,1.0019],[1691683200000,1.0001],[1691942400000,1.0165],[1692028800000,0.9993],[1692115200000,1.0069],[1692201600000,1.012],[1692288000000,1.0118],[1692547200000,1.0327],[1692633600000,1.0238],[1692720000000,1.019],[1692806400000,1.0067],[1692892800000,0.9966],[1693152000000,0.983],[1693238400000,0.9931],[1693324800000,1.0028],[1693411200000,0.987],[1693497600000,0.9893],[1693756800000,0.9838],[1693843200000,0.9868],[1693929600000,0.9703],[1694016000000,0.9771],[1694102400000,0.9884],[1694361600000,0.9887],[1694448000000,0.9977],[1694534400000,0.9906],[1694620800000,0.9901],[1694707200000,0.9841],[1694966400000,1.0078],[1695052800000,1.02],[1695139200000,1.0422],[1695225600000,1.0777],[1695312000000,1.0662],[1695571200000,1.0595],[1695657600000,1.0637],[1695744000000,1.0615],[1695830400000,1.0644],[1695916800000,1.0537],[1696176000000,1.065],[1696262400000,1.0577],[1696348800000,1.0531],[1696435200000,1.0609],[1696521600000,1.0445],[1696780800000,1.0126],[1696867200000,1.0009],[1696953600000,1.0018],[1697040000000,1.0007],[1697126400000,1.0204],[1697385600000,1.0138],[1697472000000,1.0309],[1697558400000,1.0262],[1697644800000,1.0389],[1697731200000,1.0549],[1697990400000,1.0589],[1698076800000,1.0544],[1698163200000,1.0736],[1698249600000,1.074],[1698336000000,1.05],[1698595200000,1.0662],[1698681600000,1.0699],[1698768000000,1.0815],[1698854400000,1.0931],[1698940800000,1.1005],[1699200000000,1.0909],[1699286400000,1.1046],[1699372800000,1.1305],[1699459200000,1.1505],[1699545600000,1.1667],[1699804800000,1.1814],[1699891200000,1.1652],[1699977600000,1.1816],[1700064000000,1.183],[1700150400000,1.1711],[1700409600000,1.1861],[1700496000000,1.1891],[1700582400000,1.1953],[1700668800000,1.2052],[1700755200000,1.1709],[1701014400000,1.1517],[1701100800000,1.1532],[1701187200000,1.1466],[1701273600000,1.135],[1701360000000,1.1228],[1701619200000,1.1212],[1701705600000,1.1161],[1701792000000,1.1075],[1701878400000,1.1252],[1701964800000,1.1385],[1702224000000,1.1403],[1702310400000,1.1219],[1702396800000,1.1258],[1702483200000,1.1349],[1702569600000,1.1215],[1702828800000,1.1299],[1702915200000,1.1277],[1703001600000,1.117],[1703088000000,1.1257],[1703174400000,1.1151],[1703433600000,1.1159],[1703520000000,1.0956],[1703606400000,1.1054],[1703692800000,1.1316],[1703779200000,1.1241],[1704038400000,1.1303],[1704124800000,1.1323],[1704211200000,1.1044],[1704297600000,1.1297],[1704384000000,1.134],[1704643200000,1.1519],[1704729600000,1.1457],[1704816000000,1.1441],[1704902400000,1.1811],[1704988800000,1.1633],[1705248000000,1.1596],[1705334400000,1.1601],[1705420800000,1.1589],[1705507200000,1.1599],[1705593600000,1.141],[1705852800000,1.1212],[1705939200000,1.1141],[1706025600000,1.1161],[1706112000000,1.1218],[1706198400000,1.1266],[1706457600000,1.1359],[1706544000000,1.1292],[1706630400000,1.1336],[1706716800000,1.1444],[1706803200000,1.1272],[1707062400000,1.1207],[1707148800000,1.1272],[1707235200000,1.1068],[1707321600000,1.1082],[1707408000000,1.0954],[1707667200000,1.1018],[1707753600000,1.0959],[1707840000000,1.0922],[1707926400000,1.0867],[1708012800000,1.0892],[1708272000000,1.0951],[1708358400000,1.0799],[1708444800000,1.069],[1708531200000,1.0831],[1708617600000,1.0998],[1708876800000,1.0912],[1708963200000,1.0797],[1709049600000,1.079],[1709136000000,1.0616],[1709222400000,1.0566],[1709481600000,1.042],[1709568000000,1.0633],[1709654400000,1.0505],[1709740800000,1.0531],[1709827200000,1.0518],[1710086400000,1.0481],[1710172800000,1.0311],[1710259200000,1.0448],[1710345600000,1.0594],[1710432000000,1.0496],[1710691200000,1.0646],[1710777600000,1.075],[1710864000000,1.0724],[1710950400000,1.087],[1711036800000,1.0933],[1711296000000,1.0877],[1711382400000,1.0638],[1711468800000,1.0458],[1711555200000,1.0276],[1711641600000,1.0587],[1711900800000,1.0624],[1711987200000,1.0555],[1712073600000,1.0357],[1712160000000,1.0704],[1712246400000,1.0646],[1712505600000,1.0853],[1712592000000,1.0818],[1712678400000,1.0867],[1712764800000,1.0824],[1712851200000,1.0926],[1713110400000,1.0803],[1713196800000,1.0875],[1713283200000,1.1103],[1713369600000,1.1086],[1713456000000,1.1277],[1713715200000,1.1218],[1713801600000,1.1212],[1713888000000,1.1179],[1713974400000,1.1245],[1714060800000,1.1243],[1714320000000,1.1499],[1714406400000,1.153],[1714492800000,1.1549],[1714579200000,1.1295],[1714665600000,1.1255],[1714924800000,1.1287],[1715011200000,1.119],[1715097600000,1.0941],[1715184000000,1.0876],[1715270400000,1.0939],[1715529600000,1.1082],[1715616000000,1.1173],[1715702400000,1.1227],[1715788800000,1.1347],[1715875200000,1.1463],[1716134400000,1.1416],[1716220800000,1.1547],[1716307200000,1.1572],[1716393600000,1.1488],[1716480000000,1.1346],[1716739200000,1.1154],[1716825600000,1.1228],[1716912000000,1.1207],[1716998400000,1.1311],[1717084800000,1.1427],[1717344000000,1.1311],[1717430400000,1.1387],[1717516800000,1.1546],[1717603200000,1.1504],[1717689600000,1.1579],[1717948800000,1.1558],[1718035200000,1.1438],[1718121600000,1.1423],[1718208000000,1.1198],[1718294400000,1.1296],[1718553600000,1.1228],[1718640000000,1.1309],[1718726400000,1.1192],[1718812800000,1.1277],[1718899200000,1.1349],[1719158400000,1.1315],[1719244800000,1.1392],[1719331200000,1.1241],[1719417600000,1.0994],[1719504000000,1.0837],[1719763200000,1.0717],[1719849600000,1.0673],[1719936000000,1.0636],[1720022400000,1.0437],[1720108800000,1.0305],[1720368000000,1.0321],[1720454400000,1.0094],[1720540800000,0.9978],[1720627200000,0.9981],[1720713600000,0.9873],[1720972800000,0.9877],[1721059200000,0.996],[1721145600000,0.9974],[1721232000000,1.0037],[1721318400000,0.9993],[1721577600000,1.0288],[1721664000000,1.0539],[1721750400000,1.0732],[1721836800000,1.072],[1721923200000,1.073],[1722182400000,1.0705],[1722268800000,1.0793],[1722355200000,1.0711],[1722441600000,1.0797],[1722528000000,1.0749],[1722787200000,1.0841],[1722873600000,1.1033],[1722960000000,1.1036],[1723046400000,1.0962],[1723132800000,1.101],[1723392000000,1.1111],[1723478400000,1.1235],[1723564800000,1.1286],[1723651200000,1.1397],[1723737600000,1.1531],[1723996800000,1.1704],[1724083200000,1.1547],[1724169600000,1.1644],[1724256000000,1.1696],[1724342400000,1.1689],[1724601600000,1.1807],[1724688000000,1.1647],[1724774400000,1.1522],[1724860800000,1.1693],[1724947200000,1.1571],[1725206400000,1.1787],[1725292800000,1.1905],[1725379200000,1.1888],[1725465600000,1.1706],[1725552000000,1.1387],[1725811200000,1.1381],[1725897600000,1.1089],[1725984000000,1.125],[1726070400000,1.0921],[1726156800000,1.0888],[1726416000000,1.0608],[1726502400000,1.0605],[1726588800000,1.0754],[1726675200000,1.0691],[1726761600000,1.0513],[1727020800000,1.0571],[1727107200000,1.0647],[1727193600000,1.0817],[1727280000000,1.0737],[1727366400000,1.0492],[1727625600000,1.042],[1727712000000,1.0577],[1727798400000,1.0896],[1727884800000,1.0799],[1727971200000,1.0818],[1728230400000,1.0796],[1728316800000,1.0776],[1728403200000,1.087],[1728489600000,1.0689],[1728576000000,1.0662],[1728835200000,1.046],[1728921600000,1.0387],[1729008000000,1.0383],[1729094400000,1.0477],[1729180800000,1.0427],[1729440000000,1.0485],[1729526400000,1.071],[1729612800000,1.069],[1729699200000,1.0741],[1729785600000,1.0724],[1730044800000,1.0638],[1730131200000,1.0683],[1730217600000,1.0745],[1730304000000,1.0777],[1730390400000,1.08],[1730649600000,1.0726],[1730736000000,1.083],[1730822400000,1.0822],[1730908800000,1.089],[1730995200000,1.0809],[1731254400000,1.0699],[1731340800000,1.0611],[1731427200000,1.0771],[1731513600000,1.0827],[1731600000000,1.0812],[1731859200000,1.0875],[1731945600000,1.0611],[1732032000000,1.0565],[1732118400000,1.0512],[1732204800000,1.0214],[1732464000000,1.0198],[1732550400000,1.0113],[1732636800000,1.019],[1732723200000,1.0096],[1732809600000,1.0013],[1733068800000,1.0146],[1733155200000,1.0182],[1733241600000,1.0036],[1733328000000,1.0014],[1733414400000,0.9927],[1733673600000,1.0106],[1733760000000,1.0036],[1733846400000,0.9975],[1733932800000,0.9643],[1734019200000,0.9501],[1734278400000,0.9673],[1734364800000,0.9621],[1734451200000,0.9463],[1734537600000,0.9488],[1734624000000,0.9434],[1734883200000,0.9437],[1734969600000,0.9717],[1735056000000,0.9905],[1735142400000,1.0207],[1735228800000,1.0372],[1735488000000,1.0337],[1735574400000,1.0129],[1735660800000,1.0276],[1735747200000,1.0182],[1735833600000,1.0145],[1736092800000,1.0148],[1736179200000,1.0263],[1736265600000,1.0464],[1736352000000,1.0515],[1736438400000,1.0649],[1736697600000,1.0675],[1736784000000,1.0692],[1736870400000,1.0886],[1736956800000,1.085],[1737043200000,1.1108],[1737302400000,1.1119],[1737388800000,1.1202],[1737475200000,1.1272],[1737561600000,1.1232],[1737648000000,1.1345],[1737907200000,1.1293],[1737993600000,1.1296],[1738080000000,1.1452],[1738166400000,1.1706],[1738252800000,1.172],[1738512000000,1.1608],[1738598400000,1.1548],[1738684800000,1.1377],[1738771200000,1.1417],[1738857600000,1.1636],[1739116800000,1.1787],[1739203200000,1.1835],[1739289600000,1.1921],[1739376000000,1.1948],[1739462400000,1.2126],[1739721600000,1.2109],[1739808000000,1.2032],[1739894400000,1.2121],[1739980800000,1.2247],[1740067200000,1.2088],[1740326400000,1.2151],[1740412800000,1.2008],[1740499200000,1.2029],[1740585600000,1.205],[1740672000000,1.223],[1740931200000,1.2397],[1741017600000,1.2445],[1741104000000,1.2464],[1741190400000,1.2353],[1741276800000,1.2589],[1741536000000,1.2468],[1741622400000,1.2583],[1741708800000,1.2354],[1741795200000,1.249],[1741881600000,1.2433],[1742140800000,1.2408],[1742227200000,1.2403],[1742313600000,1.2339],[1742400000000,1.2274],[1742486400000,1.1796],[1742745600000,1.1901],[1742832000000,1.2062],[1742918400000,1.2004],[1743004800000,1.1814],[1743091200000,1.196],[1743350400000,1.192],[1743436800000,1.1948],[1743523200000,1.1932],[1743609600000,1.1776],[1743696000000,1.1716],[1743955200000,1.161],[1744041600000,1.1459],[1744128000000,1.1365],[1744214400000,1.1252],[1744300800000,1.1413],[1744560000000,1.1575],[1744646400000,1.1727],[1744732800000,1.1518],[1744819200000,1.1464],[1744905600000,1.1403],[1745164800000,1.1365],[1745251200000,1.1398],[1745337600000,1.1323],[1745424000000,1.1249],[1745510400000,1.1399],[1745769600000,1.1804],[1745856000000,1.2017],[1745942400000,1.1984],[1746028800000,1.2075],[1746115200000,1.1975],[1746374400000,1.2002],[1746460800000,1.2238],[1746547200000,1.2221],[1746633600000,1.2155],[1746720000000,1.2274],[1746979200000,1.2208],[1747065600000,1.2104],[1747152000000,1.2226],[1747238400000,1.2074],[1747324800000,1.1883],[1747584000000,1.1852],[1747670400000,1.1734],[1747756800000,1.164],[1747843200000,1.1593],[1747929600000,1.1623],[1748188800000,1.1623],[1748275200000,1.16],[1748361600000,1.1751],[1748448000000,1.1672],[1748534400000,1.1759],[1748793600000,1.1741],[1748880000000,1.1705],[1748966400000,1.1763],[1749052800000,1.1547],[1749139200000,1.1572],[1749398400000,1.1497],[1749484800000,1.1339],[1749571200000,1.1412],[1749657600000,1.1445],[1749744000000,1.1427],[1750003200000,1.1487],[1750089600000,1.1605],[1750176000000,1.1755],[1750262400000,1.1905],[1750348800000,1.1874],[1750608000000,1.1784],[1750694400000,1.1865],[1750780800000,1.2013],[1750867200000,1.1952],[1750953600000,1.1984],[1751212800000,1.1912]];/*累计收益率走势*/var Data_grandTotal = [{"name":"华夏成长混合","data":[[1719763200000,0.0],[1719849600000,-0.41],[1719936000000,-0.76],[1720022400000,-2.61],[1720108800000,-3.84],[1720368000000,-3.7],[1720454400000,-5.81],[1720540800000,-6.9],[1720627200000,-6.87],[1720713600000,-7.88],[1720972800000,-7.84],[1721059200000,-7.06],[1721145600000,-6.93],[1721232000000,-6.35],[1721318400000,-6.76],[1721577600000,-4.0],[1721664000000,-1.66],[1721750400000,0.14],[1721836800000,0.03],[1721923200000,0.12],[1722182400000,-0.11],[1722268800000,0.71],[1722355200000,-0.06],[1722441600000,0.75],[1722528000000,0.3],[1722787200000,1.16],[1722873600000,2.95],[1722960000000,2.98],[1723046400000,2.29],[1723132800000,2.73],[1723392000000,3.68],[1723478400000,4.83],[1723564800000,5.31],[1723651200000,6.35],[1723737600000,7.6],[1723996800000,9.21],[1724083200000,7.74],[1724169600000,8.65],[1724256000000,9.14],[1724342400000,9.07],[1724601600000,10.17],[1724688000000,8.68],[1724774400000,7.51],[1724860800000,9.11],[1724947200000,7.97],[1725206400000,9.98],[1725292800000,11.09],[1725379200000,10.93],[1725465600000,9.23],[1725552000000,6.25],[1725811200000,6.2],[1725897600000,3.47],[1725984000000,4.97],[1726070400000,1.9],[1726156800000,1.6],[1726416000000,-1.02],[1726502400000,-1.05],[1726588800000,0.35],[1726675200000,-0.24],[1726761600000,-1.9],[1727020800000,-1.36],[1727107200000,-0.65],[1727193600000,0.93],[1727280000000,0.19],[1727366400000,-2.1],[1727625600000,-2.77],[1727712000000,-1.31],[1727798400000,1.67],[1727884800000,0.77],[1727971200000,0.94],[1728230400000,0.74],[1728316800000,0.55],[1728403200000,1.43],[1728489600000,-0.26],[1728576000000,-0.51],[1728835200000,-2.4],[1728921600000,-3.08],[1729008000000,-3.12],[1729094400000,-2.24],[1729180800000,-2.71],[1729440000000,-2.16],[1729526400000,-0.07],[1729612800000,-0.25],[1729699200000,0.22],[1729785600000,0.07],[1730044800000,-0.74],[1730131200000,-0.32],[1730217600000,0.26],[1730304000000,0.56],[1730390400000,0.77],[1730649600000,0.08],[1730736000000,1.05],[1730822400000,0.98],[1730908800000,1.61],[1730995200000,0.86],[1731254400000,-0.17],[1731340800000,-0.99],[1731427200000,0.5],[1731513600000,1.03],[1731600000000,0.89],[1731859200000,1.47],[1731945600000,-0.99],[1732032000000,-1.42],[1732118400000,-1.91],[1732204800000,-4.69],[1732464000000,-4.84],[1732550400000,-5.64],[1732636800000,-4.92],[1732723200000,-5.79],[1732809600000,-6.57],[1733068800000,-5.33],[1733155200000,-4.99],[1733241600000,-6.35],[1733328000000,-6.56],[1733414400000,-7.37],[1733673600000,-5.7],[1733760000000,-6.35],[1733846400000,-6.92],[1733932800000,-10.02],[1734019200000,-11.35],[1734278400000,-9.74],[1734364800000,-10.23],[1734451200000,-11.7],[1734537600000,-11.47],[1734624000000,-11.97],[1734883200000,-11.94],[1734969600000,-9.33],[1735056000000,-7.58],[1735142400000,-4.76],[1735228800000,-3.22],[1735488000000,-3.55],[1735574400000,-5.49],[1735660800000,-4.11],[1735747200000,-4.99],[1735833600000,-5.34],[1736092800000,-5.31],[1736179200000,-4.24],[1736265600000,-2.36],[1736352000000,-1.88],[1736438400000,-0.63],[1736697600000,-0.39],[1736784000000,-0.23],[1736870400000,1.58],[1736956800000,1.24],[1737043200000,3.65],[1737302400000,3.75],[1737388800000,4.53],[1737475200000,5.18],[1737561600000,4.81],[1737648000000,5.86],[1737907200000,5.37],[1737993600000,5.4],[1738080000000,6.86],[1738166400000,9.23],[1738252800000,9.36],[1738512000000,8.31],[1738598400000,7.75],[1738684800000,6.16],[1738771200000,6.53],[1738857600000,8.58],[1739116800000,9.98],[1739203200000,10.43],[1739289600000,11.23],[1739376000000,11.49],[1739462400000,13.15],[1739721600000,12.99],[1739808000000,12.27],[1739894400000,13.1],[1739980800000,14.28],[1740067200000,12.79],[1740326400000,13.38],[1740412800000,12.05],[1740499200000,12.24],[1740585600000,12.44],[1740672000000,14.12],[1740931200000,15.68],[1741017600000,16.12],[1741104000000,16.3],[1741190400000,15.27],[1741276800000,17.47],[1741536000000,16.34],[1741622400000,17.41],[1741708800000,15.27],[1741795200000,16.54],[1741881600000,16.01],[1742140800000,15.78],[1742227200000,15.73],[1742313600000,15.13],[1742400000000,14.53],[1742486400000,10.07],[1742745600000,11.05],[1742832000000,12.55],[1742918400000,12.01],[1743004800000,10.24],[1743091200000,11.6],[1743350400000,11.23],[1743436800000,11.49],[1743523200000,11.34],[1743609600000,9.88],[1743696000000,9.32],[1743955200000,8.33],[1744041600000,6.92],[1744128000000,6.05],[1744214400000,4.99],[1744300800000,6.49],[1744560000000,8.01],[1744646400000,9.42],[1744732800000,7.47],[1744819200000,6.97],[1744905600000,6.4],[1745164800000,6.05],[1745251200000,6.35],[1745337600000,5.65],[1745424000000,4.96],[1745510400000,6.36],[1745769600000,10.14],[1745856000000,12.13],[1745942400000,11.82],[1746028800000,12.67],[1746115200000,11.74],[1746374400000,11.99],[1746460800000,14.19],[1746547200000,14.03],[1746633600000,13.42],[1746720000000,14.53],[1746979200000,13.91],[1747065600000,12.94],[1747152000000,14.08],[1747238400000,12.66],[1747324800000,10.88],[1747584000000,10.59],[1747670400000,9.49],[1747756800000,8.61],[1747843200000,8.17],[1747929600000,8.45],[1748188800000,8.45],[1748275200000,8.24],[1748361600000,9.65],[1748448000000,8.91],[1748534400000,9.72],[1748793600000,9.55],[1748880000000,9.22],[1748966400000,9.76],[1749052800000,7.74],[1749139200000,7.98],[1749398400000,7.28],[1749484800000,5.8],[1749571200000,6.49],[1749657600000,6.79],[1749744000000,6.62],[1750003200000,7.18],[1750089600000,8.29],[1750176000000,9.69],[1750262400000,11.09],[1750348800000,10.8],[1750608000000,9.96],[1750694400000,10.71],[1750780800000,12.09],[1750867200000,11.52],[1750953600000,11.82],[1751212800000,11.15]]},{"name":"同类平均","data":[[1719763200000,0.0],[1719849600000,-0.48],[1719936000000,-1.04],[1720022400000,-0.33],[1720108800000,-1.05],[1720368000000,-2.47],[1720454400000,-3.24],[1720540800000,-1.3],[1720627200000,0.23],[1720713600000,-0.31],[1720972800000,-0.89],[1721059200000,-0.69],[1721145600000,-1.28],[1721232000000,-0.23],[1721318400000,-0.29],[1721577600000,-1.14],[1721664000000,-0.1],[1721750400000,-0.55],[1721836800000,-0.37],[1721923200000,-0.37],[1722182400000,-0.61],[1722268800000,-0.34],[1722355200000,-0.88],[1722441600000,-2.33],[1722528000000,-4.05],[1722787200000,-5.01],[1722873600000,-5.58],[1722960000000,-5.59],[1723046400000,-5.54],[1723132800000,-5.11],[1723392000000,-5.01],[1723478400000,-5.6],[1723564800000,-6.12],[1723651200000,-7.71],[1723737600000,-7.82],[1723996800000,-7.46],[1724083200000,-7.05],[1724169600000,-7.14],[1724256000000,-7.26],[1724342400000,-6.55],[1724601600000,-6.53],[1724688000000,-5.97],[1724774400000,-5.52],[1724860800000,-5.35],[1724947200000,-4.35],[1725206400000,-4.78],[1725292800000,-5.05],[1725379200000,-5.65],[1725465600000,-6.24],[1725552000000,-5.07],[1725811200000,-3.72],[1725897600000,-3.69],[1725984000000,-3.24],[1726070400000,-2.33],[1726156800000,-1.68],[1726416000000,-0.73],[1726502400000,-1.72],[1726588800000,-2.21],[1726675200000,-1.85],[1726761600000,-0.71],[1727020800000,-0.62],[1727107200000,-1.29],[1727193600000,-1.56],[1727280000000,-2.07],[1727366400000,-2.74],[1727625600000,-1.56],[1727712000000,-2.04],[1727798400000,-2.01],[1727884800000,-0.31],[1727971200000,0.65],[1728230400000,0.93],[1728316800000,0.44],[1728403200000,0.78],[1728489600000,2.1],[1728576000000,2.62],[1728835200000,3.66],[1728921600000,3.76],[1729008000000,4.19],[1729094400000,4.04],[1729180800000,4.4],[1729440000000,5.5],[1729526400000,4.3],[1729612800000,4.26],[1729699200000,4.47],[1729785600000,4.01],[1730044800000,3.76],[1730131200000,4.42],[1730217600000,6.11],[1730304000000,6.65],[1730390400000,6.94],[1730649600000,5.62],[1730736000000,7.26],[1730822400000,7.34],[1730908800000,7.32],[1730995200000,6.37],[1731254400000,6.33],[1731340800000,5.41],[1731427200000,5.48],[1731513600000,5.89],[1731600000000,5.92],[1731859200000,6.17],[1731945600000,5.46],[1732032000000,6.67],[1732118400000,6.13],[1732204800000,4.59],[1732464000000,4.45],[1732550400000,3.82],[1732636800000,2.99],[1732723200000,2.71],[1732809600000,2.96],[1733068800000,2.0],[1733155200000,1.89],[1733241600000,3.07],[1733328000000,3.64],[1733414400000,3.52],[1733673600000,3.64],[1733760000000,3.55],[1733846400000,3.52],[1733932800000,4.14],[1734019200000,4.07],[1734278400000,2.08],[1734364800000,2.07],[1734451200000,1.36],[1734537600000,1.9],[1734624000000,1.41],[1734883200000,1.54],[1734969600000,3.32],[1735056000000,2.46],[1735142400000,1.55],[1735228800000,0.42],[1735488000000,-1.5],[1735574400000,-2.97],[1735660800000,-2.68],[1735747200000,-3.16],[1735833600000,-4.6],[1736092800000,-5.72],[1736179200000,-5.25],[1736265600000,-5.83],[1736352000000,-6.09],[1736438400000,-5.84],[1736697600000,-4.8],[1736784000000,-3.32],[1736870400000,-2.51],[1736956800000,-2.39],[1737043200000,-2.23],[1737302400000,-0.81],[1737388800000,0.33],[1737475200000,0.09],[1737561600000,0.47],[1737648000000,0.71],[1737907200000,0.76],[1737993600000,0.37],[1738080000000,-0.69],[1738166400000,-1.1],[1738252800000,-2.31],[1738512000000,-1.35],[1738598400000,-0.91],[1738684800000,-1.86],[1738771200000,-0.76],[1738857600000,-0.04],[1739116800000,-1.55],[1739203200000,-0.09],[1739289600000,0.56],[1739376000000,2.23],[1739462400000,1.24],[1739721600000,1.68],[1739808000000,2.03],[1739894400000,2.21],[1739980800000,2.36],[1740067200000,3.23],[1740326400000,2.01],[1740412800000,1.0],[1740499200000,-0.11],[1740585600000,-0.55],[1740672000000,-1.02],[1740931200000,-0.72],[1741017600000,-0.5],[1741104000000,-0.46],[1741190400000,-0.99],[1741276800000,-1.33],[1741536000000,-0.57],[1741622400000,0.05],[1741708800000,0.14],[1741795200000,-0.11],[1741881600000,1.14],[1742140800000,0.67],[1742227200000,1.2],[1742313600000,2.15],[1742400000000,1.94],[1742486400000,2.62],[1742745600000,1.72],[1742832000000,2.55],[1742918400000,2.72],[1743004800000,1.43],[1743091200000,1.98],[1743350400000,1.27],[1743436800000,2.32],[1743523200000,1.77],[1743609600000,1.65],[1743696000000,1.89],[1743955200000,1.63],[1744041600000,1.85],[1744128000000,1.41],[1744214400000,1.96],[1744300800000,1.98],[1744560000000,2.16],[1744646400000,-0.08],[1744732800000,0.86],[1744819200000,0.89],[1744905600000,-0.54],[1745164800000,-0.45],[1745251200000,-0.07],[1745337600000,0.8],[1745424000000,-0.06],[1745510400000,1.18],[1745769600000,1.06],[1745856000000,3.01],[1745942400000,2.9],[1746028800000,3.47],[1746115200000,3.18],[1746374400000,2.27],[1746460800000,3.17],[1746547200000,3.93],[1746633600000,5.22],[1746720000000,5.95],[1746979200000,5.48],[1747065600000,4.09],[1747152000000,3.55],[1747238400000,3.0],[1747324800000,2.34],[1747584000000,2.83],[1747670400000,3.11],[1747756800000,2.9],[1747843200000,3.05],[1747929600000,2.94],[1748188800000,3.13],[1748275200000,3.76],[1748361600000,4.56],[1748448000000,4.0],[1748534400000,2.76],[1748793600000,3.94],[1748880000000,4.05],[1748966400000,4.98],[1749052800000,3.61],[1749139200000,3.34],[1749398400000,3.38],[1749484800000,2.2],[1749571200000,1.78],[1749657600000,2.38],[1749744000000,3.28],[1750003200000,4.61],[1750089600000,3.89],[1750176000000,2.74],[1750262400000,3.18],[1750348800000,3.96],[1750608000000,4.13],[1750694400000,3.06],[1750780800000,3.72],[1750867200000,4.38],[1750953600000,4.86],[1751212800000,4.46]]},{"name":"沪深300","data":[[1719763200000,0.0],[1719849600000,0.75],[1719936000000,-0.33],[1720022400000,-2.94],[1720108800000,-3.94],[1720368000000,-2.42],[1720454400000,-2.78],[1720540800000,-4.1],[1720627200000,-4.82],[1720713600000,-4.32],[1720972800000,-3.83],[1721059200000,-3.65],[1721145600000,-2.21],[1721232000000,-1.51],[1721318400000,-1.52],[1721577600000,-0.93],[1721664000000,0.72],[1721750400000,1.71],[1721836800000,2.76],[1721923200000,1.66],[1722182400000,1.52],[1722268800000,2.27],[1722355200000,1.98],[1722441600000,3.08],[1722528000000,3.7],[1722787200000,4.66],[1722873600000,4.44],[1722960000000,7.11],[1723046400000,8.45],[1723132800000,8.23],[1723392000000,8.34],[1723478400000,11.16],[1723564800000,10.79],[1723651200000,11.77],[1723737600000,12.88],[1723996800000,12.9],[1724083200000,11.59],[1724169600000,11.81],[1724256000000,12.22],[1724342400000,13.5],[1724601600000,14.4],[1724688000000,14.44],[1724774400000,15.43],[1724860800000,16.06],[1724947200000,16.31],[1725206400000,16.39],[1725292800000,16.12],[1725379200000,16.93],[1725465600000,15.71],[1725552000000,14.99],[1725811200000,15.01],[1725897600000,13.34],[1725984000000,12.85],[1726070400000,10.6],[1726156800000,9.85],[1726416000000,10.49],[1726502400000,11.13],[1726588800000,11.08],[1726675200000,10.83],[1726761600000,9.27],[1727020800000,11.28],[1727107200000,11.86],[1727193600000,13.1],[1727280000000,12.11],[1727366400000,11.91],[1727625600000,9.89],[1727712000000,10.76],[1727798400000,11.8],[1727884800000,9.69],[1727971200000,9.65],[1728230400000,10.35],[1728316800000,8.42],[1728403200000,6.45],[1728489600000,5.33],[1728576000000,4.67],[1728835200000,3.22],[1728921600000,3.26],[1729008000000,3.53],[1729094400000,4.19],[1729180800000,4.94],[1729440000000,6.52],[1729526400000,7.77],[1729612800000,6.37],[1729699200000,5.84],[1729785600000,4.73],[1730044800000,3.61],[1730131200000,3.54],[1730217600000,3.56],[1730304000000,4.08],[1730390400000,2.43],[1730649600000,1.18],[1730736000000,1.16],[1730822400000,0.97],[1730908800000,0.67],[1730995200000,0.61],[1731254400000,-0.14],[1731340800000,0.57],[1731427200000,0.94],[1731513600000,0.86],[1731600000000,0.19],[1731859200000,0.03],[1731945600000,-2.69],[1732032000000,-3.63],[1732118400000,-3.59],[1732204800000,-5.03],[1732464000000,-4.83],[1732550400000,-4.68],[1732636800000,-5.98],[1732723200000,-6.21],[1732809600000,-6.49],[1733068800000,-6.05],[1733155200000,-5.47],[1733241600000,-5.49],[1733328000000,-6.29],[1733414400000,-6.42],[1733673600000,-6.47],[1733760000000,-5.77],[1733846400000,-5.48],[1733932800000,-6.16],[1734019200000,-7.42],[1734278400000,-7.76],[1734364800000,-8.43],[1734451200000,-9.44],[1734537600000,-9.53],[1734624000000,-9.97],[1734883200000,-9.87],[1734969600000,-9.38],[1735056000000,-9.75],[1735142400000,-7.64],[1735228800000,-7.93],[1735488000000,-6.91],[1735574400000,-6.78],[1735660800000,-5.73],[1735747200000,-7.96],[1735833600000,-8.65],[1736092800000,-8.41],[1736179200000,-7.85],[1736265600000,-5.69],[1736352000000,-5.38],[1736438400000,-4.16],[1736697600000,-3.41],[1736784000000,-2.49],[1736870400000,-1.98],[1736956800000,-2.12],[1737043200000,-1.61],[1737302400000,-2.67],[1737388800000,-1.51],[1737475200000,-2.5],[1737561600000,-2.24],[1737648000000,-0.16],[1737907200000,-0.37],[1737993600000,-0.35],[1738080000000,0.82],[1738166400000,0.86],[1738252800000,0.06],[1738512000000,0.32],[1738598400000,0.92],[1738684800000,1.64],[1738771200000,0.87],[1738857600000,2.65],[1739116800000,4.37],[1739203200000,4.4],[1739289600000,4.69],[1739376000000,4.25],[1739462400000,5.74],[1739721600000,5.0],[1739808000000,5.72],[1739894400000,5.22],[1739980800000,4.5],[1740067200000,5.26],[1740326400000,6.68],[1740412800000,6.68],[1740499200000,5.97],[1740585600000,6.84],[1740672000000,6.79],[1740931200000,7.14],[1741017600000,8.78],[1741104000000,10.02],[1741190400000,9.46],[1741276800000,11.97],[1741536000000,11.99],[1741622400000,12.88],[1741708800000,12.16],[1741795200000,12.12],[1741881600000,10.17],[1742140800000,12.15],[1742227200000,13.69],[1742313600000,12.32],[1742400000000,10.64],[1742486400000,8.86],[1742745600000,10.15],[1742832000000,9.65],[1742918400000,9.6],[1743004800000,9.27],[1743091200000,9.14],[1743350400000,7.97],[1743436800000,8.0],[1743523200000,6.46],[1743609600000,6.4],[1743696000000,6.74],[1743955200000,7.25],[1744041600000,7.01],[1744128000000,6.05],[1744214400000,6.23],[1744300800000,5.73],[1744560000000,7.39],[1744646400000,8.23],[1744732800000,8.12],[1744819200000,7.62],[1744905600000,6.87],[1745164800000,5.88],[1745251200000,5.52],[1745337600000,5.84],[1745424000000,6.39],[1745510400000,7.01],[1745769600000,9.27],[1745856000000,8.51],[1745942400000,8.53],[1746028800000,11.58],[1746115200000,9.5],[1746374400000,8.94],[1746460800000,9.14],[1746547200000,9.32],[1746633600000,9.78],[1746720000000,9.53],[1746979200000,9.94],[1747065600000,10.01],[1747152000000,10.87],[1747238400000,8.78],[1747324800000,7.83],[1747584000000,7.84],[1747670400000,6.73],[1747756800000,5.63],[1747843200000,6.3],[1747929600000,5.62],[1748188800000,6.3],[1748275200000,7.11],[1748361600000,7.45],[1748448000000,8.0],[1748534400000,7.9],[1748793600000,6.39],[1748880000000,6.37],[1748966400000,6.86],[1749052800000,6.31],[1749139200000,6.21],[1749398400000,7.02],[1749484800000,6.09],[1749571200000,6.78],[1749657600000,8.78],[1749744000000,8.19],[1750003200000,8.36],[1750089600000,8.2],[1750176000000,9.88],[1750262400000,10.24],[1750348800000,11.24],[1750608000000,10.48],[1750694400000,10.48],[1750780800000,10.48],[1750867200000,8.53],[1750953600000,10.1],[1751212800000,11.1]]}];/*同类排名走势*/var Data_rateInSimilarType = [{"x":1744041600000,"y":828,"sc":"4567"},{"x":1744646400000,"y":118,"sc":"4567"},{"x":1745251200000,"y":389,"sc":"4567"},{"x":1745856000000,"y":693,"sc":"4567"},{"x":1746460800000,"y":688,"sc":"4567"},{"x":1747065600000,"y":235,"sc":"4567"},{"x":1747670400000,"y":317,"sc":"4567"},{"x":1748275200000,"y":254,"sc":"4567"},{"x":1748880000000,"y":266,"sc":"4567"},{"x":1749484800000,"y":721,"sc":"4567"},{"x":1750089600000,"y":889,"sc":"4567"},{"x":1750694400000,"y":484,"sc":"4567"}];/*同类排名百分比*/var Data_rateInSimilarPersent=[[1744041600000,73.09],[1744646400000,60.93],[1745251200000,38.47],[1745856000000,32.44],[1746460800000,70.21],[1747065600000,37.95],[1747670400000,20.76],[1748275200000,72.23],[1748880000000,31.87],[1749484800000,38.78],[1750089600000,39.14],[1750694400000,35.35]];/*规模变动 mom-较上期环比*/var Data_fluctuationScale = {"categories":["2024-06-30","2024-09-30","2024-12-31","2025-03-31"],"series":[{"y":45.12,"mom":"-3.21%"},{"y":47.8,"mom":"5.94%"},{"y":44.03,"mom":"-7.89%"},{"y":43.55,"mom":"-1.09%"}]};/*持有人结构*/var Data_holderStructure ={"series":[{"name":"机构持有比例","data":[12.5,11.8]},{"name":"个人持有比例","data":[87.1,87.9]},{"name":"内部持有比例","data":[0.4,0.3]}],"categories":["2023-12-31","2024-06-30"]};/*资产配置*/var Data_assetAllocation = {"series":[{"name":"股票占净比","type":null,"data":[86.2,88.1,85.7,87.3],"yAxis":0},{"name":"债券占净比","type":null,"data":[2.1,1.5,3.2,2.4],"yAxis":0},{"name":"现金占净比","type":null,"data":[10.9,9.6,10.4,9.8],"yAxis":0},{"name":"净资产","type":"line","data":[45.12,47.8,44.03,43.55],"yAxis":1}],"categories":["2024-06-30","2024-09-30","2024-12-31","2025-03-31"]};/*业绩评价 ['选股能力', '收益率', '抗风险', '稳定性','择时能力']*/var Data_performanceEvaluation = {"avr":"62.50","categories":["选股能力","收益率","抗风险","稳定性","择时能力"],"dsc":["a","b","c","d","e"],"data":[80.0,55.0,60.0,70.0,40.0]};/*现任基金经理*/var Data_currentFundManager =[{"id":"30198263","pic":"https://pdf.dfcfw.com/pdf/H8_30198263_1.JPG","name":"郑晓辉","star":4,"workTime":"8年又120天","fundSize":"123.45亿(6只基金)","power":{"avr":"71.20","categories":["经验值","收益率","抗风险","稳定性","择时能力"],"dsc":["a","b","c","d","e"],"data":[90.0,60.5,70.2,65.0,45.1],"jzrq":"2025-06-30"},"profit":{"categories":["任期收益","同类平均","沪深300"],"series":[{"data":[{"name":null,"color":"#7cb5ec","y":35.21},{"name":null,"color":"#414c7b","y":20.1},{"name":null,"color":"#f7a35c","y":5.6}]}],"jzrq":"2025-06-30"}}] ;/*申购赎回*/var Data_buySedemption = {"series":[{"name":"期间申购","data":[3.1,2.4]},{"name":"期间赎回","data":[4.2,5.0]},{"name":"总份额","data":[38.5,35.9]}],"categories":["2024-06-30","2024-12-31"]};/*同类型基金涨幅榜（页面底部通栏）*/var swithSameType = [["005827_易方达蓝筹精选混合_12.34","161725_招商中证白酒指数_10.01"]];
\ No newline at end of file
@@ -0,0 +1 @@
+/*Create By xxx 2025/6/30 20:30:12*/var ishb=false;/*基金或股票信息*/var fS_name = "易方达消费行业股票";var fS_code = "110022";/*原费率*/var fund_sourceRate="1.50";/*现费率*/var fund_Rate="0.15";/*最小申购金额*/var fund_minsg="10";/*基金持仓股票代码*/var stockCodes=["6005191", "6008091", "0008582", "6008871", "0005681"];/*基金持仓债券代码*/var zqCodes = "";/*基金持仓股票代码(新市场号)*/var stockCodesNew =["1.600519", "1.600809", "0.000858", "1.600887", "0.000568"];/*基金持仓债券代码（新市场号）*/var zqCodesNew = "";/*收益率*//*近一年收益率*/var syl_1n="53.25";/*近6月收益率*/var syl_6y="14.01";/*近三月收益率*/var syl_3y="10.11";/*近一月收益率*/var syl_1y="1.23";/*股票仓位测算图*/var Data_fundSharesPositions = [[1748880000000,82.41],[1749484800000,91.99],[1750089600000,80.29],[1750694400000,82.72]];/*单位净值走势 equityReturn-净值回报 unitMoney-每份派送金*/var Data_netWorthTrend = [{"x":1672675200000,"y":3.651,"equityReturn":0,"unitMoney":""},{"x":1672761600000,"y":3.6474,"equityReturn":-0.1,"unitMoney":""},{"x":1672848000000,"y":3.5899,"equityReturn":-1.58,"unitMoney":""},{"x":1672934400000,"y":3.5886,"equityReturn":-0.04,"unitMoney":""},{"x":1673193600000,"y":3.5078,"equityReturn":-2.25,"unitMoney":""},{"x":1673280000000,"y":3.4667,"equityReturn":-1.17,"unitMoney":""},{"x":1673366400000,"y":3.5032,"equityReturn":1.05,"unitMoney":""},{"x":1673452800000,"y":3.4955,"equityReturn":-0.22,"unitMoney":""},{"x":1673539200000,"y":3.4679,"equityReturn":-0.79,"unitMoney":""},{"x":1673798400000,"y":3.5164,"equityReturn":1.4,"unitMoney":""},{"x":1673884800000,"y":3.5284,"equityReturn":0.34,"unitMoney":""},{"x":1673971200000,"y":3.5138,"equityReturn":-0.41,"unitMoney":""},{"x":1674057600000,"y":3.459,"equityReturn":-1.56,"unitMoney":""},{"x":1674144000000,"y":3.4137,"equityReturn":-1.31,"unitMoney":""},{"x":1674403200000,"y":3.3061,"equityReturn":-3.15,"unitMoney":""},{"x":1674489600000,"y":3.3084,"equityReturn":0.07,"unitMoney":""},{"x":1674576000000,"y":3.3311,"equityReturn":0.68,"unitMoney":""},{"x":1674662400000,"y":3.1959,"equityReturn":-4.06,"unitMoney":""},{"x":1674748800000,"y":3.1097,"equityReturn":-2.7,"unitMoney":""},{"x":1675008000000,"y":3.0563,"equityReturn":-1.72,"unitMoney":""},{"x":1675094400000,"y":3.0468,"equityReturn":-0.31,"unitMoney":""},{"x":1675180800000,"y":3.0639,"equityReturn":0.56,"unitMoney":""},{"x":1675267200000,"y":3.0491,"equityReturn":-0.48,"unitMoney":""},{"x":1675353600000,"y":3.0603,"equityReturn":0.37,"unitMoney":""},{"x":1675612800000,"y":3.1174,"equityReturn":1.87,"unitMoney":""},{"x":1675699200000,"y":3.0894,"equityReturn":-0.9,"unitMoney":""},{"x":1675785600000,"y":3.1115,"equityReturn":0.72,"unitMoney":""},{"x":1675872000000,"y":3.0503,"equityReturn":-1.97,"unitMoney":""},{"x":1675958400000,"y":3.0897,"equityReturn":1.29,"unitMoney":""},{"x":1676217600000,"y":3.0979,"equityReturn":0.27,"unitMoney":""},{"x":1676304000000,"y":3.1542,"equityReturn":1.82,"unitMoney":""},{"x":1676390400000,"y":3.1455,"equityReturn":-0.28,"unitMoney":""},{"x":1676476800000,"y":3.1505,"equityReturn":0.16,"unitMoney":""},{"x":1676563200000,"y":3.2226,"equityReturn":2.29,"unitMoney":""},{"x":1676822400000,"y":3.2117,"equityReturn":-0.34,"unitMoney":""},{"x":1676908800000,"y":3.2326,"equityReturn":0.65,"unitMoney":""},{"x":1676995200000,"y":3.1879,"equityReturn":-1.38,"unitMoney":""},{"x":1677081600000,"y":3.1853,"equityReturn":-0.08,"unitMoney":""},{"x":1677168000000,"y":3.1876,"equityReturn":0.07,"unitMoney":""},{"x":1677427200000,"y":3.1338,"equityReturn":-1.69,"unitMoney":""},{"x":1677513600000,"y":3.1873,"equityReturn":1.71,"unitMoney":""},{"x":1677600000000,"y":3.1594,"equityReturn":-0.87,"unitMoney":""},{"x":1677686400000,"y":3.17,"equityReturn":0.34,"unitMoney":""},{"x":1677772800000,"y":3.0817,"equityReturn":-2.78,"unitMoney":""},{"x":1678032000000,"y":3.1264,"equityReturn":1.45,"unitMoney":""},{"x":1678118400000,"y":3.1233,"equityReturn":-0.1,"unitMoney":""},{"x":1678204800000,"y":3.1131,"equityReturn":-0.33,"unitMoney":""},{"x":1678291200000,"y":3.0871,"equityReturn":-0.84,"unitMoney":""},{"x":1678377600000,"y":3.1075,"equityReturn":0.66,"unitMoney":""},{"x":1678636800000,"y":3.1868,"equityReturn":2.55,"unitMoney":""},{"x":1678723200000,"y":3.1667,"equityReturn":-0.63,"unitMoney":""},{"x":1678809600000,"y":3.1475,"equityReturn":-0.61,"unitMoney":""},{"x":1678896000000,"y":3.0771,"equityReturn":-2.24,"unitMoney":""},{"x":1678982400000,"y":3.0858,"equityReturn":0.28,"unitMoney":""},{"x":1679241600000,"y":3.1429,"equityReturn":1.85,"unitMoney":""},{"x":1679328000000,"y":3.1271,"equityReturn":-0.5,"unitMoney":""},{"x":1679414400000,"y":3.1948,"equityReturn":2.16,"unitMoney":""},{"x":1679500800000,"y":3.3246,"equityReturn":4.06,"unitMoney":""},{"x":1679587200000,"y":3.3532,"equityReturn":0.86,"unitMoney":""},{"x":1679846400000,"y":3.2613,"equityReturn":-2.74,"unitMoney":""},{"x":1679932800000,"y":3.2642,"equityReturn":0.09,"unitMoney":""},{"x":1680019200000,"y":3.3136,"equityReturn":1.51,"unitMoney":""},{"x":1680105600000,"y":3.1696,"equityReturn":-4.35,"unitMoney":""},{"x":1680192000000,"y":3.1387,"equityReturn":-0.98,"unitMoney":""},{"x":1680451200000,"y":3.1921,"equityReturn":1.7,"unitMoney":""},{"x":1680537600000,"y":3.1731,"equityReturn":-0.6,"unitMoney":""},{"x":1680624000000,"y":3.1503,"equityReturn":-0.72,"unitMoney":""},{"x":1680710400000,"y":3.2189,"equityReturn":2.18,"unitMoney":""},{"x":1680796800000,"y":3.1742,"equityReturn":-1.39,"unitMoney":""},{"x":1681056000000,"y":3.2135,"equityReturn":1.24,"unitMoney":""},{"x":1681142400000,"y":3.1984,"equityReturn":-0.47,"unitMoney":""},{"x":1681228800000,"y":3.2173,"equityReturn":0.59,"unitMoney":""},{"x":1681315200000,"y":3.2071,"equityReturn":-0.32,"unitMoney":""},{"x":1681401600000,"y":3.2176,"equityReturn":0.33,"unitMoney":""},{"x":1681660800000,"y":3.1567,"equityReturn":-1.89,"unitMoney":""},{"x":1681747200000,"y":3.2238,"equityReturn":2.13,"unitMoney":""},{"x":1681833600000,"y":3.2805,"equityReturn":1.76,"unitMoney":""},{"x":1681920000000,"y":3.2583,"equityReturn":-0.68,"unitMoney":""},{"x":1682006400000,"y":3.2668,"equityReturn":0.26,"unitMoney":""},{"x":1682265600000,"y":3.2887,"equityReturn":0.67,"unitMoney":""},{"x":1682352000000,"y":3.3553,"equityReturn":2.02,"unitMoney":""},{"x":1682438400000,"y":3.3521,"equityReturn":-0.1,"unitMoney":""},{"x":1682524800000,"y":3.3429,"equityReturn":-0.27,"unitMoney":""},{"x":1682611200000,"y":3.4144,"equityReturn":2.14,"unitMoney":""},{"x":1682870400000,"y":3.4399,"equityReturn":0.75,"unitMoney":""},{"x":1682956800000,"y":3.4579,"equityReturn":0.52,"unitMoney":""},{"x":1683043200000,"y":3.3992,"equityReturn":-1.7,"unitMoney":""},{"x":1683129600000,"y":3.3105,"equityReturn":-2.61,"unitMoney":""},{"x":1683216000000,"y":3.3352,"equityReturn":0.74,"unitMoney":""},{"x":1683475200000,"y":3.3112,"equityReturn":-0.72,"unitMoney":""},{"x":1683561600000,"y":3.3885,"equityReturn":2.33,"unitMoney":""},{"x":1683648000000,"y":3.4034,"equityReturn":0.44,"unitMoney":""},{"x":1683734400000,"y":3.4486,"equityReturn":1.33,"unitMoney":""},{"x":1683820800000,"y":3.4721,"equityReturn":0.68,"unitMoney":""},{"x":1684080000000,"y":3.3921,"equityReturn":-2.3,"unitMoney":""},{"x":1684166400000,"y":3.426,"equityReturn":1.0,"unitMoney":""},{"x":1684252800000,"y":3.4056,"equityReturn":-0.59,"unitMoney":""},{"x":1684339200000,"y":3.4517,"equityReturn":1.35,"unitMoney":""},{"x":1684425600000,"y":3.5267,"equityReturn":2.17,"unitMoney":""},{"x":1684684800000,"y":3.5637,"equityReturn":1.05,"unitMoney":""},{"x":1684771200000,"y":3.5709,"equityReturn":0.2,"unitMoney":""},{"x":1684857600000,"y":3.4918,"equityReturn":-2.22,"unitMoney":""},{"x":1684944000000,"y":3.4594,"equityReturn":-0.93,"unitMoney":""},{"x":1685030400000,"y":3.4816,"equityReturn":0.64,"unitMoney":""},{"x":1685289600000,"y":3.4823,"equityReturn":0.02,"unitMoney":""},{"x":1685376000000,"y":3.4799,"equityReturn":-0.07,"unitMoney":""},{"x":1685462400000,"y":3.4914,"equityReturn":0.33,"unitMoney":""},{"x":1685548800000,"y":3.5099,"equityReturn":0.53,"unitMoney":""},{"x":1685635200000,"y":3.5338,"equityReturn":0.68,"unitMoney":""},{"x":1685894400000,"y":3.5392,"equityReturn":0.15,"unitMoney":""},{"x":1685980800000,"y":3.5668,"equityReturn":0.78,"unitMoney":""},{"x":1686067200000,"y":3.5633,"equityReturn":-0.1,"unitMoney":""},{"x":1686153600000,"y":3.6136,"equityReturn":1.41,"unitMoney":""},{"x":1686240000000,"y":3.5316,"equityReturn":-2.27,"unitMoney":""},{"x":1686499200000,"y":3.4679,"equityReturn":-1.8,"unitMoney":""},{"x":1686585600000,"y":3.4562,"equityReturn":-0.34,"unitMoney":""},{"x":1686672000000,"y":3.5109,"equityReturn":1.58,"unitMoney":""},{"x":1686758400000,"y":3.5689,"equityReturn":1.65,"unitMoney":""},{"x":1686844800000,"y":3.5627,"equityReturn":-0.17,"unitMoney":""},{"x":1687104000000,"y":3.6371,"equityReturn":2.09,"unitMoney":""},{"x":1687190400000,"y":3.6201,"equityReturn":-0.47,"unitMoney":""},{"x":1687276800000,"y":3.6354,"equityReturn":0.42,"unitMoney":""},{"x":1687363200000,"y":3.6617,"equityReturn":0.72,"unitMoney":""},{"x":1687449600000,"y":3.6143,"equityReturn":-1.3,"unitMoney":""},{"x":1687708800000,"y":3.7259,"equityReturn":3.09,"unitMoney":""},{"x":1687795200000,"y":3.7115,"equityReturn":-0.39,"unitMoney":""},{"x":1687881600000,"y":3.7338,"equityReturn":0.6,"unitMoney":""},{"x":1687968000000,"y":3.7858,"equityReturn":1.39,"unitMoney":""},{"x":1688054400000,"y":3.7831,"equityReturn":-0.07,"unitMoney":""},{"x":1688313600000,"y":3.7542,"equityReturn":-0.76,"unitMoney":""},{"x":1688400000000,"y":3.8087,"equityReturn":1.45,"unitMoney":""},{"x":1688486400000,"y":3.8091,"equityReturn":0.01,"unitMoney":""},{"x":1688572800000,"y":3.8368,"equityReturn":0.73,"unitMoney":""},{"x":1688659200000,"y":3.8888,"equityReturn":1.36,"unitMoney":""},{"x":1688918400000,"y":3.9828,"equityReturn":2.42,"unitMoney":""},{"x":1689004800000,"y":3.9761,"equityReturn":-0.17,"unitMoney":""},{"x":1689091200000,"y":3.9772,"equityReturn":0.03,"unitMoney":""},{"x":1689177600000,"y":3.9301,"equityReturn":-1.18,"unitMoney":""},{"x":1689264000000,"y":3.9551,"equityReturn":0.64,"unitMoney":""},{"x":1689523200000,"y":3.8909,"equityReturn":-1.62,"unitMoney":""},{"x":1689609600000,"y":4.0113,"equityReturn":3.09,"unitMoney":""},{"x":1689696000000,"y":4.0562,"equityReturn":1.12,"unitMoney":""},{"x":1689782400000,"y":4.0485,"equityReturn":-0.19,"unitMoney":""},{"x":1689868800000,"y":4.0613,"equityReturn":0.32,"unitMoney":""},{"x":1690128000000,"y":4.0004,"equityReturn":-1.5,"unitMoney":""},{"x":1690214400000,"y":3.9426,"equityReturn":-1.44,"unitMoney":""},{"x":1690300800000,"y":3.9099,"equityReturn":-0.83,"unitMoney":""},{"x":1690387200000,"y":3.8133,"equityReturn":-2.47,"unitMoney":""},{"x":1690473600000,"y":3.7993,"equityReturn":-0.37,"unitMoney":""},{"x":1690732800000,"y":3.8139,"equityReturn":0.39,"unitMoney":""},{"x":1690819200000,"y":3.6458,"equityReturn":-4.41,"unitMoney":""},{"x":1690905600000,"y":3.7049,"equityReturn":1.62,"unitMoney":""},{"x":1690992000000,"y":3.7732,"equityReturn":1.84,"unitMoney":""},{"x":1691078400000,"y":3.8723,"equityReturn":2.63,"unitMoney":""},{"x":1691337600000,"y":3.9124,"equityReturn":1.04,"unitMoney":""},{"x":1691424000000,"y":3.9191,"equityReturn":0.17,"unitMoney":""},{"x":1691510400000,"y":3.8964,"equityReturn":-0.58,"unitMoney":""},{"x":1691596800000,"y":3.8825,"equityReturn":-0.36,"unitMoney":""},{"x":1691683200000,"y":3.9084,"equityReturn":0.67,"unitMoney":""},{"x":1691942400000,"y":3.8156,"equityReturn":-2.37,"unitMoney":""},{"x":1692028800000,"y":3.7395,"equityReturn":-1.99,"unitMoney":""},{"x":1692115200000,"y":3.7585,"equityReturn":0.51,"unitMoney":""},{"x":1692201600000,"y":3.7234,"equityReturn":-0.93,"unitMoney":""},{"x":1692288000000,"y":3.7412,"equityReturn":0.48,"unitMoney":""},{"x":1692547200000,"y":3.7591,"equityReturn":0.48,"unitMoney":""},{"x":1692633600000,"y":3.8362,"equityReturn":2.05,"unitMoney":""},{"x":1692720000000,"y":3.869,"equityReturn":0.85,"unitMoney":""},{"x":1692806400000,"y":3.817,"equityReturn":-1.34,"unitMoney":""},{"x":1692892800000,"y":3.9033,"equityReturn":2.26,"unitMoney":""},{"x":1693152000000,"y":3.8583,"equityReturn":-1.15,"unitMoney":""},{"x":1693238400000,"y":3.8525,"equityReturn":-0.15,"unitMoney":""},{"x":1693324800000,"y":3.8189,"equityReturn":-0.87,"unitMoney":""},{"x":1693411200000,"y":3.7268,"equityReturn":-2.41,"unitMoney":""},{"x":1693497600000,"y":3.7207,"equityReturn":-0.16,"unitMoney":""},{"x":1693756800000,"y":3.7444,"equityReturn":0.64,"unitMoney":""},{"x":1693843200000,"y":3.7713,"equityReturn":0.72,"unitMoney":""},{"x":1693929600000,"y":3.7827,"equityReturn":0.3,"unitMoney":""},{"x":1694016000000,"y":3.8861,"equityReturn":2.73,"unitMoney":""},{"x":1694102400000,"y":3.9767,"equityReturn":2.33,"unitMoney":""},{"x":1694361600000,"y":3.871,"equityReturn":-2.66,"unitMoney":""},{"x":1694448000000,"y":4.0053,"equityReturn":3.47,"unitMoney":""},{"x":1694534400000,"y":4.093,"equityReturn":2.19,"unitMoney":""},{"x":1694620800000,"y":4.1384,"equityReturn":1.11,"unitMoney":""},{"x":1694707200000,"y":4.2388,"equityReturn":2.42,"unitMoney":""},{"x":1694966400000,"y":4.2358,"equityReturn":-0.07,"unitMoney":""},{"x":1695052800000,"y":4.3158,"equityReturn":1.89,"unitMoney":""},{"x":1695139200000,"y":4.4072,"equityReturn":2.12,"unitMoney":""},{"x":1695225600000,"y":4.4043,"equityReturn":-0.07,"unitMoney":""},{"x":1695312000000,"y":4.4022,"equityReturn":-0.05,"unitMoney":""},{"x":1695571200000,"y":4.4124,"equityReturn":0.23,"unitMoney":""},{"x":1695657600000,"y":4.3238,"equityReturn":-2.01,"unitMoney":""},{"x":1695744000000,"y":4.2958,"equityReturn":-0.65,"unitMoney":""},{"x":1695830400000,"y":4.3704,"equityReturn":1.74,"unitMoney":""},{"x":1695916800000,"y":4.3723,"equityReturn":0.04,"unitMoney":""},{"x":1696176000000,"y":4.4558,"equityReturn":1.91,"unitMoney":""},{"x":1696262400000,"y":4.4678,"equityReturn":0.27,"unitMoney":""},{"x":1696348800000,"y":4.5013,"equityReturn":0.75,"unitMoney":""},{"x":1696435200000,"y":4.477,"equityReturn":-0.54,"unitMoney":""},{"x":1696521600000,"y":4.4998,"equityReturn":0.51,"unitMoney":""},{"x":1696780800000,"y":4.5267,"equityReturn":0.6,"unitMoney":""},{"x":1696867200000,"y":4.4835,"equityReturn":-0.95,"unitMoney":""},{"x":1696953600000,"y":4.5155,"equityReturn":0.71,"unitMoney":""},{"x":1697040000000,"y":4.6096,"equityReturn":2.08,"unitMoney":""},{"x":1697126400000,"y":4.4913,"equityReturn":-2.57,"unitMoney":""},{"x":1697385600000,"y":4.5187,"equityReturn":0.61,"unitMoney":""},{"x":1697472000000,"y":4.5591,"equityReturn":0.89,"unitMoney":""},{"x":1697558400000,"y":4.6406,"equityReturn":1.79,"unitMoney":""},{"x":1697644800000,"y":4.6669,"equityReturn":0.57,"unitMoney":""},{"x":1697731200000,"y":4.7478,"equityReturn":1.73,"unitMoney":""},{"x":1697990400000,"y":4.6673,"equityReturn":-1.7,"unitMoney":""},{"x":1698076800000,"y":4.7261,"equityReturn":1.26,"unitMoney":""},{"x":1698163200000,"y":4.7713,"equityReturn":0.96,"unitMoney":""},{"x":1698249600000,"y":4.8081,"equityReturn":0.77,"unitMoney":""},{"x":1698336000000,"y":4.7772,"equityReturn":-0.64,"unitMoney":""},{"x":1698595200000,"y":4.871,"equityReturn":1.96,"unitMoney":""},{"x":1698681600000,"y":4.9163,"equityReturn":0.93,"unitMoney":""},{"x":1698768000000,"y":4.8177,"equityReturn":-2.01,"unitMoney":""},{"x":1698854400000,"y":4.8734,"equityReturn":1.16,"unitMoney":""},{"x":1698940800000,"y":4.8979,"equityReturn":0.5,"unitMoney":""},{"x":1699200000000,"y":4.9777,"equityReturn":1.63,"unitMoney":""},{"x":1699286400000,"y":4.9598,"equityReturn":-0.36,"unitMoney":""},{"x":1699372800000,"y":4.9856,"equityReturn":0.52,"unitMoney":""},{"x":1699459200000,"y":4.9733,"equityReturn":-0.25,"unitMoney":""},{"x":1699545600000,"y":4.9665,"equityReturn":-0.14,"unitMoney":""},{"x":1699804800000,"y":4.9708,"equityReturn":0.09,"unitMoney":""},{"x":1699891200000,"y":4.9187,"equityReturn":-1.05,"unitMoney":""},{"x":1699977600000,"y":4.8051,"equityReturn":-2.31,"unitMoney":""},{"x":1700064000000,"y":4.8189,"equityReturn":0.29,"unitMoney":""},{"x":1700150400000,"y":4.7088,"equityReturn":-2.29,"unitMoney":""},{"x":1700409600000,"y":4.8277,"equityReturn":2.53,"unitMoney":""},{"x":1700496000000,"y":4.6614,"equityReturn":-3.44,"unitMoney":""},{"x":1700582400000,"y":4.7121,"equityReturn":1.09,"unitMoney":""},{"x":1700668800000,"y":4.823,"equityReturn":2.35,"unitMoney":""},{"x":1700755200000,"y":4.7365,"equityReturn":-1.79,"unitMoney":""},{"x":1701014400000,"y":4.8193,"equityReturn":1.75,"unitMoney":""},{"x":1701100800000,"y":4.7193,"equityReturn":-2.07,"unitMoney":""},{"x":1701187200000,"y":4.6627,"equityReturn":-1.2,"unitMoney":""},{"x":1701273600000,"y":4.743,"equityReturn":1.72,"unitMoney":""},{"x":1701360000000,"y":4.774,"equityReturn":0.65,"unitMoney":""},{"x":1701619200000,"y":4.7505,"equityReturn":-0.49,"unitMoney":""},{"x":1701705600000,"y":4.7115,"equityReturn":-0.82,"unitMoney":""},{"x":1701792000000,"y":4.7562,"equityReturn":0.95,"unitMoney":""},{"x":1701878400000,"y":4.7665,"equityReturn":0.22,"unitMoney":""},{"x":1701964800000,"y":4.8359,"equityReturn":1.46,"unitMoney":""},{"x":1702224000000,"y":4.7788,"equityReturn":-1.18,"unitMoney":""},{"x":1702310400000,"y":4.7465,"equityReturn":-0.68,"unitMoney":""},{"x":1702396800000,"y":4.6577,"equityReturn":-1.87,"unitMoney":""},{"x":1702483200000,"y":4.5525,"equityReturn":-2.26,"unitMoney":""},{"x":1702569600000,"y":4.5387,"equityReturn":-0.3,"unitMoney":""},{"x":1702828800000,"y":4.3853,"equityReturn":-3.38,"unitMoney":""},{"x":1702915200000,"y":4.3603,"equityReturn":-0.57,"unitMoney":""},{"x":1703001600000,"y":4.3226,"equityReturn":-0.87,"unitMoney":""},{"x":1703088000000,"y":4.3541,"equityReturn":0.73,"unitMoney":""},{"x":1703174400000,"y":4.4639,"equityReturn":2.52,"unitMoney":""},{"x":1703433600000,"y":4.5075,"equityReturn":0.98,"unitMoney":""},{"x":1703520000000,"y":4.4464,"equityReturn":-1.35,"unitMoney":""},{"x":1703606400000,"y":4.4071,"equityReturn":-0.88,"unitMoney":""},{"x":1703692800000,"y":4.458,"equityReturn":1.15,"unitMoney":""},{"x":1703779200000,"y":4.3828,"equityReturn":-1.69,"unitMoney":""},{"x":1704038400000,"y":4.4469,"equityReturn":1.46,"unitMoney":""},{"x":1704124800000,"y":4.3479,"equityReturn":-2.23,"unitMoney":""},{"x":1704211200000,"y":4.3203,"equityReturn":-0.64,"unitMoney":""},{"x":1704297600000,"y":4.2684,"equityReturn":-1.2,"unitMoney":""},{"x":1704384000000,"y":4.2339,"equityReturn":-0.81,"unitMoney":""},{"x":1704643200000,"y":4.2571,"equityReturn":0.55,"unitMoney":""},{"x":1704729600000,"y":4.1197,"equityReturn":-3.23,"unitMoney":""},{"x":1704816000000,"y":4.1679,"equityReturn":1.17,"unitMoney":""},{"x":1704902400000,"y":4.2384,"equityReturn":1.69,"unitMoney":""},{"x":1704988800000,"y":4.246,"equityReturn":0.18,"unitMoney":""},{"x":1705248000000,"y":4.1288,"equityReturn":-2.76,"unitMoney":""},{"x":1705334400000,"y":4.1557,"equityReturn":0.65,"unitMoney":""},{"x":1705420800000,"y":4.0599,"equityReturn":-2.31,"unitMoney":""},{"x":1705507200000,"y":4.0643,"equityReturn":0.11,"unitMoney":""},{"x":1705593600000,"y":4.0891,"equityReturn":0.61,"unitMoney":""},{"x":1705852800000,"y":4.0602,"equityReturn":-0.71,"unitMoney":""},{"x":1705939200000,"y":4.0183,"equityReturn":-1.03,"unitMoney":""},{"x":1706025600000,"y":3.9537,"equityReturn":-1.61,"unitMoney":""},{"x":1706112000000,"y":3.9587,"equityReturn":0.13,"unitMoney":""},{"x":1706198400000,"y":3.9658,"equityReturn":0.18,"unitMoney":""},{"x":1706457600000,"y":3.923,"equityReturn":-1.08,"unitMoney":""},{"x":1706544000000,"y":3.8152,"equityReturn":-2.75,"unitMoney":""},{"x":1706630400000,"y":3.8463,"equityReturn":0.82,"unitMoney":""},{"x":1706716800000,"y":3.876,"equityReturn":0.77,"unitMoney":""},{"x":1706803200000,"y":3.7993,"equityReturn":-1.98,"unitMoney":""},{"x":1707062400000,"y":3.7822,"equityReturn":-0.45,"unitMoney":""},{"x":1707148800000,"y":3.7542,"equityReturn":-0.74,"unitMoney":""},{"x":1707235200000,"y":3.7178,"equityReturn":-0.97,"unitMoney":""},{"x":1707321600000,"y":3.6803,"equityReturn":-1.01,"unitMoney":""},{"x":1707408000000,"y":3.7275,"equityReturn":1.28,"unitMoney":""},{"x":1707667200000,"y":3.6816,"equityReturn":-1.23,"unitMoney":""},{"x":1707753600000,"y":3.6405,"equityReturn":-1.12,"unitMoney":""},{"x":1707840000000,"y":3.6018,"equityReturn":-1.06,"unitMoney":""},{"x":1707926400000,"y":3.6549,"equityReturn":1.47,"unitMoney":""},{"x":1708012800000,"y":3.6821,"equityReturn":0.74,"unitMoney":""},{"x":1708272000000,"y":3.7208,"equityReturn":1.05,"unitMoney":""},{"x":1708358400000,"y":3.7821,"equityReturn":1.65,"unitMoney":""},{"x":1708444800000,"y":3.7922,"equityReturn":0.27,"unitMoney":""},{"x":1708531200000,"y":3.8434,"equityReturn":1.35,"unitMoney":""},{"x":1708617600000,"y":3.9281,"equityReturn":2.2,"unitMoney":""},{"x":1708876800000,"y":3.8959,"equityReturn":-0.82,"unitMoney":""},{"x":1708963200000,"y":3.8375,"equityReturn":-1.5,"unitMoney":""},{"x":1709049600000,"y":3.8248,"equityReturn":-0.33,"unitMoney":""},{"x":1709136000000,"y":3.8796,"equityReturn":1.43,"unitMoney":""},{"x":1709222400000,"y":3.7897,"equityReturn":-2.32,"unitMoney":""},{"x":1709481600000,"y":3.8235,"equityReturn":0.89,"unitMoney":""},{"x":1709568000000,"y":3.8704,"equityReturn":1.23,"unitMoney":""},{"x":1709654400000,"y":3.8029,"equityReturn":-1.74,"unitMoney":""},{"x":1709740800000,"y":3.7736,"equityReturn":-0.77,"unitMoney":""},{"x":1709827200000,"y":3.8022,"equityReturn":0.76,"unitMoney":""},{"x":1710086400000,"y":3.8531,"equityReturn":1.34,"unitMoney":""},{"x":1710172800000,"y":3.9074,"equityReturn":1.41,"unitMoney":""},{"x":1710259200000,"y":3.9638,"equityReturn":1.44,"unitMoney":""},{"x":1710345600000,"y":3.9519,"equityReturn":-0.3,"unitMoney":""},{"x":1710432000000,"y":3.9206,"equityReturn":-0.79,"unitMoney":""},{"x":1710691200000,"y":3.8923,"equityReturn":-0.72,"unitMoney":""},{"x":1710777600000,"y":3.9552,"equityReturn":1.62,"unitMoney":""},{"x":1710864000000,"y":3.9751,"equityReturn":0.5,"unitMoney":""},{"x":1710950400000,"y":3.9928,"equityReturn":0.45,"unitMoney":""},{"x":1711036800000,"y":3.9511,"equityReturn":-1.04,"unitMoney":""},{"x":1711296000000,"y":3.9298,"equityReturn":-0.54,"unitMoney":""},{"x":1711382400000,"y":3.883,"equityReturn":-1.19,"unitMoney":""},{"x":1711468800000,"y":3.8701,"equityReturn":-0.33,"unitMoney":""},{"x":1711555200000,"y":3.8109,"equityReturn":-1.53,"unitMoney":""},{"x":1711641600000,"y":3.8795,"equityReturn":1.8,"unitMoney":""},{"x":1711900800000,"y":3.9412,"equityReturn":1.59,"unitMoney":""},{"x":1711987200000,"y":3.9219,"equityReturn":-0.49,"unitMoney":""},{"x":1712073600000,"y":3.9371,"equityReturn":0.39,"unitMoney":""},{"x":1712160000000,"y":3.9924,"equityReturn":1.4,"unitMoney":""},{"x":1712246400000,"y":3.9764,"equityReturn":-0.4,"unitMoney":""},{"x":1712505600000,"y":3.9382,"equityReturn":-0.96,"unitMoney":""},{"x":1712592000000,"y":3.87,"equityReturn":-1.73,"unitMoney":""},{"x":1712678400000,"y":3.8517,"equityReturn":-0.47,"unitMoney":""},{"x":1712764800000,"y":3.8645,"equityReturn":0.33,"unitMoney":""},{"x":1712851200000,"y":3.9551,"equityReturn":2.34,"unitMoney":""},{"x":1713110400000,"y":3.9679,"equityReturn":0.32,"unitMoney":""},{"x":1713196800000,"y":4.0151,"equityReturn":1.19,"unitMoney":""},{"x":1713283200000,"y":4.0413,"equityReturn":0.65,"unitMoney":""},{"x":1713369600000,"y":3.9843,"equityReturn":-1.41,"unitMoney":""},{"x":1713456000000,"y":4.0176,"equityReturn":0.83,"unitMoney":""},{"x":1713715200000,"y":3.9779,"equityReturn":-0.99,"unitMoney":""},{"x":1713801600000,"y":3.9259,"equityReturn":-1.31,"unitMoney":""},{"x":1713888000000,"y":3.9205,"equityReturn":-0.14,"unitMoney":""},{"x":1713974400000,"y":4.0389,"equityReturn":3.02,"unitMoney":""},{"x":1714060800000,"y":4.0403,"equityReturn":0.03,"unitMoney":""},{"x":1714320000000,"y":3.9366,"equityReturn":-2.57,"unitMoney":""},{"x":1714406400000,"y":3.8941,"equityReturn":-1.08,"unitMoney":""},{"x":1714492800000,"y":3.8608,"equityReturn":-0.85,"unitMoney":""},{"x":1714579200000,"y":3.8399,"equityReturn":-0.54,"unitMoney":""},{"x":1714665600000,"y":3.7991,"equityReturn":-1.06,"unitMoney":""},{"x":1714924800000,"y":3.8036,"equityReturn":0.12,"unitMoney":""},{"x":1715011200000,"y":3.7335,"equityReturn":-1.84,"unitMoney":""},{"x":1715097600000,"y":3.6971,"equityReturn":-0.97,"unitMoney":""},{"x":1715184000000,"y":3.723,"equityReturn":0.7,"unitMoney":""},{"x":1715270400000,"y":3.8002,"equityReturn":2.07,"unitMoney":""},{"x":1715529600000,"y":3.8057,"equityReturn":0.14,"unitMoney":""},{"x":1715616000000,"y":3.792,"equityReturn":-0.36,"unitMoney":""},{"x":1715702400000,"y":3.7406,"equityReturn":-1.36,"unitMoney":""},{"x":1715788800000,"y":3.6656,"equityReturn":-2.0,"unitMoney":""},{"x":1715875200000,"y":3.6631,"equityReturn":-0.07,"unitMoney":""},{"x":1716134400000,"y":3.6692,"equityReturn":0.17,"unitMoney":""},{"x":1716220800000,"y":3.6525,"equityReturn":-0.46,"unitMoney":""},{"x":1716307200000,"y":3.6592,"equityReturn":0.18,"unitMoney":""},{"x":1716393600000,"y":3.6529,"equityReturn":-0.17,"unitMoney":""},{"x":1716480000000,"y":3.5625,"equityReturn":-2.48,"unitMoney":""},{"x":1716739200000,"y":3.5771,"equityReturn":0.41,"unitMoney":""},{"x":1716825600000,"y":3.5521,"equityReturn":-0.7,"unitMoney":""},{"x":1716912000000,"y":3.5655,"equityReturn":0.38,"unitMoney":""},{"x":1716998400000,"y":3.5918,"equityReturn":0.74,"unitMoney":""},{"x":1717084800000,"y":3.6272,"equityReturn":0.99,"unitMoney":""},{"x":1717344000000,"y":3.64,"equityReturn":0.35,"unitMoney":""},{"x":1717430400000,"y":3.5791,"equityReturn":-1.67,"unitMoney":""},{"x":1717516800000,"y":3.5417,"equityReturn":-1.05,"unitMoney":""},{"x":1717603200000,"y":3.5516,"equityReturn":0.28,"unitMoney":""},{"x":1717689600000,"y":3.5131,"equityReturn":-1.08,"unitMoney":""},{"x":1717948800000,"y":3.5111,"equityReturn":-0.06,"unitMoney":""},{"x":1718035200000,"y":3.563,"equityReturn":1.48,"unitMoney":""},{"x":1718121600000,"y":3.5834,"equityReturn":0.57,"unitMoney":""},{"x":1718208000000,"y":3.5852,"equityReturn":0.05,"unitMoney":""},{"x":1718294400000,"y":3.5506,"equityReturn":-0.96,"unitMoney":""},{"x":1718553600000,"y":3.5645,"equityReturn":0.39,"unitMoney":""},{"x":1718640000000,"y":3.5367,"equityReturn":-0.78,"unitMoney":""},{"x":1718726400000,"y":3.5789,"equityReturn":1.19,"unitMoney":""},{"x":1718812800000,"y":3.5982,"equityReturn":0.54,"unitMoney":""},{"x":1718899200000,"y":3.5944,"equityReturn":-0.11,"unitMoney":""},{"x":1719158400000,"y":3.6533,"equityReturn":1.64,"unitMoney":""},{"x":1719244800000,"y":3.8142,"equityReturn":4.4,"unitMoney":""},{"x":1719331200000,"y":3.8486,"equityReturn":0.9,"unitMoney":""},{"x":1719417600000,"y":3.8348,"equityReturn":-0.36,"unitMoney":""},{"x":1719504000000,"y":3.7867,"equityReturn":-1.25,"unitMoney":""},{"x":1719763200000,"y":3.756,"equityReturn":-0.81,"unitMoney":""},{"x":1719849600000,"y":3.8084,"equityReturn":1.4,"unitMoney":""},{"x":1719936000000,"y":3.7773,"equityReturn":-0.82,"unitMoney":""},{"x":1720022400000,"y":3.7384,"equityReturn":-1.03,"unitMoney":""},{"x":1720108800000,"y":3.7135,"equityReturn":-0.67,"unitMoney":""},{"x":1720368000000,"y":3.7837,"equityReturn":1.89,"unitMoney":""},{"x":1720454400000,"y":3.8101,"equityReturn":0.7,"unitMoney":""},{"x":1720540800000,"y":3.7772,"equityReturn":-0.86,"unitMoney":""},{"x":1720627200000,"y":3.8222,"equityReturn":1.19,"unitMoney":""},{"x":1720713600000,"y":3.8508,"equityReturn":0.75,"unitMoney":""},{"x":1720972800000,"y":3.8169,"equityReturn":-0.88,"unitMoney":""},{"x":1721059200000,"y":3.8217,"equityReturn":0.13,"unitMoney":""},{"x":1721145600000,"y":3.9535,"equityReturn":3.45,"unitMoney":""},{"x":1721232000000,"y":3.9556,"equityReturn":0.05,"unitMoney":""},{"x":1721318400000,"y":3.9421,"equityReturn":-0.34,"unitMoney":""},{"x":1721577600000,"y":4.0184,"equityReturn":1.93,"unitMoney":""},{"x":1721664000000,"y":4.0303,"equityReturn":0.3,"unitMoney":""},{"x":1721750400000,"y":4.159,"equityReturn":3.19,"unitMoney":""},{"x":1721836800000,"y":4.1713,"equityReturn":0.3,"unitMoney":""},{"x":1721923200000,"y":4.214,"equityReturn":1.02,"unitMoney":""},{"x":1722182400000,"y":4.2673,"equityReturn":1.26,"unitMoney":""},{"x":1722268800000,"y":4.337,"equityReturn":1.63,"unitMoney":""},{"x":1722355200000,"y":4.3805,"equityReturn":1.0,"unitMoney":""},{"x":1722441600000,"y":4.3027,"equityReturn":-1.78,"unitMoney":""},{"x":1722528000000,"y":4.36,"equityReturn":1.33,"unitMoney":""},{"x":1722787200000,"y":4.308,"equityReturn":-1.19,"unitMoney":""},{"x":1722873600000,"y":4.3352,"equityReturn":0.63,"unitMoney":""},{"x":1722960000000,"y":4.3448,"equityReturn":0.22,"unitMoney":""},{"x":1723046400000,"y":4.3984,"equityReturn":1.23,"unitMoney":""},{"x":1723132800000,"y":4.3734,"equityReturn":-0.57,"unitMoney":""},{"x":1723392000000,"y":4.4293,"equityReturn":1.28,"unitMoney":""},{"x":1723478400000,"y":4.5487,"equityReturn":2.7,"unitMoney":""},{"x":1723564800000,"y":4.5627,"equityReturn":0.31,"unitMoney":""},{"x":1723651200000,"y":4.5736,"equityReturn":0.24,"unitMoney":""},{"x":1723737600000,"y":4.5443,"equityReturn":-0.64,"unitMoney":""},{"x":1723996800000,"y":4.4834,"equityReturn":-1.34,"unitMoney":""},{"x":1724083200000,"y":4.3847,"equityReturn":-2.2,"unitMoney":""},{"x":1724169600000,"y":4.3886,"equityReturn":0.09,"unitMoney":""},{"x":1724256000000,"y":4.4115,"equityReturn":0.52,"unitMoney":""},{"x":1724342400000,"y":4.3767,"equityReturn":-0.79,"unitMoney":""},{"x":1724601600000,"y":4.4077,"equityReturn":0.71,"unitMoney":""},{"x":1724688000000,"y":4.4038,"equityReturn":-0.09,"unitMoney":""},{"x":1724774400000,"y":4.3381,"equityReturn":-1.49,"unitMoney":""},{"x":1724860800000,"y":4.257,"equityReturn":-1.87,"unitMoney":""},{"x":1724947200000,"y":4.1748,"equityReturn":-1.93,"unitMoney":""},{"x":1725206400000,"y":4.2981,"equityReturn":2.95,"unitMoney":""},{"x":1725292800000,"y":4.4599,"equityReturn":3.76,"unitMoney":""},{"x":1725379200000,"y":4.5019,"equityReturn":0.94,"unitMoney":""},{"x":1725465600000,"y":4.4114,"equityReturn":-2.01,"unitMoney":""},{"x":1725552000000,"y":4.4508,"equityReturn":0.89,"unitMoney":""},{"x":1725811200000,"y":4.4925,"equityReturn":0.94,"unitMoney":""},{"x":1725897600000,"y":4.4219,"equityReturn":-1.57,"unitMoney":""},{"x":1725984000000,"y":4.3043,"equityReturn":-2.66,"unitMoney":""},{"x":1726070400000,"y":4.2202,"equityReturn":-1.95,"unitMoney":""},{"x":1726156800000,"y":4.1605,"equityReturn":-1.41,"unitMoney":""},{"x":1726416000000,"y":4.1972,"equityReturn":0.88,"unitMoney":""},{"x":1726502400000,"y":4.2635,"equityReturn":1.58,"unitMoney":""},{"x":1726588800000,"y":4.3116,"equityReturn":1.13,"unitMoney":""},{"x":1726675200000,"y":4.3359,"equityReturn":0.56,"unitMoney":""},{"x":1726761600000,"y":4.3668,"equityReturn":0.71,"unitMoney":""},{"x":1727020800000,"y":4.4199,"equityReturn":1.22,"unitMoney":""},{"x":1727107200000,"y":4.4187,"equityReturn":-0.03,"unitMoney":""},{"x":1727193600000,"y":4.456,"equityReturn":0.84,"unitMoney":""},{"x":1727280000000,"y":4.494,"equityReturn":0.85,"unitMoney":""},{"x":1727366400000,"y":4.5469,"equityReturn":1.18,"unitMoney":""},{"x":1727625600000,"y":4.5645,"equityReturn":0.39,"unitMoney":""},{"x":1727712000000,"y":4.6634,"equityReturn":2.17,"unitMoney":""},{"x":1727798400000,"y":4.6381,"equityReturn":-0.54,"unitMoney":""},{"x":1727884800000,"y":4.5163,"equityReturn":-2.63,"unitMoney":""},{"x":1727971200000,"y":4.487,"equityReturn":-0.65,"unitMoney":""},{"x":1728230400000,"y":4.5088,"equityReturn":0.49,"unitMoney":""},{"x":1728316800000,"y":4.4549,"equityReturn":-1.2,"unitMoney":""},{"x":1728403200000,"y":4.3885,"equityReturn":-1.49,"unitMoney":""},{"x":1728489600000,"y":4.3117,"equityReturn":-1.75,"unitMoney":""},{"x":1728576000000,"y":4.2818,"equityReturn":-0.69,"unitMoney":""},{"x":1728835200000,"y":4.2774,"equityReturn":-0.1,"unitMoney":""},{"x":1728921600000,"y":4.3267,"equityReturn":1.15,"unitMoney":""},{"x":1729008000000,"y":4.4054,"equityReturn":1.82,"unitMoney":""},{"x":1729094400000,"y":4.4487,"equityReturn":0.98,"unitMoney":""},{"x":1729180800000,"y":4.5007,"equityReturn":1.17,"unitMoney":""},{"x":1729440000000,"y":4.6322,"equityReturn":2.92,"unitMoney":""},{"x":1729526400000,"y":4.5291,"equityReturn":-2.22,"unitMoney":""},{"x":1729612800000,"y":4.5299,"equityReturn":0.02,"unitMoney":""},{"x":1729699200000,"y":4.4878,"equityReturn":-0.93,"unitMoney":""},{"x":1729785600000,"y":4.5548,"equityReturn":1.49,"unitMoney":""},{"x":1730044800000,"y":4.5191,"equityReturn":-0.78,"unitMoney":""},{"x":1730131200000,"y":4.5233,"equityReturn":0.09,"unitMoney":""},{"x":1730217600000,"y":4.5565,"equityReturn":0.73,"unitMoney":""},{"x":1730304000000,"y":4.5699,"equityReturn":0.29,"unitMoney":""},{"x":1730390400000,"y":4.5615,"equityReturn":-0.18,"unitMoney":""},{"x":1730649600000,"y":4.5576,"equityReturn":-0.09,"unitMoney":""},{"x":1730736000000,"y":4.5589,"equityReturn":0.03,"unitMoney":""},{"x":1730822400000,"y":4.4927,"equityReturn":-1.45,"unitMoney":""},{"x":1730908800000,"y":4.5142,"equityReturn":0.48,"unitMoney":""},{"x":1730995200000,"y":4.5333,"equityReturn":0.42,"unitMoney":""},{"x":1731254400000,"y":4.4299,"equityReturn":-2.28,"unitMoney":""},{"x":1731340800000,"y":4.463,"equityReturn":0.75,"unitMoney":""},{"x":1731427200000,"y":4.5268,"equityReturn":1.43,"unitMoney":""},{"x":1731513600000,"y":4.5426,"equityReturn":0.35,"unitMoney":""},{"x":1731600000000,"y":4.5354,"equityReturn":-0.16,"unitMoney":""},{"x":1731859200000,"y":4.5053,"equityReturn":-0.66,"unitMoney":""},{"x":1731945600000,"y":4.4713,"equityReturn":-0.76,"unitMoney":""},{"x":1732032000000,"y":4.3976,"equityReturn":-1.65,"unitMoney":""},{"x":1732118400000,"y":4.4083,"equityReturn":0.24,"unitMoney":""},{"x":1732204800000,"y":4.3199,"equityReturn":-2.01,"unitMoney":""},{"x":1732464000000,"y":4.3104,"equityReturn":-0.22,"unitMoney":""},{"x":1732550400000,"y":4.2903,"equityReturn":-0.47,"unitMoney":""},{"x":1732636800000,"y":4.2963,"equityReturn":0.14,"unitMoney":""},{"x":1732723200000,"y":4.2347,"equityReturn":-1.43,"unitMoney":""},{"x":1732809600000,"y":4.1859,"equityReturn":-1.15,"unitMoney":""},{"x":1733068800000,"y":4.268,"equityReturn":1.96,"unitMoney":""},{"x":1733155200000,"y":4.3723,"equityReturn":2.44,"unitMoney":""},{"x":1733241600000,"y":4.4527,"equityReturn":1.84,"unitMoney":""},{"x":1733328000000,"y":4.4984,"equityReturn":1.03,"unitMoney":""},{"x":1733414400000,"y":4.5687,"equityReturn":1.56,"unitMoney":""},{"x":1733673600000,"y":4.5848,"equityReturn":0.35,"unitMoney":""},{"x":1733760000000,"y":4.5218,"equityReturn":-1.37,"unitMoney":""},{"x":1733846400000,"y":4.5427,"equityReturn":0.46,"unitMoney":""},{"x":1733932800000,"y":4.6901,"equityReturn":3.25,"unitMoney":""},{"x":1734019200000,"y":4.6777,"equityReturn":-0.26,"unitMoney":""},{"x":1734278400000,"y":4.7303,"equityReturn":1.12,"unitMoney":""},{"x":1734364800000,"y":4.7503,"equityReturn":0.42,"unitMoney":""},{"x":1734451200000,"y":4.7782,"equityReturn":0.59,"unitMoney":""},{"x":1734537600000,"y":4.8434,"equityReturn":1.36,"unitMoney":""},{"x":1734624000000,"y":4.835,"equityReturn":-0.17,"unitMoney":""},{"x":1734883200000,"y":4.9351,"equityReturn":2.07,"unitMoney":""},{"x":1734969600000,"y":5.0534,"equityReturn":2.4,"unitMoney":""},{"x":1735056000000,"y":5.0247,"equityReturn":-0.57,"unitMoney":""},{"x":1735142400000,"y":5.082,"equityReturn":1.14,"unitMoney":""},{"x":1735228800000,"y":5.0047,"equityReturn":-1.52,"unitMoney":""},{"x":1735488000000,"y":5.0884,"equityReturn":1.67,"unitMoney":""},{"x":1735574400000,"y":5.1808,"equityReturn":1.82,"unitMoney":""},{"x":1735660800000,"y":5.2286,"equityReturn":0.92,"unitMoney":""},{"x":1735747200000,"y":5.0853,"equityReturn":-2.74,"unitMoney":""},{"x":1735833600000,"y":5.2079,"equityReturn":2.41,"unitMoney":""},{"x":1736092800000,"y":5.1727,"equityReturn":-0.68,"unitMoney":""},{"x":1736179200000,"y":5.3731,"equityReturn":3.87,"unitMoney":""},{"x":1736265600000,"y":5.2798,"equityReturn":-1.74,"unitMoney":""},{"x":1736352000000,"y":5.4174,"equityReturn":2.61,"unitMoney":""},{"x":1736438400000,"y":5.4773,"equityReturn":1.11,"unitMoney":""},{"x":1736697600000,"y":5.6709,"equityReturn":3.53,"unitMoney":""},{"x":1736784000000,"y":5.7428,"equityReturn":1.27,"unitMoney":""},{"x":1736870400000,"y":5.745,"equityReturn":0.04,"unitMoney":""},{"x":1736956800000,"y":5.8372,"equityReturn":1.6,"unitMoney":""},{"x":1737043200000,"y":5.8788,"equityReturn":0.71,"unitMoney":""},{"x":1737302400000,"y":5.825,"equityReturn":-0.92,"unitMoney":""},{"x":1737388800000,"y":5.9468,"equityReturn":2.09,"unitMoney":""},{"x":1737475200000,"y":5.8415,"equityReturn":-1.77,"unitMoney":""},{"x":1737561600000,"y":5.8137,"equityReturn":-0.48,"unitMoney":""},{"x":1737648000000,"y":5.8437,"equityReturn":0.52,"unitMoney":""},{"x":1737907200000,"y":5.8979,"equityReturn":0.93,"unitMoney":""},{"x":1737993600000,"y":5.9011,"equityReturn":0.05,"unitMoney":""},{"x":1738080000000,"y":5.8856,"equityReturn":-0.26,"unitMoney":""},{"x":1738166400000,"y":5.8486,"equityReturn":-0.63,"unitMoney":""},{"x":1738252800000,"y":5.8787,"equityReturn":0.52,"unitMoney":""},{"x":1738512000000,"y":5.9277,"equityReturn":0.83,"unitMoney":""},{"x":1738598400000,"y":5.9635,"equityReturn":0.6,"unitMoney":""},{"x":1738684800000,"y":5.9366,"equityReturn":-0.45,"unitMoney":""},{"x":1738771200000,"y":5.951,"equityReturn":0.24,"unitMoney":""},{"x":1738857600000,"y":5.9443,"equityReturn":-0.11,"unitMoney":""},{"x":1739116800000,"y":5.9448,"equityReturn":0.01,"unitMoney":""},{"x":1739203200000,"y":5.835,"equityReturn":-1.85,"unitMoney":""},{"x":1739289600000,"y":5.7951,"equityReturn":-0.68,"unitMoney":""},{"x":1739376000000,"y":5.613,"equityReturn":-3.14,"unitMoney":""},{"x":1739462400000,"y":5.7764,"equityReturn":2.91,"unitMoney":""},{"x":1739721600000,"y":5.598,"equityReturn":-3.09,"unitMoney":""},{"x":1739808000000,"y":5.5773,"equityReturn":-0.37,"unitMoney":""},{"x":1739894400000,"y":5.5152,"equityReturn":-1.11,"unitMoney":""},{"x":1739980800000,"y":5.5375,"equityReturn":0.4,"unitMoney":""},{"x":1740067200000,"y":5.4934,"equityReturn":-0.8,"unitMoney":""},{"x":1740326400000,"y":5.4369,"equityReturn":-1.03,"unitMoney":""},{"x":1740412800000,"y":5.4822,"equityReturn":0.83,"unitMoney":""},{"x":1740499200000,"y":5.5332,"equityReturn":0.93,"unitMoney":""},{"x":1740585600000,"y":5.6082,"equityReturn":1.36,"unitMoney":""},{"x":1740672000000,"y":5.5074,"equityReturn":-1.8,"unitMoney":""},{"x":1740931200000,"y":5.4306,"equityReturn":-1.39,"unitMoney":""},{"x":1741017600000,"y":5.4608,"equityReturn":0.56,"unitMoney":""},{"x":1741104000000,"y":5.4302,"equityReturn":-0.56,"unitMoney":""},{"x":1741190400000,"y":5.4618,"equityReturn":0.58,"unitMoney":""},{"x":1741276800000,"y":5.6929,"equityReturn":4.23,"unitMoney":""},{"x":1741536000000,"y":5.7081,"equityReturn":0.27,"unitMoney":""},{"x":1741622400000,"y":5.7613,"equityReturn":0.93,"unitMoney":""},{"x":1741708800000,"y":5.7342,"equityReturn":-0.47,"unitMoney":""},{"x":1741795200000,"y":5.7414,"equityReturn":0.13,"unitMoney":""},{"x":1741881600000,"y":5.68,"equityReturn":-1.07,"unitMoney":""},{"x":1742140800000,"y":5.8596,"equityReturn":3.16,"unitMoney":""},{"x":1742227200000,"y":6.0841,"equityReturn":3.83,"unitMoney":""},{"x":1742313600000,"y":6.1091,"equityReturn":0.41,"unitMoney":""},{"x":1742400000000,"y":5.9382,"equityReturn":-2.8,"unitMoney":""},{"x":1742486400000,"y":5.8515,"equityReturn":-1.46,"unitMoney":""},{"x":1742745600000,"y":5.8387,"equityReturn":-0.22,"unitMoney":""},{"x":1742832000000,"y":5.896,"equityReturn":0.98,"unitMoney":""},{"x":1742918400000,"y":5.7092,"equityReturn":-3.17,"unitMoney":""},{"x":1743004800000,"y":5.6805,"equityReturn":-0.5,"unitMoney":""},{"x":1743091200000,"y":5.7226,"equityReturn":0.74,"unitMoney":""},{"x":1743350400000,"y":5.6683,"equityReturn":-0.95,"unitMoney":""},{"x":1743436800000,"y":5.6054,"equityReturn":-1.11,"unitMoney":""},{"x":1743523200000,"y":5.5984,"equityReturn":-0.13,"unitMoney":""},{"x":1743609600000,"y":5.5933,"equityReturn":-0.09,"unitMoney":""},{"x":1743696000000,"y":5.6095,"equityReturn":0.29,"unitMoney":""},{"x":1743955200000,"y":5.5868,"equityReturn":-0.4,"unitMoney":""},{"x":1744041600000,"y":5.4376,"equityReturn":-2.67,"unitMoney":""},{"x":1744128000000,"y":5.4824,"equityReturn":0.82,"unitMoney":""},{"x":1744214400000,"y":5.509,"equityReturn":0.49,"unitMoney":""},{"x":1744300800000,"y":5.4762,"equityReturn":-0.59,"unitMoney":""},{"x":1744560000000,"y":5.5758,"equityReturn":1.82,"unitMoney":""},{"x":1744646400000,"y":5.6744,"equityReturn":1.77,"unitMoney":""},{"x":1744732800000,"y":5.6659,"equityReturn":-0.15,"unitMoney":""},{"x":1744819200000,"y":5.7047,"equityReturn":0.68,"unitMoney":""},{"x":1744905600000,"y":5.5744,"equityReturn":-2.28,"unitMoney":""},{"x":1745164800000,"y":5.5527,"equityReturn":-0.39,"unitMoney":""},{"x":1745251200000,"y":5.6228,"equityReturn":1.26,"unitMoney":""},{"x":1745337600000,"y":5.6163,"equityReturn":-0.12,"unitMoney":""},{"x":1745424000000,"y":5.5708,"equityReturn":-0.81,"unitMoney":""},{"x":1745510400000,"y":5.5998,"equityReturn":0.52,"unitMoney":""},{"x":1745769600000,"y":5.7517,"equityReturn":2.71,"unitMoney":""},{"x":1745856000000,"y":5.6891,"equityReturn":-1.09,"unitMoney":""},{"x":1745942400000,"y":5.6793,"equityReturn":-0.17,"unitMoney":""},{"x":1746028800000,"y":5.859,"equityReturn":3.16,"unitMoney":""},{"x":1746115200000,"y":5.9291,"equityReturn":1.2,"unitMoney":""},{"x":1746374400000,"y":6.0076,"equityReturn":1.32,"unitMoney":""},{"x":1746460800000,"y":6.1226,"equityReturn":1.92,"unitMoney":""},{"x":1746547200000,"y":6.1904,"equityReturn":1.11,"unitMoney":""},{"x":1746633600000,"y":6.2511,"equityReturn":0.98,"unitMoney":""},{"x":1746720000000,"y":6.1376,"equityReturn":-1.82,"unitMoney":""},{"x":1746979200000,"y":6.164,"equityReturn":0.43,"unitMoney":""},{"x":1747065600000,"y":6.1518,"equityReturn":-0.2,"unitMoney":""},{"x":1747152000000,"y":6.1168,"equityReturn":-0.57,"unitMoney":""},{"x":1747238400000,"y":5.9803,"equityReturn":-2.23,"unitMoney":""},{"x":1747324800000,"y":6.0041,"equityReturn":0.4,"unitMoney":""},{"x":1747584000000,"y":6.0647,"equityReturn":1.01,"unitMoney":""},{"x":1747670400000,"y":5.9135,"equityReturn":-2.49,"unitMoney":""},{"x":1747756800000,"y":5.9335,"equityReturn":0.34,"unitMoney":""},{"x":1747843200000,"y":6.1325,"equityReturn":3.35,"unitMoney":""},{"x":1747929600000,"y":5.9903,"equityReturn":-2.32,"unitMoney":""},{"x":1748188800000,"y":6.1201,"equityReturn":2.17,"unitMoney":""},{"x":1748275200000,"y":6.2,"equityReturn":1.31,"unitMoney":""},{"x":1748361600000,"y":6.1373,"equityReturn":-1.01,"unitMoney":""},{"x":1748448000000,"y":6.0221,"equityReturn":-1.88,"unitMoney":""},{"x":1748534400000,"y":6.1013,"equityReturn":1.31,"unitMoney":""},{"x":1748793600000,"y":6.0981,"equityReturn":-0.05,"unitMoney":""},{"x":1748880000000,"y":5.9888,"equityReturn":-1.79,"unitMoney":""},{"x":1748966400000,"y":5.9875,"equityReturn":-0.02,"unitMoney":""},{"x":1749052800000,"y":6.0011,"equityReturn":0.23,"unitMoney":""},{"x":1749139200000,"y":5.9976,"equityReturn":-0.06,"unitMoney":""},{"x":1749398400000,"y":5.9313,"equityReturn":-1.11,"unitMoney":""},{"x":1749484800000,"y":5.8273,"equityReturn":-1.75,"unitMoney":""},{"x":1749571200000,"y":5.8342,"equityReturn":0.12,"unitMoney":""},{"x":1749657600000,"y":5.8782,"equityReturn":0.75,"unitMoney":""},{"x":1749744000000,"y":5.8391,"equityReturn":-0.67,"unitMoney":""},{"x":1750003200000,"y":5.7992,"equityReturn":-0.68,"unitMoney":""},{"x":1750089600000,"y":5.8794,"equityReturn":1.38,"unitMoney":""},{"x":1750176000000,"y":6.0401,"equityReturn":2.73,"unitMoney":""},{"x":1750262400000,"y":5.992,"equityReturn":-0.8,"unitMoney":""},{"x":1750348800000,"y":6.1336,"equityReturn":2.36,"unitMoney":""},{"x":1750608000000,"y":6.0977,"equityReturn":-0.58,"unitMoney":""},{"x":1750694400000,"y":6.1813,"equityReturn":1.37,"unitMoney":""},{"x":1750780800000,"y":6.2964,"equityReturn":1.86,"unitMoney":""},{"x":1750867200000,"y":6.1676,"equityReturn":-2.05,"unitMoney":""},{"x":1750953600000,"y":6.2425,"equityReturn":1.22,"unitMoney":""},{"x":1751212800000,"y":6.1765,"equityReturn":-1.06,"unitMoney":""}];/*累计净值走势*/var Data_ACWorthTrend = [[1672675200000,3.651],[1672761600000,3.6474],[1672848000000,3.5899],[1672934400000,3.5886],[1673193600000,3.5078],[1673280000000,3.4667],[1673366400000,3.5032],[1673452800000,3.4955],[1673539200000,3.4679],[1673798400000,3.5164],[1673884800000,3.5284],[1673971200000,3.5138],[1674057600000,3.459],[1674144000000,3.4137],[1674403200000,3.3061],[1674489600000,3.3084],[1674576000000,3.3311],[1674662400000,3.1959],[1674748800000,3.1097],[1675008000000,3.0563],[1675094400000,3.0468],[1675180800000,3.0639],[1675267200000,3.0491],[1675353600000,3.0603],[1675612800000,3.1174],[1675699200000,3.0894],[1675785600000,3.1115],[1675872000000,3.0503],[1675958400000,3.0897],[1676217600000,3.0979],[1676304000000,3.1542],[1676390400000,3.1455],[1676476800000,3.1505],[1676563200000,3.2226],[1676822400000,3.2117],[1676908800000,3.2326],[1676995200000,3.1879],[1677081600000,3.1853],[1677168000000,3.1876],[1677427200000,3.1338],[1677513600000,3.1873],[1677600000000,3.1594],[1677686400000,3.17],[1677772800000,3.0817],[1678032000000,3.1264],[1678118400000,3.1233],[1678204800000,3.1131],[1678291200000,3.0871],[1678377600000,3.1075],[1678636800000,3.1868],[1678723200000,3.1667],[1678809600000,3.1475],[1678896000000,3.0771],[1678982400000,3.0858],[1679241600000,3.1429],[1679328000000,3.1271],[1679414400000,3.1948],[1679500800000,3.3246],[1679587200000,3.3532],[1679846400000,3.2613],[1679932800000,3.2642],[1680019200000,3.3136],[1680105600000,3.1696],[1680192000000,3.1387],[1680451200000,3.1921],[1680537600000,3.1731],[1680624000000,3.1503],[1680710400000,3.2189],[1680796800000,3.1742],[1681056000000,3.2135],[1681142400000,3.1984],[1681228800000,3.2173],[1681315200000,3.2071],[1681401600000,3.2176],[1681660800000,3.1567],[1681747200000,3.2238],[1681833600000,3.2805],[1681920000000,3.2583],[1682006400000,3.2668],[1682265600000,3.2887],[1682352000000,3.3553],[1682438400000,3.3521],[1682524800000,3.3429],[1682611200000,3.4144],[1682870400000,3.4399],[1682956800000,3.4579],[1683043200000,3.3992],[1683129600000,3.3105],[1683216000000,3.3352],[1683475200000,3.3112],[1683561600000,3.3885],[1683648000000,3.4034],[1683734400000,3.4486],[1683820800000,3.4721],[1684080000000,3.3921],[1684166400000,3.426],[1684252800000,3.4056],[1684339200000,3.4517],[1684425600000,3.5267],[1684684800000,3.5637],[1684771200000,3.5709],[1684857600000,3.4918],[1684944000000,3.4594],[1685030400000,3.4816],[1685289600000,3.4823],[1685376000000,3.4799],[1685462400000,3.4914],[1685548800000,3.5099],[1685635200000,3.5338],[1685894400000,3.5392],[1685980800000,3.5668],[1686067200000,3.5633],[1686153600000,3.6136],[1686240000000,3.5316],[1686499200000,3.4679],[1686585600000,3.4562],[1686672000000,3.5109],[1686758400000,3.5689],[1686844800000,3.5627],[1687104000000,3.6371],[1687190400000,3.6201],[1687276800000,3.6354],[1687363200000,3.6617],[1687449600000,3.6143],[1687708800000,3.7259],[1687795200000,3.7115],[1687881600000,3.7338],[1687968000000,3.7858],[1688054400000,3.7831],[1688313600000,3.7542],[1688400000000,3.8087],[1688486400000,3.8091],[1688572800000,3.8368],[1688659200000,3.8888],[1688918400000,3.9828],[1689004800000,3.9761],[1689091200000,3.9772],[1689177600000,3.9301],[1689264000000,3.9551],[1689523200000,3.8909],[1689609600000,4.0113],[1689696000000,4.0562],[1689782400000,4.0485],[1689868800000,4.0613],[1690128000000,4.0004],[1690214400000,3.9426],[1690300800000,3.9099],[1690387200000,3.8133],[1690473600000,3.7993],[1690732800000,3.8139],[1690819200000,3.6458],[1690905600000,3.7049],[1690992000000,3.7732],[1691078400000,3.8723],[1691337600000,3.9124],[1691424000000,3.9191],[1691510400000,3.8964],[1691596800000,3.8825],[1691683200000,3.9084],[1691942400000,3.8156],[1692028800000,3.7395],[1692115200000,3.7585],[1692201600000,3.7234],[1692288000000,3.7412],[1692547200000,3.7591],[1692633600000,3.8362],[1692720000000,3.869],[1692806400000,3.817],[1692892800000,3.9033],[1693152000000,3.8583],[1693238400000,3.8525],[1693324800000,3.8189],[1693411200000,3.7268],[1693497600000,3.7207],[1693756800000,3.7444],[1693843200000,3.7713],[1693929600000,3.7827],[1694016000000,3.8861],[1694102400000,3.9767],[1694361600000,3.871],[1694448000000,4.0053],[1694534400000,4.093],[1694620800000,4.1384],[1694707200000,4.2388],[1694966400000,4.2358],[1695052800000,4.3158],[1695139200000,4.4072],[1695225600000,4.4043],[1695312000000,4.4022],[1695571200000,4.4124],[1695657600000,4.3238],[1695744000000,4.2958],[1695830400000,4.3704],[1695916800000,4.3723],[1696176000000,4.4558],[1696262400000,4.4678],[1696348800000,4.5013],[1696435200000,4.477],[1696521600000,4.4998],[1696780800000,4.5267],[1696867200000,4.4835],[1696953600000,4.5155],[1697040000000,4.6096],[1697126400000,4.4913],[1697385600000,4.5187],[1697472000000,4.5591],[1697558400000,4.6406],[1697644800000,4.6669],[1697731200000,4.7478],[1697990400000,4.6673],[1698076800000,4.7261],[1698163200000,4.7713],[1698249600000,4.8081],[1698336000000,4.7772],[1698595200000,4.871],[1698681600000,4.9163],[1698768000000,4.8177],[1698854400000,4.8734],[1698940800000,4.8979],[1699200000000,4.9777],[1699286400000,4.9598],[1699372800000,4.9856],[1699459200000,4.9733],[1699545600000,4.9665],[1699804800000,4.9708],[1699891200000,4.9187],[1699977600000,4.8051],[1700064000000,4.8189],[1700150400000,4.7088],[1700409600000,4.8277],[1700496000000,4.6614],[1700582400000,4.7121],[1700668800000,4.823],[1700755200000,4.7365],[1701014400000,4.8193],[1701100800000,4.7193],[1701187200000,4.6627],[1701273600000,4.743],[1701360000000,4.774],[1701619200000,4.7505],[1701705600000,4.7115],[1701792000000,4.7562],[1701878400000,4.7665],[1701964800000,4.8359],[1702224000000,4.7788],[1702310400000,4.7465],[1702396800000,4.6577],[1702483200000,4.5525],[1702569600000,4.5387],[1702828800000,4.3853],[1702915200000,4.3603],[1703001600000,4.3226],[1703088000000,4.3541],[1703174400000,4.4639],[1703433600000,4.5075],[1703520000000,4.4464],[1703606400000,4.4071],[1703692800000,4.458],[1703779200000,4.3828],[1704038400000,4.4469],[1704124800000,4.3479],[1704211200000,4.3203],[1704297600000,4.2684],[1704384000000,4.2339],[1704643200000,4.2571],[1704729600000,4.1197],[1704816000000,4.1679],[1704902400000,4.2384],[1704988800000,4.246],[1705248000000,4.1288],[1705334400000,4.1557],[1705420800000,4.0599],[1705507200000,4.0643],[1705593600000,4.0891],[1705852800000,4.0602],[1705939200000,4.0183],[1706025600000,3.9537],[1706112000000,3.9587],[1706198400000,3.9658],[1706457600000,3.923],[1706544000000,3.8152],[1706630400000,3.8463],[1706716800000,3.876],[1706803200000,3.7993],[1707062400000,3.7822],[1707148800000,3.7542],[1707235200000,3.7178],[1707321600000,3.6803],[1707408000000,3.7275],[1707667200000,3.6816],[1707753600000,3.6405],[1707840000000,3.6018],[1707926400000,3.6549],[1708012800000,3.6821],[1708272000000,3.7208],[1708358400000,3.7821],[1708444800000,3.7922],[1708531200000,3.8434],[1708617600000,3.9281],[1708876800000,3.8959],[1708963200000,3.8375],[1709049600000,3.8248],[1709136000000,3.8796],[1709222400000,3.7897],[1709481600000,3.8235],[1709568000000,3.8704],[1709654400000,3.8029],[1709740800000,3.7736],[1709827200000,3.8022],[1710086400000,3.8531],[1710172800000,3.9074],[1710259200000,3.9638],[1710345600000,3.9519],[1710432000000,3.9206],[1710691200000,3.8923],[1710777600000,3.9552],[1710864000000,3.9751],[1710950400000,3.9928],[1711036800000,3.9511],[1711296000000,3.9298],[1711382400000,3.883],[1711468800000,3.8701],[1711555200000,3.8109],[1711641600000,3.8795],[1711900800000,3.9412],[1711987200000,3.9219],[1712073600000,3.9371],[1712160000000,3.9924],[1712246400000,3.9764],[1712505600000,3.9382],[1712592000000,3.87],[1712678400000,3.8517],[1712764800000,3.8645],[1712851200000,3.9551],[1713110400000,3.9679],[1713196800000,4.0151],[1713283200000,4.0413],[1713369600000,3.9843],[1713456000000,4.0176],[1713715200000,3.9779],[1713801600000,3.9259],[1713888000000,3.9205],[1713974400000,4.0389],[1714060800000,4.0403],[1714320000000,3.9366],[1714406400000,3.8941],[1714492800000,3.8608],[1714579200000,3.8399],[1714665600000,3.7991],[1714924800000,3.8036],[1715011200000,3.7335],[1715097600000,3.6971],[1715184000000,3.723],[1715270400000,3.8002],[1715529600000,3.8057],[1715616000000,3.792],[1715702400000,3.7406],[1715788800000,3.6656],[1715875200000,3.6631],[1716134400000,3.6692],[1716220800000,3.6525],[1716307200000,3.6592],[1716393600000,3.6529],[1716480000000,3.5625],[1716739200000,3.5771],[1716825600000,3.5521],[1716912000000,3.5655],[1716998400000,3.5918],[1717084800000,3.6272],[1717344000000,3.64],[1717430400000,3.5791],[1717516800000,3.5417],[1717603200000,3.5516],[1717689600000,3.5131],[1717948800000,3.5111],[1718035200000,3.563],[1718121600000,3.5834],[1718208000000,3.5852],[1718294400000,3.5506],[1718553600000,3.5645],[1718640000000,3.5367],[1718726400000,3.5789],[1718812800000,3.5982],[1718899200000,3.5944],[1719158400000,3.6533],[1719244800000,3.8142],[1719331200000,3.8486],[1719417600000,3.8348],[1719504000000,3.7867],[1719763200000,3.756],[1719849600000,3.8084],[1719936000000,3.7773],[1720022400000,3.7384],[1720108800000,3.7135],[1720368000000,3.7837],[1720454400000,3.8101],[1720540800000,3.7772],[1720627200000,3.8222],[1720713600000,3.8508],[1720972800000,3.8169],[1721059200000,3.8217],[1721145600000,3.9535],[1721232000000,3.9556],[1721318400000,3.9421],[1721577600000,4.0184],[1721664000000,4.0303],[1721750400000,4.159],[1721836800000,4.1713],[1721923200000,4.214],[1722182400000,4.2673],[1722268800000,4.337],[1722355200000,4.3805],[1722441600000,4.3027],[1722528000000,4.36],[1722787200000,4.308],[1722873600000,4.3352],[1722960000000,4.3448],[1723046400000,4.3984],[1723132800000,4.3734],[1723392000000,4.4293],[1723478400000,4.5487],[1723564800000,4.5627],[1723651200000,4.5736],[1723737600000,4.5443],[1723996800000,4.4834],[1724083200000,4.3847],[1724169600000,4.3886],[1724256000000,4.4115],[1724342400000,4.3767],[1724601600000,4.4077],[1724688000000,4.4038],[1724774400000,4.3381],[1724860800000,4.257],[1724947200000,4.1748],[1725206400000,4.2981],[1725292800000,4.4599],[1725379200000,4.5019],[1725465600000,4.4114],[1725552000000,4.4508],[1725811200000,4.4925],[1725897600000,4.4219],[1725984000000,4.3043],[1726070400000,4.2202],[1726156800000,4.1605],[1726416000000,4.1972],[1726502400000,4.2635],[1726588800000,4.3116],[1726675200000,4.3359],[1726761600000,4.3668],[1727020800000,4.4199],[1727107200000,4.4187],[1727193600000,4.456],[1727280000000,4.494],[1727366400000,4.5469],[1727625600000,4.5645],[1727712000000,4.6634],[1727798400000,4.6381],[1727884800000,4.5163],[1727971200000,4.487],[1728230400000,4.5088],[1728316800000,4.4549],[1728403200000,4.3885],[1728489600000,4.3117],[1728576000000,4.2818],[1728835200000,4.2774],[1728921600000,4.3267],[1729008000000,4.4054],[1729094400000,4.4487],[1729180800000,4.5007],[1729440000000,4.6322],[1729526400000,4.5291],[1729612800000,4.5299],[1729699200000,4.4878],[1729785600000,4.5548],[1730044800000,4.5191],[1730131200000,4.5233],[1730217600000,4.5565],[1730304000000,4.5699],[1730390400000,4.5615],[1730649600000,4.5576],[1730736000000,4.5589],[1730822400000,4.4927],[1730908800000,4.5142],[1730995200000,4.5333],[1731254400000,4.4299],[1731340800000,4.463],[1731427200000,4.5268],[1731513600000,4.5426],[1731600000000,4.5354],[1731859200000,4.5053],[1731945600000,4.4713],[1732032000000,4.3976],[1732118400000,4.4083],[1732204800000,4.3199],[1732464000000,4.3104],[1732550400000,4.2903],[1732636800000,4.2963],[1732723200000,4.2347],[1732809600000,4.1859],[1733068800000,4.268],[1733155200000,4.3723],[1733241600000,4.4527],[1733328000000,4.4984],[1733414400000,4.5687],[1733673600000,4.5848],[1733760000000,4.5218],[1733846400000,4.5427],[1733932800000,4.6901],[1734019200000,4.6777],[1734278400000,4.7303],[1734364800000,4.7503],[1734451200000,4.7782],[1734537600000,4.8434],[1734624000000,4.835],[1734883200000,4.9351],[1734969600000,5.0534],[1735056000000,5.0247],[1735142400000,5.082],[1735228800000,5.0047],[1735488000000,5.0884],[1735574400000,5.1808],[1735660800000,5.2286],[1735747200000,5.0853],[1735833600000,5.2079],[1736092800000,5.1727],[1736179200000,5.3731],[1736265600000,5.2798],[1736352000000,5.4174],[1736438400000,5.4773],[1736697600000,5.6709],[1736784000000,5.7428],[1736870400000,5.745],[1736956800000,5.8372],[1737043200000,5.8788],[1737302400000,5.825],[1737388800000,5.9468],[1737475200000,5.8415],[1737561600000,5.8137],[1737648000000,5.8437],[1737907200000,5.8979],[1737993600000,5.9011],[1738080000000,5.8856],[1738166400000,5.8486],[1738252800000,5.8787],[1738512000000,5.9277],[1738598400000,5.9635],[1738684800000,5.9366],[1738771200000,5.951],[1738857600000,5.9443],[1739116800000,5.9448],[1739203200000,5.835],[1739289600000,5.7951],[1739376000000,5.613],[1739462400000,5.7764],[1739721600000,5.598],[1739808000000,5.5773],[1739894400000,5.5152],[1739980800000,5.5375],[1740067200000,5.4934],[1740326400000,5.4369],[1740412800000,5.4822],[1740499200000,5.5332],[1740585600000,5.6082],[1740672000000,5.5074],[1740931200000,5.4306],[1741017600000,5.4608],[1741104000000,5.4302],[1741190400000,5.4618],[1741276800000,5.6929],[1741536000000,5.7081],[1741622400000,5.7613],[1741708800000,5.7342],[1741795200000,5.7414],[1741881600000,5.68],[1742140800000,5.8596],[1742227200000,6.0841],[1742313600000,6.1091],[1742400000000,5.9382],[1742486400000,5.8515],[1742745600000,5.8387],[1742832000000,5.896],[1742918400000,5.7092],[1743004800000,5.6805],[1743091200000,5.7226],[1743350400000,5.6683],[1743436800000,5.6054],[1743523200000,5.5984],[1743609600000,5.5933],[1743696000000,5.6095],[1743955200000,5.5868],[1744041600000,5.4376],[1744128000000,5.4824],[1744214400000,5.509],[1744300800000,5.4762],[1744560000000,5.5758],[1744646400000,5.6744],[1744732800000,5.6659],[1744819200000,5.7047],[1744905600000,5.5744],[1745164800000,5.5527],[1745251200000,5.6228],[1745337600000,5.6163],[1745424000000,5.5708],[1745510400000,5.5998],[1745769600000,5.7517],[1745856000000,5.6891],[1745942400000,5.6793],[1746028800000,5.859],[1746115200000,5.9291],[1746374400000,6.0076],[1746460800000,6.1226],[1746547200000,6.1904],[1746633600000,6.2511],[1746720000000,6.1376],[1746979200000,6.164],[1747065600000,6.1518],[1747152000000,6.1168],[1747238400000,5.9803],[1747324800000,6.0041],[1747584000000,6.0647],[1747670400000,5.9135],[1747756800000,5.9335],[1747843200000,6.1325],[1747929600000,5.9903],[1748188800000,6.1201],[1748275200000,6.2],[1748361600000,6.1373],[1748448000000,6.0221],[1748534400000,6.1013],[1748793600000,6.0981],[1748880000000,5.9888],[1748966400000,5.9875],[1749052800000,6.0011],[1749139200000,5.9976],[1749398400000,5.9313],[1749484800000,5.8273],[1749571200000,5.8342],[1749657600000,5.8782],[1749744000000,5.8391],[1750003200000,5.7992],[1750089600000,5.8794],[1750176000000,6.0401],[1750262400000,5.992],[1750348800000,6.1336],[1750608000000,6.0977],[1750694400000,6.1813],[1750780800000,6.2964],[1750867200000,6.1676],[1750953600000,6.2425],[1751212800000,6.1765]];/*累计收益率走势*/var Data_grandTotal = [{"name":"易方达消费行业股票","data":[[1719763200000,0.0],[1719849600000,1.4],[1719936000000,0.57],[1720022400000,-0.47],[1720108800000,-1.13],[1720368000000,0.74],[1720454400000,1.44],[1720540800000,0.56],[1720627200000,1.76],[1720713600000,2.52],[1720972800000,1.62],[1721059200000,1.75],[1721145600000,5.26],[1721232000000,5.31],[1721318400000,4.95],[1721577600000,6.99],[1721664000000,7.3],[1721750400000,10.73],[1721836800000,11.06],[1721923200000,12.19],[1722182400000,13.61],[1722268800000,15.47],[1722355200000,16.63],[1722441600000,14.56],[1722528000000,16.08],[1722787200000,14.7],[1722873600000,15.42],[1722960000000,15.68],[1723046400000,17.1],[1723132800000,16.44],[1723392000000,17.93],[1723478400000,21.1],[1723564800000,21.48],[1723651200000,21.77],[1723737600000,20.99],[1723996800000,19.37],[1724083200000,16.74],[1724169600000,16.84],[1724256000000,17.45],[1724342400000,16.53],[1724601600000,17.35],[1724688000000,17.25],[1724774400000,15.5],[1724860800000,13.34],[1724947200000,11.15],[1725206400000,14.43],[1725292800000,18.74],[1725379200000,19.86],[1725465600000,17.45],[1725552000000,18.5],[1725811200000,19.61],[1725897600000,17.73],[1725984000000,14.6],[1726070400000,12.36],[1726156800000,10.77],[1726416000000,11.75],[1726502400000,13.51],[1726588800000,14.79],[1726675200000,15.44],[1726761600000,16.26],[1727020800000,17.68],[1727107200000,17.64],[1727193600000,18.64],[1727280000000,19.65],[1727366400000,21.06],[1727625600000,21.53],[1727712000000,24.16],[1727798400000,23.49],[1727884800000,20.24],[1727971200000,19.46],[1728230400000,20.04],[1728316800000,18.61],[1728403200000,16.84],[1728489600000,14.79],[1728576000000,14.0],[1728835200000,13.88],[1728921600000,15.19],[1729008000000,17.29],[1729094400000,18.44],[1729180800000,19.83],[1729440000000,23.33],[1729526400000,20.58],[1729612800000,20.6],[1729699200000,19.48],[1729785600000,21.27],[1730044800000,20.32],[1730131200000,20.43],[1730217600000,21.31],[1730304000000,21.67],[1730390400000,21.45],[1730649600000,21.34],[1730736000000,21.38],[1730822400000,19.61],[1730908800000,20.19],[1730995200000,20.69],[1731254400000,17.94],[1731340800000,18.82],[1731427200000,20.52],[1731513600000,20.94],[1731600000000,20.75],[1731859200000,19.95],[1731945600000,19.04],[1732032000000,17.08],[1732118400000,17.37],[1732204800000,15.01],[1732464000000,14.76],[1732550400000,14.23],[1732636800000,14.38],[1732723200000,12.74],[1732809600000,11.45],[1733068800000,13.63],[1733155200000,16.41],[1733241600000,18.55],[1733328000000,19.77],[1733414400000,21.64],[1733673600000,22.07],[1733760000000,20.39],[1733846400000,20.95],[1733932800000,24.87],[1734019200000,24.54],[1734278400000,25.94],[1734364800000,26.47],[1734451200000,27.22],[1734537600000,28.95],[1734624000000,28.73],[1734883200000,31.39],[1734969600000,34.54],[1735056000000,33.78],[1735142400000,35.3],[1735228800000,33.25],[1735488000000,35.47],[1735574400000,37.93],[1735660800000,39.21],[1735747200000,35.39],[1735833600000,38.66],[1736092800000,37.72],[1736179200000,43.05],[1736265600000,40.57],[1736352000000,44.23],[1736438400000,45.83],[1736697600000,50.98],[1736784000000,52.9],[1736870400000,52.96],[1736956800000,55.41],[1737043200000,56.52],[1737302400000,55.09],[1737388800000,58.33],[1737475200000,55.52],[1737561600000,54.78],[1737648000000,55.58],[1737907200000,57.03],[1737993600000,57.11],[1738080000000,56.7],[1738166400000,55.71],[1738252800000,56.51],[1738512000000,57.82],[1738598400000,58.77],[1738684800000,58.06],[1738771200000,58.44],[1738857600000,58.26],[1739116800000,58.27],[1739203200000,55.35],[1739289600000,54.29],[1739376000000,49.44],[1739462400000,53.79],[1739721600000,49.04],[1739808000000,48.49],[1739894400000,46.84],[1739980800000,47.43],[1740067200000,46.26],[1740326400000,44.75],[1740412800000,45.96],[1740499200000,47.32],[1740585600000,49.31],[1740672000000,46.63],[1740931200000,44.58],[1741017600000,45.39],[1741104000000,44.57],[1741190400000,45.42],[1741276800000,51.57],[1741536000000,51.97],[1741622400000,53.39],[1741708800000,52.67],[1741795200000,52.86],[1741881600000,51.22],[1742140800000,56.01],[1742227200000,61.98],[1742313600000,62.65],[1742400000000,58.1],[1742486400000,55.79],[1742745600000,55.45],[1742832000000,56.98],[1742918400000,52.0],[1743004800000,51.24],[1743091200000,52.36],[1743350400000,50.91],[1743436800000,49.24],[1743523200000,49.05],[1743609600000,48.92],[1743696000000,49.35],[1743955200000,48.74],[1744041600000,44.77],[1744128000000,45.96],[1744214400000,46.67],[1744300800000,45.8],[1744560000000,48.45],[1744646400000,51.08],[1744732800000,50.85],[1744819200000,51.88],[1744905600000,48.41],[1745164800000,47.84],[1745251200000,49.7],[1745337600000,49.53],[1745424000000,48.32],[1745510400000,49.09],[1745769600000,53.13],[1745856000000,51.47],[1745942400000,51.21],[1746028800000,55.99],[1746115200000,57.86],[1746374400000,59.95],[1746460800000,63.01],[1746547200000,64.81],[1746633600000,66.43],[1746720000000,63.41],[1746979200000,64.11],[1747065600000,63.79],[1747152000000,62.85],[1747238400000,59.22],[1747324800000,59.85],[1747584000000,61.47],[1747670400000,57.44],[1747756800000,57.97],[1747843200000,63.27],[1747929600000,59.49],[1748188800000,62.94],[1748275200000,65.07],[1748361600000,63.4],[1748448000000,60.33],[1748534400000,62.44],[1748793600000,62.36],[1748880000000,59.45],[1748966400000,59.41],[1749052800000,59.77],[1749139200000,59.68],[1749398400000,57.92],[1749484800000,55.15],[1749571200000,55.33],[1749657600000,56.5],[1749744000000,55.46],[1750003200000,54.4],[1750089600000,56.53],[1750176000000,60.81],[1750262400000,59.53],[1750348800000,63.3],[1750608000000,62.35],[1750694400000,64.57],[1750780800000,67.64],[1750867200000,64.21],[1750953600000,66.2],[1751212800000,64.44]]},{"name":"同类平均","data":[[1719763200000,0.0],[1719849600000,-0.48],[1719936000000,-1.04],[1720022400000,-0.33],[1720108800000,-1.05],[1720368000000,-2.47],[1720454400000,-3.24],[1720540800000,-1.3],[1720627200000,0.23],[1720713600000,-0.31],[1720972800000,-0.89],[1721059200000,-0.69],[1721145600000,-1.28],[1721232000000,-0.23],[1721318400000,-0.29],[1721577600000,-1.14],[1721664000000,-0.1],[1721750400000,-0.55],[1721836800000,-0.37],[1721923200000,-0.37],[1722182400000,-0.61],[1722268800000,-0.34],[1722355200000,-0.88],[1722441600000,-2.33],[1722528000000,-4.05],[1722787200000,-5.01],[1722873600000,-5.58],[1722960000000,-5.59],[1723046400000,-5.54],[1723132800000,-5.11],[1723392000000,-5.01],[1723478400000,-5.6],[1723564800000,-6.12],[1723651200000,-7.71],[1723737600000,-7.82],[1723996800000,-7.46],[1724083200000,-7.05],[1724169600000,-7.14],[1724256000000,-7.26],[1724342400000,-6.55],[1724601600000,-6.53],[1724688000000,-5.97],[1724774400000,-5.52],[1724860800000,-5.35],[1724947200000,-4.35],[1725206400000,-4.78],[1725292800000,-5.05],[1725379200000,-5.65],[1725465600000,-6.24],[1725552000000,-5.07],[1725811200000,-3.72],[1725897600000,-3.69],[1725984000000,-3.24],[1726070400000,-2.33],[1726156800000,-1.68],[1726416000000,-0.73],[1726502400000,-1.72],[1726588800000,-2.21],[1726675200000,-1.85],[1726761600000,-0.71],[1727020800000,-0.62],[1727107200000,-1.29],[1727193600000,-1.56],[1727280000000,-2.07],[1727366400000,-2.74],[1727625600000,-1.56],[1727712000000,-2.04],[1727798400000,-2.01],[1727884800000,-0.31],[1727971200000,0.65],[1728230400000,0.93],[1728316800000,0.44],[1728403200000,0.78],[1728489600000,2.1],[1728576000000,2.62],[1728835200000,3.66],[1728921600000,3.76],[1729008000000,4.19],[1729094400000,4.04],[1729180800000,4.4],[1729440000000,5.5],[1729526400000,4.3],[1729612800000,4.26],[1729699200000,4.47],[1729785600000,4.01],[1730044800000,3.76],[1730131200000,4.42],[1730217600000,6.11],[1730304000000,6.65],[1730390400000,6.94],[1730649600000,5.62],[1730736000000,7.26],[1730822400000,7.34],[1730908800000,7.32],[1730995200000,6.37],[1731254400000,6.33],[1731340800000,5.41],[1731427200000,5.48],[1731513600000,5.89],[1731600000000,5.92],[1731859200000,6.17],[1731945600000,5.46],[1732032000000,6.67],[1732118400000,6.13],[1732204800000,4.59],[1732464000000,4.45],[1732550400000,3.82],[1732636800000,2.99],[1732723200000,2.71],[1732809600000,2.96],[1733068800000,2.0],[1733155200000,1.89],[1733241600000,3.07],[1733328000000,3.64],[1733414400000,3.52],[1733673600000,3.64],[1733760000000,3.55],[1733846400000,3.52],[1733932800000,4.14],[1734019200000,4.07],[1734278400000,2.08],[1734364800000,2.07],[1734451200000,1.36],[1734537600000,1.9],[1734624000000,1.41],[1734883200000,1.54],[1734969600000,3.32],[1735056000000,2.46],[1735142400000,1.55],[1735228800000,0.42],[1735488000000,-1.5],[1735574400000,-2.97],[1735660800000,-2.68],[1735747200000,-3.16],[1735833600000,-4.6],[1736092800000,-5.72],[1736179200000,-5.25],[1736265600000,-5.83],[1736352000000,-6.09],[1736438400000,-5.84],[1736697600000,-4.8],[1736784000000,-3.32],[1736870400000,-2.51],[1736956800000,-2.39],[1737043200000,-2.23],[1737302400000,-0.81],[1737388800000,0.33],[1737475200000,0.09],[1737561600000,0.47],[1737648000000,0.71],[1737907200000,0.76],[1737993600000,0.37],[1738080000000,-0.69],[1738166400000,-1.1],[1738252800000,-2.31],[1738512000000,-1.35],[1738598400000,-0.91],[1738684800000,-1.86],[1738771200000,-0.76],[1738857600000,-0.04],[1739116800000,-1.55],[1739203200000,-0.09],[1739289600000,0.56],[1739376000000,2.23],[1739462400000,1.24],[1739721600000,1.68],[1739808000000,2.03],[1739894400000,2.21],[1739980800000,2.36],[1740067200000,3.23],[1740326400000,2.01],[1740412800000,1.0],[1740499200000,-0.11],[1740585600000,-0.55],[1740672000000,-1.02],[1740931200000,-0.72],[1741017600000,-0.5],[1741104000000,-0.46],[1741190400000,-0.99],[1741276800000,-1.33],[1741536000000,-0.57],[1741622400000,0.05],[1741708800000,0.14],[1741795200000,-0.11],[1741881600000,1.14],[1742140800000,0.67],[1742227200000,1.2],[1742313600000,2.15],[1742400000000,1.94],[1742486400000,2.62],[1742745600000,1.72],[1742832000000,2.55],[1742918400000,2.72],[1743004800000,1.43],[1743091200000,1.98],[1743350400000,1.27],[1743436800000,2.32],[1743523200000,1.77],[1743609600000,1.65],[1743696000000,1.89],[1743955200000,1.63],[1744041600000,1.85],[1744128000000,1.41],[1744214400000,1.96],[1744300800000,1.98],[1744560000000,2.16],[1744646400000,-0.08],[1744732800000,0.86],[1744819200000,0.89],[1744905600000,-0.54],[1745164800000,-0.45],[1745251200000,-0.07],[1745337600000,0.8],[1745424000000,-0.06],[1745510400000,1.18],[1745769600000,1.06],[1745856000000,3.01],[1745942400000,2.9],[1746028800000,3.47],[1746115200000,3.18],[1746374400000,2.27],[1746460800000,3.17],[1746547200000,3.93],[1746633600000,5.22],[1746720000000,5.95],[1746979200000,5.48],[1747065600000,4.09],[1747152000000,3.55],[1747238400000,3.0],[1747324800000,2.34],[1747584000000,2.83],[1747670400000,3.11],[1747756800000,2.9],[1747843200000,3.05],[1747929600000,2.94],[1748188800000,3.13],[1748275200000,3.76],[1748361600000,4.56],[1748448000000,4.0],[1748534400000,2.76],[1748793600000,3.94],[1748880000000,4.05],[1748966400000,4.98],[1749052800000,3.61],[1749139200000,3.34],[1749398400000,3.38],[1749484800000,2.2],[1749571200000,1.78],[1749657600000,2.38],[1749744000000,3.28],[1750003200000,4.61],[1750089600000,3.89],[1750176000000,2.74],[1750262400000,3.18],[1750348800000,3.96],[1750608000000,4.13],[1750694400000,3.06],[1750780800000,3.72],[1750867200000,4.38],[1750953600000,4.86],[1751212800000,4.46]]},{"name":"沪深300","data":[[1719763200000,0.0],[1719849600000,0.75],[1719936000000,-0.33],[1720022400000,-2.94],[1720108800000,-3.94],[1720368000000,-2.42],[1720454400000,-2.78],[1720540800000,-4.1],[1720627200000,-4.82],[1720713600000,-4.32],[1720972800000,-3.83],[1721059200000,-3.65],[1721145600000,-2.21],[1721232000000,-1.51],[1721318400000,-1.52],[1721577600000,-0.93],[1721664000000,0.72],[1721750400000,1.71],[1721836800000,2.76],[1721923200000,1.66],[1722182400000,1.52],[1722268800000,2.27],[1722355200000,1.98],[1722441600000,3.08],[1722528000000,3.7],[1722787200000,4.66],[1722873600000,4.44],[1722960000000,7.11],[1723046400000,8.45],[1723132800000,8.23],[1723392000000,8.34],[1723478400000,11.16],[1723564800000,10.79],[1723651200000,11.77],[1723737600000,12.88],[1723996800000,12.9],[1724083200000,11.59],[1724169600000,11.81],[1724256000000,12.22],[1724342400000,13.5],[1724601600000,14.4],[1724688000000,14.44],[1724774400000,15.43],[1724860800000,16.06],[1724947200000,16.31],[1725206400000,16.39],[1725292800000,16.12],[1725379200000,16.93],[1725465600000,15.71],[1725552000000,14.99],[1725811200000,15.01],[1725897600000,13.34],[1725984000000,12.85],[1726070400000,10.6],[1726156800000,9.85],[1726416000000,10.49],[1726502400000,11.13],[1726588800000,11.08],[1726675200000,10.83],[1726761600000,9.27],[1727020800000,11.28],[1727107200000,11.86],[1727193600000,13.1],[1727280000000,12.11],[1727366400000,11.91],[1727625600000,9.89],[1727712000000,10.76],[1727798400000,11.8],[1727884800000,9.69],[1727971200000,9.65],[1728230400000,10.35],[1728316800000,8.42],[1728403200000,6.45],[1728489600000,5.33],[1728576000000,4.67],[1728835200000,3.22],[1728921600000,3.26],[1729008000000,3.53],[1729094400000,4.19],[1729180800000,4.94],[1729440000000,6.52],[1729526400000,7.77],[1729612800000,6.37],[1729699200000,5.84],[1729785600000,4.73],[1730044800000,3.61],[1730131200000,3.54],[1730217600000,3.56],[1730304000000,4.08],[1730390400000,2.43],[1730649600000,1.18],[1730736000000,1.16],[1730822400000,0.97],[1730908800000,0.67],[1730995200000,0.61],[1731254400000,-0.14],[1731340800000,0.57],[1731427200000,0.94],[1731513600000,0.86],[1731600000000,0.19],[1731859200000,0.03],[1731945600000,-2.69],[1732032000000,-3.63],[1732118400000,-3.59],[1732204800000,-5.03],[1732464000000,-4.83],[1732550400000,-4.68],[1732636800000,-5.98],[1732723200000,-6.21],[1732809600000,-6.49],[1733068800000,-6.05],[1733155200000,-5.47],[1733241600000,-5.49],[1733328000000,-6.29],[1733414400000,-6.42],[1733673600000,-6.47],[1733760000000,-5.77],[1733846400000,-5.48],[1733932800000,-6.16],[1734019200000,-7.42],[1734278400000,-7.76],[1734364800000,-8.43],[1734451200000,-9.44],[1734537600000,-9.53],[1734624000000,-9.97],[1734883200000,-9.87],[1734969600000,-9.38],[1735056000000,-9.75],[1735142400000,-7.64],[1735228800000,-7.93],[1735488000000,-6.91],[1735574400000,-6.78],[1735660800000,-5.73],[1735747200000,-7.96],[1735833600000,-8.65],[1736092800000,-8.41],[1736179200000,-7.85],[1736265600000,-5.69],[1736352000000,-5.38],[1736438400000,-4.16],[1736697600000,-3.41],[1736784000000,-2.49],[1736870400000,-1.98],[1736956800000,-2.12],[1737043200000,-1.61],[1737302400000,-2.67],[1737388800000,-1.51],[1737475200000,-2.5],[1737561600000,-2.24],[1737648000000,-0.16],[1737907200000,-0.37],[1737993600000,-0.35],[1738080000000,0.82],[1738166400000,0.86],[1738252800000,0.06],[1738512000000,0.32],[1738598400000,0.92],[1738684800000,1.64],[1738771200000,0.87],[1738857600000,2.65],[1739116800000,4.37],[1739203200000,4.4],[1739289600000,4.69],[1739376000000,4.25],[1739462400000,5.74],[1739721600000,5.0],[1739808000000,5.72],[1739894400000,5.22],[1739980800000,4.5],[1740067200000,5.26],[1740326400000,6.68],[1740412800000,6.68],[1740499200000,5.97],[1740585600000,6.84],[1740672000000,6.79],[1740931200000,7.14],[1741017600000,8.78],[1741104000000,10.02],[1741190400000,9.46],[1741276800000,11.97],[1741536000000,11.99],[1741622400000,12.88],[1741708800000,12.16],[1741795200000,12.12],[1741881600000,10.17],[1742140800000,12.15],[1742227200000,13.69],[1742313600000,12.32],[1742400000000,10.64],[1742486400000,8.86],[1742745600000,10.15],[1742832000000,9.65],[1742918400000,9.6],[1743004800000,9.27],[1743091200000,9.14],[1743350400000,7.97],[1743436800000,8.0],[1743523200000,6.46],[1743609600000,6.4],[1743696000000,6.74],[1743955200000,7.25],[1744041600000,7.01],[1744128000000,6.05],[1744214400000,6.23],[1744300800000,5.73],[1744560000000,7.39],[1744646400000,8.23],[1744732800000,8.12],[1744819200000,7.62],[1744905600000,6.87],[1745164800000,5.88],[1745251200000,5.52],[1745337600000,5.84],[1745424000000,6.39],[1745510400000,7.01],[1745769600000,9.27],[1745856000000,8.51],[1745942400000,8.53],[1746028800000,11.58],[1746115200000,9.5],[1746374400000,8.94],[1746460800000,9.14],[1746547200000,9.32],[1746633600000,9.78],[1746720000000,9.53],[1746979200000,9.94],[1747065600000,10.01],[1747152000000,10.87],[1747238400000,8.78],[1747324800000,7.83],[1747584000000,7.84],[1747670400000,6.73],[1747756800000,5.63],[1747843200000,6.3],[1747929600000,5.62],[1748188800000,6.3],[1748275200000,7.11],[1748361600000,7.45],[1748448000000,8.0],[1748534400000,7.9],[1748793600000,6.39],[1748880000000,6.37],[1748966400000,6.86],[1749052800000,6.31],[1749139200000,6.21],[1749398400000,7.02],[1749484800000,6.09],[1749571200000,6.78],[1749657600000,8.78],[1749744000000,8.19],[1750003200000,8.36],[1750089600000,8.2],[1750176000000,9.88],[1750262400000,10.24],[1750348800000,11.24],[1750608000000,10.48],[1750694400000,10.48],[1750780800000,10.48],[1750867200000,8.53],[1750953600000,10.1],[1751212800000,11.1]]}];/*同类排名走势*/var Data_rateInSimilarType = [{"x":1744041600000,"y":747,"sc":"4567"},{"x":1744646400000,"y":830,"sc":"4567"},{"x":1745251200000,"y":398,"sc":"4567"},{"x":1745856000000,"y":895,"sc":"4567"},{"x":1746460800000,"y":635,"sc":"4567"},{"x":1747065600000,"y":893,"sc":"4567"},{"x":1747670400000,"y":570,"sc":"4567"},{"x":1748275200000,"y":663,"sc":"4567"},{"x":1748880000000,"y":643,"sc":"4567"},{"x":1749484800000,"y":261,"sc":"4567"},{"x":1750089600000,"y":722,"sc":"4567"},{"x":1750694400000,"y":499,"sc":"4567"}];/*同类排名百分比*/var Data_rateInSimilarPersent=[[1744041600000,27.52],[1744646400000,42.37],[1745251200000,67.81],[1745856000000,35.21],[1746460800000,56.32],[1747065600000,73.97],[1747670400000,67.85],[1748275200000,65.6],[1748880000000,28.31],[1749484800000,40.61],[1750089600000,72.67],[1750694400000,58.07]];/*规模变动 mom-较上期环比*/var Data_fluctuationScale = {"categories":["2024-06-30","2024-09-30","2024-12-31","2025-03-31"],"series":[{"y":45.12,"mom":"-3.21%"},{"y":47.8,"mom":"5.94%"},{"y":44.03,"mom":"-7.89%"},{"y":43.55,"mom":"-1.09%"}]};/*持有人结构*/var Data_holderStructure ={"series":[{"name":"机构持有比例","data":[12.5,11.8]},{"name":"个人持有比例","data":[87.1,87.9]},{"name":"内部持有比例","data":[0.4,0.3]}],"categories":["2023-12-31","2024-06-30"]};/*资产配置*/var Data_assetAllocation = {"series":[{"name":"股票占净比","type":null,"data":[86.2,88.1,85.7,87.3],"yAxis":0},{"name":"债券占净比","type":null,"data":[2.1,1.5,3.2,2.4],"yAxis":0},{"name":"现金占净比","type":null,"data":[10.9,9.6,10.4,9.8],"yAxis":0},{"name":"净资产","type":"line","data":[45.12,47.8,44.03,43.55],"yAxis":1}],"categories":["2024-06-30","2024-09-30","2024-12-31","2025-03-31"]};/*业绩评价 ['选股能力', '收益率', '抗风险', '稳定性','择时能力']*/var Data_performanceEvaluation = {"avr":"62.50","categories":["选股能力","收益率","抗风险","稳定性","择时能力"],"dsc":["a","b","c","d","e"],"data":[80.0,55.0,60.0,70.0,40.0]};/*现任基金经理*/var Data_currentFundManager =[{"id":"30040164","pic":"https://pdf.dfcfw.com/pdf/H8_30040164_1.JPG","name":"萧楠","star":5,"workTime":"12年又30天","fundSize":"301.20亿(4只基金)","power":{"avr":"71.20","categories":["经验值","收益率","抗风险","稳定性","择时能力"],"dsc":["a","b","c","d","e"],"data":[90.0,60.5,70.2,65.0,45.1],"jzrq":"2025-06-30"},"profit":{"categories":["任期收益","同类平均","沪深300"],"series":[{"data":[{"name":null,"color":"#7cb5ec","y":35.21},{"name":null,"color":"#414c7b","y":20.1},{"name":null,"color":"#f7a35c","y":5.6}]}],"jzrq":"2025-06-30"}}] ;/*申购赎回*/var Data_buySedemption = {"series":[{"name":"期间申购","data":[3.1,2.4]},{"name":"期间赎回","data":[4.2,5.0]},{"name":"总份额","data":[38.5,35.9]}],"categories":["2024-06-30","2024-12-31"]};/*同类型基金涨幅榜（页面底部通栏）*/var swithSameType = [["005827_易方达蓝筹精选混合_12.34","161725_招商中证白酒指数_10.01"]];
\ No newline at end of file
@@ -0,0 +1,194 @@
+// Package fakeupstream 本地模拟上游服务器
+// 基于 httptest 提供东方财富/天天基金接口的录制数据，支持注入延迟、5xx 和损坏的响应体，
+// 用于在无网络环境下端到端测试整个服务
+package fakeupstream
+
+import (
+	"embed"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+//go:embed payloads
+var payloads embed.FS
+
+// Route 模拟的上游接口
+type Route string
+
+const (
+	RoutePingzhongData Route = "pingzhongdata"   // 基金详情 /pingzhongdata/<code>.js
+	RouteEstimate      Route = "fundgz"          // 实时估值 /js/<code>.js
+	RouteFundList      Route = "fundcode_search" // 基金列表 /js/fundcode_search.js
+	RouteBatch         Route = "batch"           // 批量行情 /Data/Fund_JJJZ_Data.aspx
+	RouteNAVHistory    Route = "lsjz"            // 历史净值分页 /f10/lsjz?fundCode=<code>
+)
+
+// Fault 故障类型
+type Fault int
+
+const (
+	FaultNone        Fault = iota // 正常响应
+	FaultServerError              // 返回 503
+	FaultMalformed                // 返回无法解析的响应体
+)
+
+// Server 模拟上游服务器
+type Server struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	latency   time.Duration     // 每个请求的额外延迟
+	faults    map[Route]Fault   // 各接口注入的故障
+	overrides map[string][]byte // 覆盖的响应体 key: 请求路径
+	hits      map[Route]int     // 各接口请求次数
+}
+
+// New 创建并启动模拟上游服务器，测试结束后需调用 Close
+func New() *Server {
+	s := &Server{
+		faults:    make(map[Route]Fault),
+		overrides: make(map[string][]byte),
+		hits:      make(map[Route]int),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+// SetLatency 设置每个请求的额外延迟
+func (s *Server) SetLatency(latency time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.latency = latency
+}
+
+// SetFault 为指定接口注入故障，FaultNone 恢复正常
+func (s *Server) SetFault(route Route, fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults[route] = fault
+}
+
+// SetPayload 覆盖指定路径的响应体，如 "/js/000001.js"
+func (s *Server) SetPayload(urlPath string, body []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.overrides[urlPath] = body
+}
+
+// Hits 获取指定接口的请求次数
+func (s *Server) Hits(route Route) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.hits[route]
+}
+
+// Payload 读取录制的响应体，name 为 payloads 下的相对路径
+func Payload(name string) ([]byte, error) {
+	return payloads.ReadFile(path.Join("payloads", name))
+}
+
+// serve 分发请求
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	route, name := s.resolve(r)
+
+	s.mu.Lock()
+	latency := s.latency
+	fault := s.faults[route]
+	override, hasOverride := s.overrides[r.URL.Path]
+	if route != "" {
+		s.hits[route]++
+	}
+	s.mu.Unlock()
+
+	if latency > 0 {
+		select {
+		case <-time.After(latency):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	if route == "" {
+		http.NotFound(w, r)
+		return
+	}
+
+	switch fault {
+	case FaultServerError:
+		http.Error(w, "Service Unavailable", http.StatusServiceUnavailable)
+		return
+	case FaultMalformed:
+		w.Header().Set("Content-Type", "application/javascript; charset=utf-8")
+		w.Write([]byte("<html><body>系统繁忙，请稍后再试</body></html>"))
+		return
+	}
+
+	// 历史净值分页接口由录制的 pingzhongdata 数据生成
+	if route == RouteNAVHistory && !hasOverride {
+		s.serveNAVHistory(w, r)
+		return
+	}
+
+	body := override
+	if !hasOverride {
+		var err error
+		body, err = Payload(name)
+		if err != nil {
+			s.notFound(w, r, route)
+			return
+		}
+	}
+
+	// 批量行情只录制了第一页，后续页返回空数据
+	if route == RouteBatch && !hasOverride && s.page(r) > 1 {
+		body = []byte(`var db={chars:[],datas:[],count:["0","0","0","0"],record:"0",pages:"1",curpage:"` +
+			strconv.Itoa(s.page(r)) + `",indexsy:[],showday:[]}`)
+	}
+
+	w.Header().Set("Content-Type", "application/javascript; charset=utf-8")
+	w.Write(body)
+}
+
+// resolve 根据请求路径识别接口和录制文件
+func (s *Server) resolve(r *http.Request) (Route, string) {
+	urlPath := r.URL.Path
+	switch {
+	case urlPath == "/js/fundcode_search.js":
+		return RouteFundList, "fundcode_search.js"
+	case strings.HasPrefix(urlPath, "/pingzhongdata/"):
+		return RoutePingzhongData, "pingzhongdata/" + path.Base(urlPath)
+	case strings.HasPrefix(urlPath, "/js/"):
+		return RouteEstimate, "fundgz/" + path.Base(urlPath)
+	case urlPath == "/Data/Fund_JJJZ_Data.aspx":
+		return RouteBatch, "Fund_JJJZ_Data.aspx"
+	case urlPath == "/f10/lsjz":
+		return RouteNAVHistory, ""
+	}
+	return "", ""
+}
+
+// notFound 模拟上游对未知基金的响应
+func (s *Server) notFound(w http.ResponseWriter, r *http.Request, route Route) {
+	if route == RouteEstimate {
+		// 天天基金对无估值的基金返回空的 jsonp
+		w.Header().Set("Content-Type", "application/javascript; charset=utf-8")
+		w.Write([]byte("jsonpgz();"))
+		return
+	}
+	http.NotFound(w, r)
+}
+
+// page 解析批量行情请求的页码（page=页码,每页数量）
+func (s *Server) page(r *http.Request) int {
+	pageParam := strings.SplitN(r.URL.Query().Get("page"), ",", 2)
+	page, err := strconv.Atoi(pageParam[0])
+	if err != nil || page < 1 {
+		return 1
+	}
+	return page
+}
@@ -5,8 +5,13 @@ import (
 	"fund/handler"
 	"fund/router"
 	"fund/service"
+	"fund/storage"
 	"log"
 	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
 )
 
 func main() {
@@ -14,10 +19,170 @@ func main() {
 	host := "0.0.0.0" // 监听所有接口
 	port := 8080
 	serverIP := "175.27.141.110"
+	providerName := envOrDefault("FUND_PROVIDER", "eastmoney")                  // 主数据源
+	fallbackNames := envOrDefault("FUND_FALLBACK_PROVIDERS", "eastmoney-batch") // 备用数据源（逗号分隔）
+	tolerance := envOrDefault("FUND_ESTIMATE_TOLERANCE", "0")                   // 多数据源估值交叉验证容差（百分点，0 表示不验证）
+	upstreamBaseURL := os.Getenv("FUND_UPSTREAM_BASE_URL")                      // 上游地址（本地模拟上游/代理）
+	retention := envOrDefault("FUND_INTRADAY_RETENTION_DAYS", "0")              // 日内数据归档保留天数（0 表示永久保留）
+	storageBackend := envOrDefault("FUND_STORAGE", "file")                      // 存储后端: file/sqlite
+	sqlitePath := envOrDefault("FUND_SQLITE_PATH", "./data/fund.db")            // SQLite 数据库文件
+	riskFree := envOrDefault("FUND_RISK_FREE_RATE", "1.5")                      // 年化无风险利率（%），用于夏普、索提诺比率
+	adminUsername := os.Getenv("FUND_ADMIN_USERNAME")                           // 管理员用户名（启动时不存在则创建）
+	adminPassword := os.Getenv("FUND_ADMIN_PASSWORD")                           // 管理员初始密码（仅创建时使用）
+	registration := os.Getenv("FUND_REGISTRATION")                              // 用户注册: open 开放注册 / closed 关闭注册（默认配置了管理员时开放，否则关闭）
+	sessionTTL := envOrDefault("FUND_SESSION_TTL", "720h")                      // 登录令牌有效期
+	smtpAddr := os.Getenv("FUND_SMTP_ADDR")                                     // 提醒邮件 SMTP 服务器 host:port（未设置时不支持邮件通知）
+	smtpUsername := os.Getenv("FUND_SMTP_USERNAME")                             // SMTP 登录用户名
+	smtpPassword := os.Getenv("FUND_SMTP_PASSWORD")                             // SMTP 登录密码
+	smtpFrom := envOrDefault("FUND_SMTP_FROM", smtpUsername)                    // 发件人（默认为登录用户名）
+	notifyPrivate := envOrDefault("FUND_NOTIFY_ALLOW_PRIVATE", "false")         // 是否允许通知地址为内网地址
+
+	// 初始化数据源
+	providers := []service.Provider{}
+	for _, name := range append([]string{providerName}, strings.Split(fallbackNames, ",")...) {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		provider, err := service.NewProvider(name, upstreamBaseURL)
+		if err != nil {
+			log.Fatalf("❌ 初始化数据源失败: %v", err)
+		}
+		providers = append(providers, provider)
+	}
+	estimateTolerance, err := strconv.ParseFloat(tolerance, 64)
+	if err != nil {
+		log.Fatalf("❌ 估值容差配置错误: %v", err)
+	}
+	provider := service.NewFailoverProvider(estimateTolerance, providers...)
+	if provider.CrossValidating() {
+		log.Printf("🔌 使用数据源: %s (估值交叉验证容差 %.2f%%)", provider.Name(), estimateTolerance)
+	} else {
+		log.Printf("🔌 使用数据源: %s", provider.Name())
+		if estimateTolerance > 0 {
+			log.Printf("⚠️  没有可用于交叉验证的单基金估值数据源, 不做估值交叉验证")
+		}
+	}
 
 	// 初始化服务层
-	fundService := service.NewFundService()
-	intradayService := service.NewIntradayService()
+	fundService := service.NewFundServiceWithProvider(provider)
+	intradayService := service.NewIntradayServiceWithProvider(provider)
+	retentionDays, err := strconv.Atoi(retention)
+	if err != nil {
+		log.Fatalf("❌ 归档保留天数配置错误: %v", err)
+	}
+	intradayService.SetArchiveRetention(retentionDays)
+	riskFreeRate, err := strconv.ParseFloat(riskFree, 64)
+	if err != nil {
+		log.Fatalf("❌ 无风险利率配置错误: %v", err)
+	}
+	fundService.SetRiskFreeRate(riskFreeRate)
+
+	// 初始化存储
+	var portfolioStore storage.PortfolioStore
+	var userStore storage.UserStore
+	var watchlistStore storage.WatchlistStore
+	var alertStore storage.AlertStore
+	var notifyStore storage.NotifyStore
+	switch storageBackend {
+	case "file":
+		fundStore := storage.NewFileFundStore("./data")
+		fundService.SetFundStore(fundStore)
+		intradayService.SetFundStore(fundStore)
+		portfolioStore = storage.NewFilePortfolioStore("./data")
+		userStore = storage.NewFileUserStore("./data")
+		watchlistStore = storage.NewFileWatchlistStore("./data")
+		alertStore = storage.NewFileAlertStore("./data")
+		notifyStore = storage.NewFileNotifyStore("./data")
+		log.Printf("💾 使用文件存储: ./data")
+	case "sqlite":
+		store, err := storage.OpenSQLite(sqlitePath)
+		if err != nil {
+			log.Fatalf("❌ 打开 SQLite 数据库失败: %v", err)
+		}
+		fundService.SetFundStore(store)
+		intradayService.SetFundStore(store)
+		intradayService.SetStore(store)
+		portfolioStore = store
+		userStore = store
+		watchlistStore = store
+		alertStore = store
+		notifyStore = store
+		log.Printf("💾 使用 SQLite 存储: %s", sqlitePath)
+	default:
+		log.Fatalf("❌ 未知的存储后端: %s, 可选值: file/sqlite", storageBackend)
+	}
+
+	// 初始化用户服务
+	userService := service.NewUserService(userStore)
+	if adminUsername != "" {
+		admin, err := userService.BootstrapAdmin(adminUsername, adminPassword)
+		if err != nil {
+			log.Fatalf("❌ 创建管理员失败: %v", err)
+		}
+		log.Printf("🔑 管理员: %s (ID %d)", admin.Username, admin.ID)
+
+		// 单用户版本的持仓数据只迁移给配置的管理员，不会归属其他注册用户
+		claimed, err := portfolioStore.ClaimLegacyPortfolio(admin.ID)
+		if err != nil {
+			log.Fatalf("❌ 迁移单用户版本的持仓数据失败: %v", err)
+		}
+		if claimed {
+			log.Printf("📦 单用户版本的持仓数据已迁移给管理员 %s", admin.Username)
+		}
+	}
+	if registration == "" {
+		registration = "closed"
+		if adminUsername != "" {
+			registration = "open"
+		} else {
+			log.Printf("⚠️  未配置 FUND_ADMIN_USERNAME, 默认关闭注册")
+		}
+	}
+	switch registration {
+	case "open":
+		userService.SetRegistrationOpen(true)
+	case "closed":
+		userService.SetRegistrationOpen(false)
+	default:
+		log.Fatalf("❌ 注册配置错误: %s, 可选值: open/closed", registration)
+	}
+	ttl, err := time.ParseDuration(sessionTTL)
+	if err != nil || ttl <= 0 {
+		log.Fatalf("❌ 登录令牌有效期配置错误: %s", sessionTTL)
+	}
+	userService.SetSessionTTL(ttl)
+	log.Printf("🔐 用户注册: %s, 登录令牌有效期 %s", registration, ttl)
+
+	// 用户自选基金与配置文件中的监控列表一起高频采集
+	watchlistService := service.NewWatchlistService(watchlistStore)
+	intradayService.SetWatchSource(watchlistService.WatchCodes)
+
+	// 每写入一个日内数据点计算一次用户的提醒规则
+	alertService := service.NewAlertService(alertStore, fundService)
+	intradayService.AddPointListener(alertService.Evaluate)
+
+	// 触发的提醒发送到用户配置的通知渠道
+	notifyService := service.NewNotifyService(notifyStore)
+	allowPrivate, err := strconv.ParseBool(notifyPrivate)
+	if err != nil {
+		log.Fatalf("❌ 内网通知地址配置错误: %s", notifyPrivate)
+	}
+	if allowPrivate {
+		notifyService.SetAllowPrivateNetwork(true)
+		log.Printf("⚠️  允许向内网地址发送通知")
+	}
+	if smtpAddr != "" {
+		notifyService.SetNotifier(service.ChannelEmail, service.NewEmailNotifier(service.SMTPConfig{
+			Addr:     smtpAddr,
+			Username: smtpUsername,
+			Password: smtpPassword,
+			From:     smtpFrom,
+		}))
+		log.Printf("✉️  邮件通知: %s", smtpAddr)
+	}
+	alertService.AddAlertListener(notifyService.Notify)
+	alertService.AddAlertListener(intradayService.PublishAlert)
 
 	// 启动日内实时数据采集服务
 	if err := intradayService.Start(); err != nil {
@@ -26,9 +191,27 @@ func main() {
 
 	// 初始化处理器层
 	fundHandler := handler.NewFundHandler(fundService, intradayService)
+	portfolioService := service.NewPortfolioService(portfolioStore, fundService, intradayService)
+	portfolioHandler := handler.NewPortfolioHandler(portfolioService)
+	authHandler := handler.NewAuthHandler(userService)
+	watchlistHandler := handler.NewWatchlistHandler(watchlistService)
+	adminHandler := handler.NewAdminHandler(intradayService)
+	alertHandler := handler.NewAlertHandler(alertService)
+	notifyHandler := handler.NewNotifyHandler(notifyService)
+	liveHandler := handler.NewLiveHandler(intradayService)
 
 	// 设置路由
-	mux := router.SetupRoutes(fundHandler)
+	mux := router.SetupRoutes(router.Handlers{
+		Fund:          fundHandler,
+		Portfolio:     portfolioHandler,
+		Auth:          authHandler,
+		Watchlist:     watchlistHandler,
+		Admin:         adminHandler,
+		Alert:         alertHandler,
+		Notify:        notifyHandler,
+		Live:          liveHandler,
+		Authenticator: userService,
+	})
 
 	// 启动服务器
 	addr := fmt.Sprintf("%s:%d", host, port)
@@ -39,8 +222,25 @@ func main() {
 	log.Printf("API 端点:")
 	log.Printf("📡 基金详情: http://%s:%d/api/fund/detail?code=001186", serverIP, port)
 	log.Printf("📈 走势数据: http://%s:%d/api/fund/trend?code=001186&period=month", serverIP, port)
-	log.Printf("📊 日内数据: http://%s:%d/api/fund/intraday?code=001186", serverIP, port)
+	log.Printf("📐 业绩指标: http://%s:%d/api/fund/analytics?code=001186&period=year", serverIP, port)
+	log.Printf("⚖️  基金对比: http://%s:%d/api/fund/compare?codes=001186,110022&period=year", serverIP, port)
+	log.Printf("🎯 基准对比: http://%s:%d/api/fund/benchmark?code=001186&period=year", serverIP, port)
+	log.Printf("🧮 定投回测: http://%s:%d/api/fund/backtest?code=001186&start=2024-01-01&amount=1000&frequency=monthly&day=1", serverIP, port)
+	log.Printf("📊 日内数据: http://%s:%d/api/fund/intraday?code=001186&date=2025-01-02", serverIP, port)
+	log.Printf("📺 实时推送: http://%s:%d/api/fund/stream?codes=001186,110022 (SSE)", serverIP, port)
+	log.Printf("🔌 实时订阅: ws://%s:%d/api/ws?codes=001186&topics=status (WebSocket)", serverIP, port)
 	log.Printf("📋 基金列表: http://%s:%d/api/fund/list", serverIP, port)
+	log.Printf("👤 用户注册: POST http://%s:%d/api/auth/register", serverIP, port)
+	log.Printf("🔑 用户登录: POST http://%s:%d/api/auth/login", serverIP, port)
+	log.Printf("⭐ 自选分组: http://%s:%d/api/watchlists", serverIP, port)
+	log.Printf("🔔 提醒规则: http://%s:%d/api/alerts/rules", serverIP, port)
+	log.Printf("📣 触发提醒: http://%s:%d/api/alerts?date=2025-01-02", serverIP, port)
+	log.Printf("📨 通知渠道: http://%s:%d/api/notify/channels", serverIP, port)
+	log.Printf("💼 持仓管理: http://%s:%d/api/portfolio/holdings", serverIP, port)
+	log.Printf("🧾 交易记录: http://%s:%d/api/portfolio/transactions?code=001186", serverIP, port)
+	log.Printf("💰 组合估值: http://%s:%d/api/portfolio/valuation", serverIP, port)
+	log.Printf("⏱️  组合日内: http://%s:%d/api/portfolio/intraday", serverIP, port)
+	log.Printf("🛠️  监控配置: http://%s:%d/api/admin/watch (管理员)", serverIP, port)
 	log.Printf("🔧 服务状态: http://%s:%d/api/status", serverIP, port)
 	log.Printf("❤️  健康检查: http://%s:%d/health", serverIP, port)
 
@@ -48,3 +248,11 @@ func main() {
 		log.Fatalf("❌ 服务器启动失败: %v", err)
 	}
 }
+
+// envOrDefault 读取环境变量，未设置时返回默认值
+func envOrDefault(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
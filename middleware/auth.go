@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"fund/model"
+	"net/http"
+	"strings"
+)
+
+// Authenticator 令牌校验
+type Authenticator interface {
+	// Authenticate 校验令牌，返回令牌所属用户
+	Authenticate(token string) (*model.User, error)
+}
+
+// userContextKey 请求上下文中保存当前用户的键
+type userContextKey struct{}
+
+// Auth 认证中间件：校验令牌并将用户写入请求上下文，未认证时返回 401
+func Auth(authenticator Authenticator, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token := TokenFromRequest(r)
+		if token == "" {
+			unauthorized(w, "请提供访问令牌")
+			return
+		}
+		user, err := authenticator.Authenticate(token)
+		if err != nil || user == nil {
+			unauthorized(w, "访问令牌无效或已过期")
+			return
+		}
+
+		next(w, r.WithContext(context.WithValue(r.Context(), userContextKey{}, user)))
+	}
+}
+
+// OptionalAuth 可选认证中间件：提供了令牌时校验并将用户写入请求上下文（令牌无效返回 401），未提供令牌时匿名访问
+func OptionalAuth(authenticator Authenticator, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if TokenFromRequest(r) == "" {
+			next(w, r)
+			return
+		}
+		Auth(authenticator, next)(w, r)
+	}
+}
+
+// Admin 管理员中间件：需在 Auth 之后使用，非管理员返回 403
+func Admin(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if user := UserFromContext(r.Context()); user == nil || !user.Admin {
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			w.WriteHeader(http.StatusForbidden)
+			json.NewEncoder(w).Encode(map[string]string{
+				"error": "需要管理员权限",
+			})
+			return
+		}
+		next(w, r)
+	}
+}
+
+// TokenFromRequest 从请求中读取令牌
+// 依次读取 Authorization: Bearer <token>、X-API-Token 请求头和 access_token 查询参数
+// （浏览器的 EventSource、WebSocket 无法设置请求头）
+func TokenFromRequest(r *http.Request) string {
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		if scheme, token, ok := strings.Cut(auth, " "); ok && strings.EqualFold(scheme, "Bearer") {
+			return strings.TrimSpace(token)
+		}
+	}
+	if token := r.Header.Get("X-API-Token"); token != "" {
+		return token
+	}
+	return r.URL.Query().Get("access_token")
+}
+
+// UserFromContext 获取认证中间件写入的当前用户，未认证时返回 nil
+func UserFromContext(ctx context.Context) *model.User {
+	user, _ := ctx.Value(userContextKey{}).(*model.User)
+	return user
+}
+
+// unauthorized 返回 401 响应
+func unauthorized(w http.ResponseWriter, message string) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("WWW-Authenticate", `Bearer realm="fund"`)
+	w.WriteHeader(http.StatusUnauthorized)
+	json.NewEncoder(w).Encode(map[string]string{
+		"error": message,
+	})
+}
@@ -1,5 +1,7 @@
 package model
 
+import "time"
+
 // FundDetail 基金详细信息
 type FundDetail struct {
 	Code          string `json:"code"`          // 基金代码
@@ -26,20 +28,37 @@ type RealtimeData struct {
 	GsZzl    string `json:"gszzl"`    // 估算增长率
 	Gsz      string `json:"gsz"`      // 估算净值
 	Gztime   string `json:"gztime"`   // 估值时间
+
+	Source    string  `json:"source,omitempty"`    // 数据来源
+	Deviation float64 `json:"deviation,omitempty"` // 与备用数据源估算涨跌幅的偏差（百分点）
+	Divergent bool    `json:"divergent,omitempty"` // 偏差是否超出容差
 }
 
 // TrendPoint 走势数据点
 type TrendPoint struct {
-	Date  string  `json:"date"`  // 日期
-	Value float64 `json:"value"` // 净值
+	Date        string    `json:"date"`               // 日期
+	Value       float64   `json:"value"`              // 单位净值（adjust=dividend 时为分红再投资后的复权净值）
+	AccValue    float64   `json:"accValue,omitempty"` // 累计净值
+	DailyReturn float64   `json:"dailyReturn"`        // 日增长率（%，已计入当日分红）
+	Dividend    *Dividend `json:"dividend,omitempty"` // 当日分红送配事件
+}
+
+// Dividend 分红送配事件
+type Dividend struct {
+	Cash  float64 `json:"cash,omitempty"`  // 每份派现金（元）
+	Split float64 `json:"split,omitempty"` // 拆分折算比例（每份折算为多少份）
+	Text  string  `json:"text"`            // 上游原始描述，如 每份派现金0.0500元
 }
 
 // FundTrend 基金走势数据
 type FundTrend struct {
-	Code   string        `json:"code"`   // 基金代码
-	Name   string        `json:"name"`   // 基金名称
-	Period string        `json:"period"` // 周期类型: week/month/quarter
-	Data   []TrendPoint  `json:"data"`   // 走势数据点
+	Code   string       `json:"code"`             // 基金代码
+	Name   string       `json:"name"`             // 基金名称
+	Period string       `json:"period"`           // 周期类型: week/month/quarter/half_year/year/three_years/all/ytd/since/custom
+	Start  string       `json:"start,omitempty"`  // 区间开始日期（含），为空表示最早
+	End    string       `json:"end"`              // 区间结束日期（含）
+	Adjust string       `json:"adjust,omitempty"` // 复权方式: dividend 为分红再投资
+	Data   []TrendPoint `json:"data"`             // 走势数据点
 }
 
 // FundBasicInfo 基金基本信息
@@ -54,6 +73,10 @@ type IntradayPoint struct {
 	Time  string  `json:"time"`  // 时间 HH:MM
 	Value float64 `json:"value"` // 估算净值
 	Rate  float64 `json:"rate"`  // 估算涨跌幅
+
+	Source    string  `json:"source,omitempty"`    // 数据来源
+	Deviation float64 `json:"deviation,omitempty"` // 与备用数据源估算涨跌幅的偏差（百分点）
+	Divergent bool    `json:"divergent,omitempty"` // 多数据源估值偏差超出容差
 }
 
 // FundIntradayData 基金日内实时数据
@@ -63,3 +86,379 @@ type FundIntradayData struct {
 	Date string          `json:"date"` // 日期
 	Data []IntradayPoint `json:"data"` // 日内数据点
 }
+
+// CollectorStatus 日内数据采集状态（每轮采集结束后更新）
+type CollectorStatus struct {
+	Mode      string    `json:"mode"`      // 采集模式: watch 监控列表 / batch 全量批量
+	State     string    `json:"state"`     // 本轮结果: done 完成 / closed 非交易时间跳过 / failed 采集失败
+	Date      string    `json:"date"`      // 交易日
+	Time      string    `json:"time"`      // 采集时间点 HH:MM
+	Success   int       `json:"success"`   // 成功的基金数量
+	Failed    int       `json:"failed"`    // 失败数量（监控模式为基金数，批量模式为页数）
+	ElapsedMs int64     `json:"elapsedMs"` // 耗时（毫秒）
+	Interval  int       `json:"interval"`  // 监控采集周期（秒）
+	UpdatedAt time.Time `json:"updatedAt"` // 状态更新时间
+}
+
+// FundProfile 基金档案：持仓、资产配置、规模变动、持有人结构、基金经理和费率
+type FundProfile struct {
+	Code            string            `json:"code"`            // 基金代码
+	Name            string            `json:"name"`            // 基金名称
+	Holdings        []StockHolding    `json:"holdings"`        // 前十大持仓股票
+	BondCodes       []string          `json:"bondCodes"`       // 持仓债券代码
+	AssetAllocation []AssetAllocation `json:"assetAllocation"` // 资产配置（按报告期）
+	Scale           []ScaleChange     `json:"scale"`           // 规模变动（按报告期）
+	HolderStructure []HolderStructure `json:"holderStructure"` // 持有人结构（按报告期）
+	Managers        []FundManager     `json:"managers"`        // 现任基金经理
+	Fees            FundFees          `json:"fees"`            // 申购费率
+}
+
+// StockHolding 持仓股票
+type StockHolding struct {
+	Code   string `json:"code"`   // 股票代码
+	Market string `json:"market"` // 交易市场: SH/SZ/HK
+}
+
+// AssetAllocation 资产配置（占净值比例%）
+type AssetAllocation struct {
+	Date      string  `json:"date"`      // 报告期
+	Stock     float64 `json:"stock"`     // 股票占净比
+	Bond      float64 `json:"bond"`      // 债券占净比
+	Cash      float64 `json:"cash"`      // 现金占净比
+	NetAssets float64 `json:"netAssets"` // 净资产（亿元）
+}
+
+// ScaleChange 规模变动
+type ScaleChange struct {
+	Date   string  `json:"date"`   // 报告期
+	Scale  float64 `json:"scale"`  // 净资产规模（亿元）
+	Change string  `json:"change"` // 较上期变动
+}
+
+// HolderStructure 持有人结构（占总份额比例%）
+type HolderStructure struct {
+	Date        string  `json:"date"`        // 报告期
+	Institution float64 `json:"institution"` // 机构持有比例
+	Individual  float64 `json:"individual"`  // 个人持有比例
+	Internal    float64 `json:"internal"`    // 内部持有比例
+}
+
+// FundManager 基金经理
+type FundManager struct {
+	ID       string `json:"id"`       // 基金经理ID
+	Name     string `json:"name"`     // 姓名
+	Pic      string `json:"pic"`      // 照片
+	Star     int    `json:"star"`     // 评级
+	WorkTime string `json:"workTime"` // 从业时间
+	FundSize string `json:"fundSize"` // 管理规模
+}
+
+// FundFees 申购费率
+type FundFees struct {
+	SourceRate  string `json:"sourceRate"`  // 原费率%
+	Rate        string `json:"rate"`        // 现费率%
+	MinPurchase string `json:"minPurchase"` // 最小申购金额（元）
+}
+
+// FundAnalytics 基金业绩与风险指标（基于分红再投资的复权净值计算）
+// 收益率、波动率、回撤、胜率单位为 %，无法计算的比率为 0
+type FundAnalytics struct {
+	Code             string  `json:"code"`             // 基金代码
+	Name             string  `json:"name"`             // 基金名称
+	Period           string  `json:"period"`           // 周期类型
+	Start            string  `json:"start"`            // 区间内第一个净值日期
+	End              string  `json:"end"`              // 区间内最后一个净值日期
+	Days             int     `json:"days"`             // 净值数据点数
+	TotalReturn      float64 `json:"totalReturn"`      // 区间累计收益率
+	AnnualizedReturn float64 `json:"annualizedReturn"` // 年化收益率
+	Volatility       float64 `json:"volatility"`       // 年化波动率
+	MaxDrawdown      float64 `json:"maxDrawdown"`      // 最大回撤（正数）
+	DrawdownPeak     string  `json:"drawdownPeak"`     // 最大回撤起点（前高）日期
+	DrawdownTrough   string  `json:"drawdownTrough"`   // 最大回撤终点（谷底）日期
+	SharpeRatio      float64 `json:"sharpeRatio"`      // 夏普比率
+	SortinoRatio     float64 `json:"sortinoRatio"`     // 索提诺比率
+	CalmarRatio      float64 `json:"calmarRatio"`      // 卡玛比率
+	WinRate          float64 `json:"winRate"`          // 日收益率为正的比例
+	RiskFreeRate     float64 `json:"riskFreeRate"`     // 计算使用的年化无风险利率
+}
+
+// FundComparison 多基金对比：按共同交易日对齐、以区间首日为 0% 的累计收益率
+type FundComparison struct {
+	Period       string              `json:"period"`       // 周期类型
+	Start        string              `json:"start"`        // 第一个共同交易日
+	End          string              `json:"end"`          // 最后一个共同交易日
+	Funds        []ComparedFund      `json:"funds"`        // 参与对比的基金（顺序与 rows.returns 一致）
+	Rows         []ComparisonRow     `json:"rows"`         // 对齐后的累计收益率表
+	Correlations []ReturnCorrelation `json:"correlations"` // 两两之间日收益率的相关系数
+}
+
+// ComparedFund 参与对比的基金
+type ComparedFund struct {
+	Code        string  `json:"code"`        // 基金代码
+	Name        string  `json:"name"`        // 基金名称
+	TotalReturn float64 `json:"totalReturn"` // 区间累计收益率（%）
+}
+
+// ComparisonRow 对比表中的一行
+type ComparisonRow struct {
+	Date    string    `json:"date"`    // 交易日
+	Returns []float64 `json:"returns"` // 各基金相对区间首日的累计收益率（%）
+}
+
+// ReturnCorrelation 两只基金日收益率的相关系数
+type ReturnCorrelation struct {
+	A     string  `json:"a"`     // 基金代码
+	B     string  `json:"b"`     // 基金代码
+	Value float64 `json:"value"` // 皮尔逊相关系数 [-1, 1]
+}
+
+// ReturnSeries 累计收益率曲线
+type ReturnSeries struct {
+	Name string        `json:"name"` // 曲线名称，如 华夏成长混合、同类平均、沪深300
+	Role string        `json:"role"` // 曲线类型: fund（本基金）/peer（同类平均）/benchmark（业绩比较基准或指数）
+	Data []ReturnPoint `json:"data"` // 数据点，按日期升序
+}
+
+// ReturnPoint 累计收益率数据点
+type ReturnPoint struct {
+	Date   string  `json:"date"`   // 日期
+	Return float64 `json:"return"` // 累计收益率（%）
+}
+
+// FundBenchmark 基金与业绩比较基准、同类平均的对比及超额收益指标
+// 曲线以区间首日为 0%，收益率、跟踪误差、阿尔法单位为 %（年化）
+type FundBenchmark struct {
+	Code             string         `json:"code"`             // 基金代码
+	Name             string         `json:"name"`             // 基金名称
+	Benchmark        string         `json:"benchmark"`        // 基准名称
+	Period           string         `json:"period"`           // 周期类型
+	Start            string         `json:"start"`            // 区间内第一个交易日
+	End              string         `json:"end"`              // 区间内最后一个交易日
+	Series           []ReturnSeries `json:"series"`           // 本基金、同类平均、基准曲线
+	ExcessReturn     float64        `json:"excessReturn"`     // 区间超额收益（本基金 - 基准，百分点）
+	TrackingError    float64        `json:"trackingError"`    // 年化跟踪误差
+	Beta             float64        `json:"beta"`             // 贝塔系数
+	Alpha            float64        `json:"alpha"`            // 年化詹森阿尔法
+	InformationRatio float64        `json:"informationRatio"` // 信息比率
+}
+
+// BacktestResult 定投回测结果（金额单位：元，收益率单位：%）
+type BacktestResult struct {
+	Code        string             `json:"code"`        // 基金代码
+	Name        string             `json:"name"`        // 基金名称
+	Start       string             `json:"start"`       // 回测开始日期
+	End         string             `json:"end"`         // 回测结束日期（最后一个净值日期）
+	Frequency   string             `json:"frequency"`   // 定投频率: weekly/biweekly/monthly
+	Day         int                `json:"day"`         // 每周几（1-5）或每月几号（1-31）
+	Amount      float64            `json:"amount"`      // 每期投入金额
+	FeeRate     float64            `json:"feeRate"`     // 申购费率（%）
+	Purchases   []BacktestPurchase `json:"purchases"`   // 每期买入记录
+	Curve       []BacktestPoint    `json:"curve"`       // 每个交易日的持仓市值曲线
+	TotalCost   float64            `json:"totalCost"`   // 累计投入（含手续费）
+	TotalFee    float64            `json:"totalFee"`    // 累计手续费
+	Shares      float64            `json:"shares"`      // 持有份额（含分红再投资）
+	MarketValue float64            `json:"marketValue"` // 期末市值
+	Profit      float64            `json:"profit"`      // 收益
+	ReturnRate  float64            `json:"returnRate"`  // 收益率
+	IRR         float64            `json:"irr"`         // 年化内部收益率
+	LumpSum     LumpSumResult      `json:"lumpSum"`     // 相同总金额在首期一次性买入的对比结果
+}
+
+// BacktestPurchase 定投买入记录
+type BacktestPurchase struct {
+	Date   string  `json:"date"`   // 成交日期（计划日期非交易日时顺延）
+	NAV    float64 `json:"nav"`    // 成交净值
+	Amount float64 `json:"amount"` // 投入金额（含手续费）
+	Fee    float64 `json:"fee"`    // 手续费
+	Shares float64 `json:"shares"` // 确认份额
+}
+
+// BacktestPoint 回测市值曲线数据点
+type BacktestPoint struct {
+	Date   string  `json:"date"`   // 日期
+	Cost   float64 `json:"cost"`   // 累计投入
+	Shares float64 `json:"shares"` // 持有份额
+	Value  float64 `json:"value"`  // 市值
+	Return float64 `json:"return"` // 收益率
+}
+
+// LumpSumResult 一次性投入的对比结果
+type LumpSumResult struct {
+	Date        string  `json:"date"`        // 买入日期
+	Cost        float64 `json:"cost"`        // 投入金额
+	Shares      float64 `json:"shares"`      // 持有份额（含分红再投资）
+	MarketValue float64 `json:"marketValue"` // 期末市值
+	Profit      float64 `json:"profit"`      // 收益
+	ReturnRate  float64 `json:"returnRate"`  // 收益率
+	IRR         float64 `json:"irr"`         // 年化内部收益率
+}
+
+// Holding 持仓
+type Holding struct {
+	Code         string    `json:"code"`         // 基金代码
+	Name         string    `json:"name"`         // 基金名称
+	Shares       float64   `json:"shares"`       // 持有份额
+	Cost         float64   `json:"cost"`         // 持仓成本（元，卖出时按平均成本扣减）
+	PurchaseDate string    `json:"purchaseDate"` // 首次买入日期 YYYY-MM-DD
+	Realized     float64   `json:"realized"`     // 已实现收益（元，卖出盈亏和现金分红）
+	UpdatedAt    time.Time `json:"updatedAt"`    // 更新时间
+}
+
+// Transaction 交易记录
+type Transaction struct {
+	ID        int64     `json:"id"`              // 记录ID
+	Code      string    `json:"code"`            // 基金代码
+	Type      string    `json:"type"`            // 类型: buy/sell/dividend
+	Date      string    `json:"date"`            // 交易日期 YYYY-MM-DD
+	Shares    float64   `json:"shares"`          // 份额（买入/卖出份额，分红再投资份额）
+	Price     float64   `json:"price,omitempty"` // 成交净值
+	Amount    float64   `json:"amount"`          // 金额（买入为支付金额含手续费，卖出为到账金额，分红为现金分红）
+	Fee       float64   `json:"fee,omitempty"`   // 手续费
+	Note      string    `json:"note,omitempty"`  // 备注
+	CreatedAt time.Time `json:"createdAt"`       // 记录时间
+}
+
+// PortfolioValuation 组合估值（金额单位：元，收益率单位：%）
+type PortfolioValuation struct {
+	Holdings    []HoldingValuation `json:"holdings"`    // 各持仓估值
+	Cost        float64            `json:"cost"`        // 持仓成本合计
+	MarketValue float64            `json:"marketValue"` // 市值合计
+	TodayProfit float64            `json:"todayProfit"` // 当日（估算）收益合计
+	TotalProfit float64            `json:"totalProfit"` // 累计收益合计（含已实现收益）
+	TotalReturn float64            `json:"totalReturn"` // 累计收益率（累计收益 / 持仓成本）
+	UpdatedAt   time.Time          `json:"updatedAt"`   // 估值时间
+}
+
+// HoldingValuation 单只基金的持仓估值
+type HoldingValuation struct {
+	Holding
+	NAV          float64 `json:"nav"`                    // 最新官方净值
+	NAVDate      string  `json:"navDate"`                // 官方净值日期
+	Estimate     float64 `json:"estimate,omitempty"`     // 当日估算净值
+	EstimateRate float64 `json:"estimateRate,omitempty"` // 当日估算涨跌幅（%）
+	EstimateTime string  `json:"estimateTime,omitempty"` // 估值时间 HH:MM
+	Price        float64 `json:"price"`                  // 计算市值使用的净值（当日已公布净值 > 当日估值 > 最新净值）
+	MarketValue  float64 `json:"marketValue"`            // 市值
+	TodayProfit  float64 `json:"todayProfit"`            // 当日（估算）收益
+	TotalProfit  float64 `json:"totalProfit"`            // 累计收益（市值 - 成本 + 已实现收益）
+	TotalReturn  float64 `json:"totalReturn"`            // 累计收益率
+}
+
+// PortfolioIntraday 组合日内估值曲线（金额单位：元，收益率单位：%）
+type PortfolioIntraday struct {
+	Date      string                   `json:"date"`              // 日期
+	BaseValue float64                  `json:"baseValue"`         // 按前一交易日净值计算的组合市值
+	Funds     []PortfolioIntradayFund  `json:"funds"`             // 参与计算的持仓
+	Missing   []string                 `json:"missing,omitempty"` // 当日无估值数据的基金（按前一交易日净值计入）
+	Data      []PortfolioIntradayPoint `json:"data"`              // 分钟级估值点
+}
+
+// PortfolioIntradayFund 组合日内曲线中的单只持仓
+type PortfolioIntradayFund struct {
+	Code    string  `json:"code"`    // 基金代码
+	Name    string  `json:"name"`    // 基金名称
+	Shares  float64 `json:"shares"`  // 持有份额
+	BaseNAV float64 `json:"baseNav"` // 前一交易日净值
+	Points  int     `json:"points"`  // 当日估值点数
+}
+
+// PortfolioIntradayPoint 组合日内估值点
+type PortfolioIntradayPoint struct {
+	Time   string  `json:"time"`   // 时间 HH:MM
+	Value  float64 `json:"value"`  // 组合估算市值
+	Profit float64 `json:"profit"` // 当日估算收益
+	Rate   float64 `json:"rate"`   // 当日估算涨跌幅
+}
+
+// User 用户
+type User struct {
+	ID           int64     `json:"id"`        // 用户ID
+	Username     string    `json:"username"`  // 用户名
+	PasswordHash string    `json:"-"`         // bcrypt 密码哈希
+	Admin        bool      `json:"admin"`     // 是否管理员（启动时按配置创建）
+	CreatedAt    time.Time `json:"createdAt"` // 注册时间
+}
+
+// APIToken 用户的访问令牌（只保存令牌的 SHA-256 哈希，明文只在创建时返回一次）
+type APIToken struct {
+	ID         int64      `json:"id"`                   // 令牌ID
+	UserID     int64      `json:"userId"`               // 所属用户ID
+	Name       string     `json:"name"`                 // 令牌名称（登录会话为 login）
+	Prefix     string     `json:"prefix"`               // 令牌前缀（用于识别）
+	TokenHash  string     `json:"-"`                    // 令牌 SHA-256 哈希
+	CreatedAt  time.Time  `json:"createdAt"`            // 创建时间
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"` // 最近使用时间
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty"`  // 过期时间，为空表示不过期
+}
+
+// Watchlist 用户的自选分组
+type Watchlist struct {
+	ID        int64     `json:"id"`        // 分组ID
+	Name      string    `json:"name"`      // 分组名称
+	Position  int       `json:"position"`  // 分组排序（升序）
+	Codes     []string  `json:"codes"`     // 基金代码（按用户排序）
+	CreatedAt time.Time `json:"createdAt"` // 创建时间
+	UpdatedAt time.Time `json:"updatedAt"` // 更新时间
+}
+
+// AlertRule 用户的基金提醒规则
+// Type: rate_above/rate_below 估算涨跌幅高于/低于阈值（%，可为负）；value_above/value_below 估算净值高于/低于阈值；
+// drawdown 估算净值较当日最高回落超过阈值（%）；nav_deviation 估算净值与上一交易日净值的偏离（绝对值）超过阈值（%）
+type AlertRule struct {
+	ID        int64     `json:"id"`             // 规则ID
+	UserID    int64     `json:"userId"`         // 所属用户
+	Code      string    `json:"code"`           // 基金代码
+	Type      string    `json:"type"`           // 规则类型
+	Threshold float64   `json:"threshold"`      // 阈值
+	Enabled   bool      `json:"enabled"`        // 是否启用
+	Note      string    `json:"note,omitempty"` // 备注
+	CreatedAt time.Time `json:"createdAt"`      // 创建时间
+	UpdatedAt time.Time `json:"updatedAt"`      // 更新时间
+}
+
+// Alert 已触发的提醒（同一规则每个交易日最多触发一次）
+type Alert struct {
+	ID        int64     `json:"id"`        // 提醒ID
+	RuleID    int64     `json:"ruleId"`    // 触发的规则
+	UserID    int64     `json:"userId"`    // 所属用户
+	Code      string    `json:"code"`      // 基金代码
+	Name      string    `json:"name"`      // 基金名称
+	Type      string    `json:"type"`      // 规则类型
+	Threshold float64   `json:"threshold"` // 规则阈值
+	Observed  float64   `json:"observed"`  // 触发时与阈值比较的指标值
+	Date      string    `json:"date"`      // 交易日 YYYY-MM-DD
+	Time      string    `json:"time"`      // 触发的数据点时间 HH:MM
+	Value     float64   `json:"value"`     // 触发时的估算净值
+	Rate      float64   `json:"rate"`      // 触发时的估算涨跌幅
+	Message   string    `json:"message"`   // 提醒内容
+	CreatedAt time.Time `json:"createdAt"` // 触发时间
+}
+
+// NotifyChannel 用户的提醒通知渠道
+// Type: webhook 通用 Webhook（JSON POST，配置密钥时附带 HMAC-SHA256 签名）；email 邮件；wecom/dingtalk/feishu 企业微信、钉钉、飞书群机器人
+type NotifyChannel struct {
+	ID        int64     `json:"id"`              // 渠道ID
+	UserID    int64     `json:"userId"`          // 所属用户
+	Name      string    `json:"name"`            // 渠道名称
+	Type      string    `json:"type"`            // 渠道类型
+	URL       string    `json:"url,omitempty"`   // Webhook / 机器人地址
+	Email     string    `json:"email,omitempty"` // 收件人邮箱
+	Secret    string    `json:"-"`               // 签名密钥（Webhook 签名、钉钉/飞书加签），不在接口中返回
+	HasSecret bool      `json:"hasSecret"`       // 是否配置了签名密钥
+	Enabled   bool      `json:"enabled"`         // 是否启用
+	CreatedAt time.Time `json:"createdAt"`       // 创建时间
+	UpdatedAt time.Time `json:"updatedAt"`       // 更新时间
+}
+
+// NotifyDelivery 提醒通知的投递记录
+type NotifyDelivery struct {
+	ID          int64     `json:"id"`              // 记录ID
+	UserID      int64     `json:"userId"`          // 所属用户
+	ChannelID   int64     `json:"channelId"`       // 通知渠道
+	ChannelType string    `json:"channelType"`     // 渠道类型
+	AlertID     int64     `json:"alertId"`         // 提醒ID（测试通知为 0）
+	Status      string    `json:"status"`          // 投递结果: sent/failed
+	Attempts    int       `json:"attempts"`        // 尝试次数
+	Error       string    `json:"error,omitempty"` // 最后一次失败原因
+	CreatedAt   time.Time `json:"createdAt"`       // 投递完成时间
+}
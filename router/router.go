@@ -6,21 +6,84 @@ import (
 	"net/http"
 )
 
+// Handlers 路由使用的处理器
+type Handlers struct {
+	Fund          *handler.FundHandler      // 行情数据（公开）
+	Portfolio     *handler.PortfolioHandler // 持仓管理（需要认证）
+	Auth          *handler.AuthHandler      // 用户和访问令牌
+	Watchlist     *handler.WatchlistHandler // 自选分组（需要认证）
+	Admin         *handler.AdminHandler     // 服务管理（需要管理员权限）
+	Alert         *handler.AlertHandler     // 提醒规则（需要认证）
+	Notify        *handler.NotifyHandler    // 提醒通知渠道（需要认证）
+	Live          *handler.LiveHandler      // 实时推送 WebSocket（提醒主题需要认证）
+	Authenticator middleware.Authenticator  // 令牌校验
+}
+
 // SetupRoutes 设置路由
-func SetupRoutes(fundHandler *handler.FundHandler) *http.ServeMux {
+// 行情数据接口公开访问，个人数据接口（自选、持仓等）需要访问令牌
+func SetupRoutes(handlers Handlers) *http.ServeMux {
 	mux := http.NewServeMux()
+	fundHandler, portfolioHandler, authHandler := handlers.Fund, handlers.Portfolio, handlers.Auth
+	watchlistHandler, adminHandler, alertHandler := handlers.Watchlist, handlers.Admin, handlers.Alert
+	notifyHandler, liveHandler := handlers.Notify, handlers.Live
+
+	// private 需要认证的接口
+	private := func(next http.HandlerFunc) http.HandlerFunc {
+		return middleware.CORS(middleware.Auth(handlers.Authenticator, next))
+	}
+	// admin 需要管理员权限的接口
+	admin := func(next http.HandlerFunc) http.HandlerFunc {
+		return private(middleware.Admin(next))
+	}
 
 	// 基金详情API
 	mux.HandleFunc("/api/fund/detail", middleware.CORS(fundHandler.GetFundDetail))
 	mux.HandleFunc("/api/fund/trend", middleware.CORS(fundHandler.GetFundTrend))
-	
+	mux.HandleFunc("/api/fund/analytics", middleware.CORS(fundHandler.GetFundAnalytics))
+	mux.HandleFunc("/api/fund/compare", middleware.CORS(fundHandler.CompareFunds))
+	mux.HandleFunc("/api/fund/benchmark", middleware.CORS(fundHandler.GetFundBenchmark))
+	mux.HandleFunc("/api/fund/backtest", middleware.CORS(fundHandler.Backtest))
+	mux.HandleFunc("/api/fund/profile", middleware.CORS(fundHandler.GetFundProfile))
+
 	// 日内实时数据API
 	mux.HandleFunc("/api/fund/intraday", middleware.CORS(fundHandler.GetIntradayData))
 	mux.HandleFunc("/api/fund/list", middleware.CORS(fundHandler.GetFundList))
-	
+	mux.HandleFunc("/api/fund/stream", middleware.CORS(fundHandler.StreamIntraday))
+	mux.HandleFunc("/api/ws", middleware.CORS(middleware.OptionalAuth(handlers.Authenticator, liveHandler.Connect)))
+
+	// 用户API
+	mux.HandleFunc("/api/auth/register", middleware.CORS(authHandler.Register))
+	mux.HandleFunc("/api/auth/login", middleware.CORS(authHandler.Login))
+	mux.HandleFunc("/api/auth/me", private(authHandler.Me))
+	mux.HandleFunc("/api/auth/tokens", private(authHandler.Tokens))
+
+	// 自选分组API
+	mux.HandleFunc("/api/watchlists", private(watchlistHandler.Watchlists))
+	mux.HandleFunc("/api/watchlists/codes", private(watchlistHandler.Codes))
+	mux.HandleFunc("/api/watchlists/order", private(watchlistHandler.Order))
+
+	// 提醒API
+	mux.HandleFunc("/api/alerts", private(alertHandler.GetAlerts))
+	mux.HandleFunc("/api/alerts/rules", private(alertHandler.Rules))
+
+	// 通知API
+	mux.HandleFunc("/api/notify/channels", private(notifyHandler.Channels))
+	mux.HandleFunc("/api/notify/channels/test", private(notifyHandler.TestChannel))
+	mux.HandleFunc("/api/notify/deliveries", private(notifyHandler.GetDeliveries))
+
+	// 持仓管理API
+	mux.HandleFunc("/api/portfolio/holdings", private(portfolioHandler.Holdings))
+	mux.HandleFunc("/api/portfolio/transactions", private(portfolioHandler.Transactions))
+	mux.HandleFunc("/api/portfolio/valuation", private(portfolioHandler.GetValuation))
+	mux.HandleFunc("/api/portfolio/intraday", private(portfolioHandler.GetIntraday))
+
+	// 管理API
+	mux.HandleFunc("/api/admin/watch", admin(adminHandler.WatchConfig))
+	mux.HandleFunc("/api/admin/watch/codes", admin(adminHandler.WatchCodes))
+
 	// 服务状态
 	mux.HandleFunc("/api/status", middleware.CORS(fundHandler.GetServiceStatus))
-	
+
 	// 健康检查
 	mux.HandleFunc("/health", fundHandler.Health)
 
@@ -0,0 +1,474 @@
+package router
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"fund/handler"
+	"fund/internal/fakeupstream"
+	"fund/model"
+	"fund/service"
+	"fund/storage"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// TestServerEndToEnd 使用模拟上游端到端测试整个服务
+func TestServerEndToEnd(t *testing.T) {
+	upstream := fakeupstream.New()
+	defer upstream.Close()
+
+	provider := service.NewEastmoneyProviderWithBaseURL(upstream.URL)
+	fundService := service.NewFundServiceWithProvider(provider)
+	intradayService := service.NewIntradayServiceWithProvider(provider)
+
+	dir := t.TempDir()
+	configFile := filepath.Join(dir, "watch_funds.json")
+	if err := os.WriteFile(configFile, []byte(`{"watch_list":["000001","110022"],"fetch_interval":1}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	intradayService.SetDataDir(filepath.Join(dir, "data"))
+	intradayService.SetConfigFile(configFile)
+	clock := func() time.Time {
+		return time.Date(2025, 7, 1, 10, 30, 0, 0, time.FixedZone("CST", 8*3600))
+	}
+	fundService.SetClock(clock)
+	intradayService.SetClock(clock)
+	watchlistService := service.NewWatchlistService(storage.NewFileWatchlistStore(dir))
+	intradayService.SetWatchSource(watchlistService.WatchCodes)
+	if err := intradayService.Start(); err != nil {
+		t.Fatalf("❌ 启动实时数据服务失败: %v", err)
+	}
+	defer intradayService.Stop()
+
+	portfolioService := service.NewPortfolioService(storage.NewFilePortfolioStore(dir), fundService, intradayService)
+	portfolioService.SetClock(clock)
+	userService := service.NewUserService(storage.NewFileUserStore(dir))
+	if _, err := userService.BootstrapAdmin("alice", "secret-alice"); err != nil {
+		t.Fatal(err)
+	}
+	alertService := service.NewAlertService(storage.NewFileAlertStore(dir), fundService)
+	fundHandler := handler.NewFundHandler(fundService, intradayService)
+	fundHandler.SetStreamHeartbeat(20 * time.Millisecond)
+	hooks := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer hooks.Close()
+	notifyService := service.NewNotifyService(storage.NewFileNotifyStore(dir))
+	notifyService.SetAllowPrivateNetwork(true)
+	server := httptest.NewServer(SetupRoutes(Handlers{
+		Fund:          fundHandler,
+		Portfolio:     handler.NewPortfolioHandler(portfolioService),
+		Auth:          handler.NewAuthHandler(userService),
+		Watchlist:     handler.NewWatchlistHandler(watchlistService),
+		Admin:         handler.NewAdminHandler(intradayService),
+		Alert:         handler.NewAlertHandler(alertService),
+		Notify:        handler.NewNotifyHandler(notifyService),
+		Live:          handler.NewLiveHandler(intradayService),
+		Authenticator: userService,
+	}))
+	defer server.Close()
+
+	authToken := "" // 非空时请求携带 Authorization 头
+	send := func(method, path, body string, out interface{}) int {
+		t.Helper()
+		req, err := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if authToken != "" {
+			req.Header.Set("Authorization", "Bearer "+authToken)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("❌ 请求 %s %s 失败: %v", method, path, err)
+		}
+		defer resp.Body.Close()
+		if out != nil {
+			if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+				t.Fatalf("❌ 解析 %s 响应失败: %v", path, err)
+			}
+		}
+		return resp.StatusCode
+	}
+	get := func(path string, out interface{}) int {
+		t.Helper()
+		return send(http.MethodGet, path, "", out)
+	}
+
+	var detail map[string]interface{}
+	if code := get("/api/fund/detail?code=000001", &detail); code != http.StatusOK || detail["name"] != "华夏成长混合" {
+		t.Errorf("❌ 基金详情响应异常: %d %v", code, detail)
+	}
+
+	var trend map[string]interface{}
+	if code := get("/api/fund/trend?code=110022&period=all", &trend); code != http.StatusOK {
+		t.Errorf("❌ 走势响应异常: %d %v", code, trend)
+	}
+
+	var ytd model.FundTrend
+	if code := get("/api/fund/trend?code=110022&period=ytd", &ytd); code != http.StatusOK ||
+		ytd.Start != "2025-01-01" || ytd.End != "2025-07-01" || len(ytd.Data) == 0 {
+		t.Errorf("❌ 今年以来走势响应异常: %d %+v", code, ytd.Period)
+	}
+	for _, path := range []string{
+		"/api/fund/trend?code=110022&period=decade",
+		"/api/fund/trend?code=110022&period=since",
+		"/api/fund/trend?code=110022&period=year&start=2025-01-01",
+		"/api/fund/trend?code=110022&start=2025-02-30",
+		"/api/fund/trend?code=110022&start=2025-03-01&end=2025-02-01",
+		"/api/fund/trend?code=110022&adjust=split",
+	} {
+		if code := get(path, nil); code != http.StatusBadRequest {
+			t.Errorf("❌ %s 应返回400, 实际 %d", path, code)
+		}
+	}
+
+	var analytics model.FundAnalytics
+	if code := get("/api/fund/analytics?code=000001&period=ytd", &analytics); code != http.StatusOK ||
+		analytics.Start != "2025-01-01" || analytics.MaxDrawdown <= 0 {
+		t.Errorf("❌ 业绩指标响应异常: %d %+v", code, analytics)
+	}
+	if code := get("/api/fund/analytics?code=000001&period=since&start=2025-06-30", nil); code != http.StatusBadRequest {
+		t.Errorf("❌ 数据不足应返回400, 实际 %d", code)
+	}
+
+	var comparison model.FundComparison
+	if code := get("/api/fund/compare?codes=000001,110022,000001&period=quarter", &comparison); code != http.StatusOK ||
+		len(comparison.Funds) != 2 || len(comparison.Rows) == 0 {
+		t.Errorf("❌ 基金对比响应异常: %d %+v", code, comparison.Funds)
+	}
+	for _, path := range []string{"/api/fund/compare?codes=000001", "/api/fund/compare?codes=000001,abc"} {
+		if code := get(path, nil); code != http.StatusBadRequest {
+			t.Errorf("❌ %s 应返回400, 实际 %d", path, code)
+		}
+	}
+
+	var benchmark model.FundBenchmark
+	if code := get("/api/fund/benchmark?code=110022&period=half_year", &benchmark); code != http.StatusOK ||
+		benchmark.Benchmark != "沪深300" || len(benchmark.Series) != 3 {
+		t.Errorf("❌ 基准对比响应异常: %d %+v", code, benchmark.Benchmark)
+	}
+
+	var backtest model.BacktestResult
+	if code := get("/api/fund/backtest?code=110022&start=2025-01-01&amount=200&frequency=weekly&day=2", &backtest); code != http.StatusOK ||
+		len(backtest.Purchases) < 20 || backtest.TotalCost != float64(200*len(backtest.Purchases)) {
+		t.Errorf("❌ 定投回测响应异常: %d %d", code, len(backtest.Purchases))
+	}
+	for _, path := range []string{
+		"/api/fund/backtest?code=110022",
+		"/api/fund/backtest?code=110022&start=2025-01-01&frequency=daily",
+		"/api/fund/backtest?code=110022&start=2025-01-01&frequency=weekly&day=6",
+		"/api/fund/backtest?code=110022&start=2025-01-01&amount=-1",
+	} {
+		if code := get(path, nil); code != http.StatusBadRequest {
+			t.Errorf("❌ %s 应返回400, 实际 %d", path, code)
+		}
+	}
+
+	var profile model.FundProfile
+	if code := get("/api/fund/profile?code=110022", &profile); code != http.StatusOK ||
+		profile.Code != "110022" || len(profile.Holdings) == 0 || len(profile.Managers) == 0 {
+		t.Errorf("❌ 基金档案响应异常: %d %+v", code, profile)
+	}
+
+	var list map[string]interface{}
+	if code := get("/api/fund/list?keyword=易方达", &list); code != http.StatusOK || list["total"] != float64(2) {
+		t.Errorf("❌ 基金列表响应异常: %d %v", code, list)
+	}
+
+	// 等待采集协程写入日内数据
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		var intraday map[string]interface{}
+		if get("/api/fund/intraday?code=110022", &intraday) == http.StatusOK {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("❌ 超时未采集到日内数据")
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	// 实时推送: 先推送当日已有数据，空闲时发送心跳
+	if code := get("/api/fund/stream?codes=abc", nil); code != http.StatusBadRequest {
+		t.Errorf("❌ 推送订阅非法代码应返回400, 实际 %d", code)
+	}
+	streamResp, err := http.Get(server.URL + "/api/fund/stream?codes=110022,110022")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ct := streamResp.Header.Get("Content-Type"); streamResp.StatusCode != http.StatusOK || !strings.HasPrefix(ct, "text/event-stream") {
+		t.Fatalf("❌ 推送响应异常: %d %s", streamResp.StatusCode, ct)
+	}
+	var streamLines []string
+	scanner := bufio.NewScanner(streamResp.Body)
+	scanner.Buffer(make([]byte, 0, 64<<10), 1<<20)
+	for scanner.Scan() && len(streamLines) < 100 {
+		streamLines = append(streamLines, scanner.Text())
+		if scanner.Text() == ": ping" {
+			break
+		}
+	}
+	streamResp.Body.Close()
+	if len(streamLines) < 7 || streamLines[0] != "retry: 3000" || !strings.HasPrefix(streamLines[2], "id: ") ||
+		streamLines[3] != "event: snapshot" || !strings.HasPrefix(streamLines[4], `data: {"code":"110022"`) ||
+		streamLines[len(streamLines)-1] != ": ping" {
+		t.Errorf("❌ 推送内容异常: %q", streamLines)
+	}
+
+	// 个人数据接口需要认证，行情数据公开
+	if code := get("/api/portfolio/holdings", nil); code != http.StatusUnauthorized {
+		t.Errorf("❌ 未登录访问持仓应返回401, 实际 %d", code)
+	}
+	if code := send(http.MethodPost, "/api/auth/register", `{"username":"bob","password":"secret-bob"}`, nil); code != http.StatusOK {
+		t.Fatalf("❌ 注册 bob 应返回200, 实际 %d", code)
+	}
+	if code := send(http.MethodPost, "/api/auth/register", `{"username":"alice","password":"secret-alice"}`, nil); code != http.StatusConflict {
+		t.Errorf("❌ 重复注册应返回409, 实际 %d", code)
+	}
+	if code := send(http.MethodPost, "/api/auth/login", `{"username":"alice","password":"wrong"}`, nil); code != http.StatusUnauthorized {
+		t.Errorf("❌ 密码错误应返回401, 实际 %d", code)
+	}
+	var login struct {
+		User  model.User `json:"user"`
+		Token string     `json:"token"`
+	}
+	if code := send(http.MethodPost, "/api/auth/login", `{"username":"alice","password":"secret-alice"}`, &login); code != http.StatusOK ||
+		login.Token == "" || !login.User.Admin {
+		t.Fatalf("❌ 登录响应异常: %d %+v", code, login)
+	}
+	authToken = "invalid"
+	if code := get("/api/auth/me", nil); code != http.StatusUnauthorized {
+		t.Errorf("❌ 无效令牌应返回401, 实际 %d", code)
+	}
+	authToken = login.Token
+	var me model.User
+	if code := get("/api/auth/me", &me); code != http.StatusOK || me.Username != "alice" {
+		t.Errorf("❌ 当前用户响应异常: %d %+v", code, me)
+	}
+
+	// WebSocket 实时订阅: 未登录不能订阅提醒，登录后订阅行情、采集状态和提醒
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/api/ws"
+	if _, resp, err := websocket.DefaultDialer.Dial(wsURL+"?topics=alerts", nil); err == nil || resp == nil || resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("❌ 未登录订阅提醒应返回400: %v %v", resp, err)
+	}
+	ws, _, err := websocket.DefaultDialer.Dial(wsURL+"?codes=110022&topics=status,alerts",
+		http.Header{"Authorization": {"Bearer " + login.Token}})
+	if err != nil {
+		t.Fatalf("❌ 建立 WebSocket 连接失败: %v", err)
+	}
+	type liveMessage struct {
+		Type  string          `json:"type"`
+		Data  json.RawMessage `json:"data"`
+		Error string          `json:"error"`
+	}
+	readUntil := func(messageType string) liveMessage {
+		t.Helper()
+		ws.SetReadDeadline(time.Now().Add(5 * time.Second))
+		for {
+			var message liveMessage
+			if err := ws.ReadJSON(&message); err != nil {
+				t.Fatalf("❌ 等待 %s 消息失败: %v", messageType, err)
+			}
+			if message.Type == messageType {
+				return message
+			}
+		}
+	}
+	if message := readUntil("subscribed"); string(message.Data) != `{"codes":["110022"],"topics":["alerts","status"]}` {
+		t.Errorf("❌ 订阅确认异常: %s", message.Data)
+	}
+	if message := readUntil("snapshot"); !strings.Contains(string(message.Data), `"code":"110022"`) {
+		t.Errorf("❌ 快照消息异常: %s", message.Data)
+	}
+	ws.WriteJSON(map[string]interface{}{"action": "subscribe", "codes": []string{"abc"}})
+	if message := readUntil("error"); !strings.Contains(message.Error, "基金代码") {
+		t.Errorf("❌ 非法订阅应返回错误消息: %+v", message)
+	}
+	ws.WriteJSON(map[string]interface{}{"action": "unsubscribe", "codes": []string{"110022"}, "topics": []string{"alerts"}})
+	if message := readUntil("subscribed"); string(message.Data) != `{"codes":[],"topics":["status"]}` {
+		t.Errorf("❌ 取消订阅确认异常: %s", message.Data)
+	}
+	ws.WriteJSON(map[string]string{"action": "ping"})
+	readUntil("pong")
+	ws.Close()
+
+	// 管理员运行时修改监控配置，写回配置文件
+	var watchConfig service.WatchConfig
+	if code := send(http.MethodPost, "/api/admin/watch/codes", `{"codes":["161725","000001"]}`, &watchConfig); code != http.StatusOK ||
+		len(watchConfig.WatchList) != 3 || watchConfig.WatchList[2] != "161725" {
+		t.Errorf("❌ 添加监控基金响应异常: %d %+v", code, watchConfig)
+	}
+	if code := send(http.MethodPut, "/api/admin/watch", `{"fetch_interval":5}`, &watchConfig); code != http.StatusOK ||
+		watchConfig.FetchInterval != 5 || len(watchConfig.WatchList) != 3 {
+		t.Errorf("❌ 修改采集周期响应异常: %d %+v", code, watchConfig)
+	}
+	if code := send(http.MethodDelete, "/api/admin/watch/codes?codes=161725", "", &watchConfig); code != http.StatusOK || len(watchConfig.WatchList) != 2 {
+		t.Errorf("❌ 移除监控基金响应异常: %d %+v", code, watchConfig)
+	}
+	if code := send(http.MethodPut, "/api/admin/watch", `{"fetch_interval":-1}`, nil); code != http.StatusBadRequest {
+		t.Errorf("❌ 非法采集周期应返回400, 实际 %d", code)
+	}
+	if data, err := os.ReadFile(configFile); err != nil || !strings.Contains(string(data), `"fetch_interval": 5`) {
+		t.Errorf("❌ 监控配置未写回配置文件: %s %v", data, err)
+	}
+
+	// 自选分组
+	var watchlist model.Watchlist
+	if code := send(http.MethodPost, "/api/watchlists", `{"name":"默认","codes":["110022"]}`, &watchlist); code != http.StatusOK || watchlist.ID == 0 {
+		t.Fatalf("❌ 新建分组响应异常: %d %+v", code, watchlist)
+	}
+	if code := send(http.MethodPost, "/api/watchlists/codes", `{"id":`+strconv.FormatInt(watchlist.ID, 10)+`,"code":"000001","position":0}`, &watchlist); code != http.StatusOK ||
+		len(watchlist.Codes) != 2 || watchlist.Codes[0] != "000001" {
+		t.Errorf("❌ 添加自选基金响应异常: %d %+v", code, watchlist)
+	}
+	var second model.Watchlist
+	if code := send(http.MethodPost, "/api/watchlists", `{"name":"备选"}`, &second); code != http.StatusOK {
+		t.Fatalf("❌ 新建第二个分组失败: %d", code)
+	}
+	var ordered []model.Watchlist
+	order := fmt.Sprintf(`{"ids":[%d,%d]}`, second.ID, watchlist.ID)
+	if code := send(http.MethodPut, "/api/watchlists/order", order, &ordered); code != http.StatusOK || len(ordered) != 2 || ordered[0].ID != second.ID {
+		t.Errorf("❌ 分组排序响应异常: %d %+v", code, ordered)
+	}
+	for method, path := range map[string]string{
+		http.MethodPost: "/api/watchlists/codes",
+		http.MethodPut:  "/api/watchlists",
+	} {
+		if code := send(method, path, `{"id":`+strconv.FormatInt(watchlist.ID, 10)+`,"code":"abc"}`, nil); code != http.StatusBadRequest {
+			t.Errorf("❌ %s %s 非法参数应返回400, 实际 %d", method, path, code)
+		}
+	}
+	if code := send(http.MethodDelete, "/api/watchlists?id="+strconv.FormatInt(second.ID, 10), "", nil); code != http.StatusOK {
+		t.Errorf("❌ 删除分组应返回200, 实际 %d", code)
+	}
+
+	// 提醒规则
+	var rule model.AlertRule
+	if code := send(http.MethodPost, "/api/alerts/rules", `{"code":"000001","type":"rate_above","threshold":0.3}`, &rule); code != http.StatusOK ||
+		rule.ID == 0 || !rule.Enabled {
+		t.Fatalf("❌ 新建提醒规则响应异常: %d %+v", code, rule)
+	}
+	ruleQuery := "/api/alerts/rules?id=" + strconv.FormatInt(rule.ID, 10)
+	if code := send(http.MethodPut, ruleQuery, `{"code":"000001","type":"rate_above","threshold":0.3,"enabled":false}`, &rule); code != http.StatusOK || rule.Enabled {
+		t.Errorf("❌ 停用提醒规则响应异常: %d %+v", code, rule)
+	}
+	if code := send(http.MethodPost, "/api/alerts/rules", `{"code":"000001","type":"unknown","threshold":1}`, nil); code != http.StatusBadRequest {
+		t.Errorf("❌ 未知规则类型应返回400, 实际 %d", code)
+	}
+	var alerts []model.Alert
+	if code := get("/api/alerts?date=2025-07-01", &alerts); code != http.StatusOK {
+		t.Errorf("❌ 提醒列表应返回200, 实际 %d", code)
+	}
+	if code := get("/api/alerts?limit=0", nil); code != http.StatusBadRequest {
+		t.Errorf("❌ 非法 limit 应返回400, 实际 %d", code)
+	}
+
+	// 通知渠道
+	var channel map[string]interface{}
+	if code := send(http.MethodPost, "/api/notify/channels", `{"type":"webhook","url":"`+hooks.URL+`","secret":"s3cret"}`, &channel); code != http.StatusOK ||
+		channel["hasSecret"] != true || channel["secret"] != nil || channel["name"] != "webhook" {
+		t.Fatalf("❌ 新建通知渠道响应异常: %d %v", code, channel)
+	}
+	channelQuery := "?id=" + strconv.FormatFloat(channel["id"].(float64), 'f', 0, 64)
+	for _, body := range []string{`{"type":"email","email":"alice@example.com"}`, `{"type":"webhook","url":"ftp://example.com"}`} {
+		if code := send(http.MethodPost, "/api/notify/channels", body, nil); code != http.StatusBadRequest {
+			t.Errorf("❌ %s 应返回400, 实际 %d", body, code)
+		}
+	}
+	var delivery model.NotifyDelivery
+	if code := send(http.MethodPost, "/api/notify/channels/test"+channelQuery, "", &delivery); code != http.StatusOK || delivery.Status != "sent" {
+		t.Errorf("❌ 测试通知响应异常: %d %+v", code, delivery)
+	}
+	var deliveries []model.NotifyDelivery
+	if code := get("/api/notify/deliveries", &deliveries); code != http.StatusOK || len(deliveries) != 1 {
+		t.Errorf("❌ 投递记录响应异常: %d %+v", code, deliveries)
+	}
+	if code := send(http.MethodDelete, "/api/notify/channels"+channelQuery, "", nil); code != http.StatusOK {
+		t.Errorf("❌ 删除通知渠道应返回200, 实际 %d", code)
+	}
+	if code := send(http.MethodPost, "/api/notify/channels/test"+channelQuery, "", nil); code != http.StatusNotFound {
+		t.Errorf("❌ 已删除的通知渠道应返回404, 实际 %d", code)
+	}
+
+	// 持仓管理
+	if code := send(http.MethodPost, "/api/portfolio/transactions",
+		`{"code":"110022","type":"buy","date":"2025-06-30","amount":1000,"price":2.5}`, nil); code != http.StatusOK {
+		t.Errorf("❌ 记录买入应返回200, 实际 %d", code)
+	}
+	if code := send(http.MethodPost, "/api/portfolio/holdings", `{"code":"000001","shares":500,"cost":550}`, nil); code != http.StatusOK {
+		t.Errorf("❌ 保存持仓应返回200, 实际 %d", code)
+	}
+	for path, body := range map[string]string{
+		"/api/portfolio/transactions": `{"code":"110022","type":"sell","shares":100000}`,
+		"/api/portfolio/holdings":     `{"code":"000001","shares":-1}`,
+	} {
+		if code := send(http.MethodPost, path, body, nil); code != http.StatusBadRequest {
+			t.Errorf("❌ %s %s 应返回400, 实际 %d", path, body, code)
+		}
+	}
+	var valuation model.PortfolioValuation
+	if code := get("/api/portfolio/valuation", &valuation); code != http.StatusOK ||
+		len(valuation.Holdings) != 2 || valuation.Cost != 1550 || valuation.MarketValue <= 0 {
+		t.Errorf("❌ 组合估值响应异常: %d %+v", code, valuation)
+	}
+	var curve model.PortfolioIntraday
+	if code := get("/api/portfolio/intraday", &curve); code != http.StatusOK ||
+		curve.Date != "2025-07-01" || len(curve.Funds) != 2 || len(curve.Data) == 0 {
+		t.Errorf("❌ 组合日内曲线响应异常: %d %+v", code, curve)
+	}
+	if code := get("/api/portfolio/intraday?date=20250701", nil); code != http.StatusBadRequest {
+		t.Errorf("❌ 日期格式错误应返回400, 实际 %d", code)
+	}
+	if code := send(http.MethodDelete, "/api/portfolio/holdings?code=000001", "", nil); code != http.StatusOK {
+		t.Errorf("❌ 删除持仓应返回200, 实际 %d", code)
+	}
+	if code := send(http.MethodDelete, "/api/portfolio/holdings?code=000001", "", nil); code != http.StatusNotFound {
+		t.Errorf("❌ 删除不存在的持仓应返回404, 实际 %d", code)
+	}
+
+	// API 令牌登录的另一个用户看不到 alice 的持仓
+	var created struct {
+		Token string         `json:"token"`
+		Info  model.APIToken `json:"info"`
+	}
+	if code := send(http.MethodPost, "/api/auth/login", `{"username":"bob","password":"secret-bob"}`, &login); code != http.StatusOK {
+		t.Fatalf("❌ 登录 bob 失败: %d", code)
+	}
+	authToken = login.Token
+	if code := send(http.MethodPost, "/api/auth/tokens", `{"name":"ci"}`, &created); code != http.StatusOK || created.Token == "" {
+		t.Fatalf("❌ 创建 API 令牌响应异常: %d %+v", code, created)
+	}
+	authToken = created.Token
+	var holdings []model.Holding
+	if code := get("/api/portfolio/holdings", &holdings); code != http.StatusOK || len(holdings) != 0 {
+		t.Errorf("❌ bob 不应看到 alice 的持仓: %d %+v", code, holdings)
+	}
+	if code := get("/api/watchlists?id="+strconv.FormatInt(watchlist.ID, 10), nil); code != http.StatusNotFound {
+		t.Errorf("❌ bob 读取 alice 的分组应返回404, 实际 %d", code)
+	}
+	if code := get(ruleQuery, nil); code != http.StatusNotFound {
+		t.Errorf("❌ bob 读取 alice 的提醒规则应返回404, 实际 %d", code)
+	}
+	if code := get("/api/admin/watch", nil); code != http.StatusForbidden {
+		t.Errorf("❌ 非管理员访问管理接口应返回403, 实际 %d", code)
+	}
+	if code := send(http.MethodDelete, "/api/auth/tokens?id="+strconv.FormatInt(created.Info.ID, 10), "", nil); code != http.StatusOK {
+		t.Errorf("❌ 吊销令牌应返回200, 实际 %d", code)
+	}
+	if code := get("/api/portfolio/holdings", nil); code != http.StatusUnauthorized {
+		t.Errorf("❌ 吊销后的令牌应返回401, 实际 %d", code)
+	}
+	authToken = ""
+
+	if code := get("/api/fund/detail?code=abc", nil); code != http.StatusBadRequest {
+		t.Errorf("❌ 非法基金代码应返回400, 实际 %d", code)
+	}
+}
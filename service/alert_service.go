@@ -0,0 +1,434 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"fund/model"
+	"fund/storage"
+	"log"
+	"math"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	// ErrAlertRuleNotFound 提醒规则不存在
+	ErrAlertRuleNotFound = errors.New("提醒规则不存在")
+	// ErrInvalidAlertRule 提醒规则参数无效
+	ErrInvalidAlertRule = errors.New("提醒规则参数无效")
+)
+
+// 提醒规则类型
+const (
+	AlertRateAbove    = "rate_above"    // 估算涨跌幅 >= 阈值（%）
+	AlertRateBelow    = "rate_below"    // 估算涨跌幅 <= 阈值（%）
+	AlertValueAbove   = "value_above"   // 估算净值 >= 阈值
+	AlertValueBelow   = "value_below"   // 估算净值 <= 阈值
+	AlertDrawdown     = "drawdown"      // 估算净值较当日最高回落 >= 阈值（%）
+	AlertNAVDeviation = "nav_deviation" // 估算净值与上一交易日净值偏离的绝对值 >= 阈值（%）
+)
+
+const (
+	maxAlertRulesPerUser = 100 // 每个用户的规则数量上限
+	maxAlertNote         = 64  // 规则备注长度上限（字符）
+	defaultAlertLimit    = 100 // 提醒列表默认返回数量
+)
+
+// AlertService 提醒规则服务：管理用户的规则，并在日内数据点写入时计算规则、记录触发的提醒
+type AlertService struct {
+	store       storage.AlertStore // 提醒存储
+	fundService *FundService       // 基金服务（用于读取上一交易日净值）
+	mutex       sync.Mutex         // 修改锁（读取-修改-保存需串行）
+	now         func() time.Time   // 时钟（可替换，便于测试）
+
+	rulesMutex sync.Mutex                   // 规则缓存锁
+	rules      map[string][]model.AlertRule // 已启用规则缓存 key: 基金代码，nil 表示需要重新加载
+	fired      map[int64]string             // 规则最近一次触发的交易日，同一交易日不再重复计算
+
+	basesMutex sync.Mutex            // 净值偏离基准锁
+	bases      map[string]*alertBase // 净值偏离规则的基准净值 key: 基金代码
+
+	listenersMutex sync.RWMutex    // 回调锁
+	listeners      []AlertListener // 提醒触发回调
+}
+
+// alertBase 基金在某个交易日的净值偏离基准，由后台协程获取，采集协程只读取不等待上游
+type alertBase struct {
+	date        string    // 交易日
+	nav         float64   // 上一交易日官方净值，0 表示尚未取得
+	loading     bool      // 正在后台获取
+	attemptedAt time.Time // 最近一次获取失败的时间，重试间隔内不再请求
+}
+
+// AlertListener 提醒触发后的回调，在采集协程中同步调用，耗时操作应自行异步处理
+type AlertListener func(alert model.Alert)
+
+// NewAlertService 创建提醒规则服务
+func NewAlertService(store storage.AlertStore, fundService *FundService) *AlertService {
+	return &AlertService{
+		store:       store,
+		fundService: fundService,
+		now:         time.Now,
+		fired:       make(map[int64]string),
+		bases:       make(map[string]*alertBase),
+	}
+}
+
+// SetClock 设置时钟，用于测试
+func (s *AlertService) SetClock(now func() time.Time) {
+	s.now = now
+}
+
+// AddAlertListener 注册提醒触发回调（如发送通知）
+func (s *AlertService) AddAlertListener(listener AlertListener) {
+	s.listenersMutex.Lock()
+	defer s.listenersMutex.Unlock()
+	s.listeners = append(s.listeners, listener)
+}
+
+// ListRules 获取用户的全部规则
+func (s *AlertService) ListRules(userID int64) ([]model.AlertRule, error) {
+	return s.store.ListAlertRules(userID)
+}
+
+// GetRule 获取用户的规则
+func (s *AlertService) GetRule(userID, id int64) (*model.AlertRule, error) {
+	rule, err := s.store.GetAlertRule(userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if rule == nil {
+		return nil, ErrAlertRuleNotFound
+	}
+	return rule, nil
+}
+
+// CreateRule 新建规则
+func (s *AlertService) CreateRule(userID int64, rule model.AlertRule) (*model.AlertRule, error) {
+	if err := normalizeAlertRule(&rule); err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	existing, err := s.store.ListAlertRules(userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= maxAlertRulesPerUser {
+		return nil, fmt.Errorf("%w: 每个用户最多 %d 条提醒规则", ErrInvalidAlertRule, maxAlertRulesPerUser)
+	}
+
+	now := s.now()
+	rule.ID = 0
+	rule.UserID = userID
+	rule.CreatedAt = now
+	rule.UpdatedAt = now
+	saved, err := s.store.SaveAlertRule(rule)
+	if err != nil {
+		return nil, err
+	}
+	s.invalidateRules()
+	s.prefetchBase(saved)
+	return &saved, nil
+}
+
+// UpdateRule 修改规则的基金、类型、阈值、启用状态和备注（当天已触发的规则当天不再触发）
+func (s *AlertService) UpdateRule(userID, id int64, rule model.AlertRule) (*model.AlertRule, error) {
+	if err := normalizeAlertRule(&rule); err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	existing, err := s.GetRule(userID, id)
+	if err != nil {
+		return nil, err
+	}
+	existing.Code = rule.Code
+	existing.Type = rule.Type
+	existing.Threshold = rule.Threshold
+	existing.Enabled = rule.Enabled
+	existing.Note = rule.Note
+	existing.UpdatedAt = s.now()
+	saved, err := s.store.SaveAlertRule(*existing)
+	if err != nil {
+		return nil, err
+	}
+	s.invalidateRules()
+	s.prefetchBase(saved)
+	return &saved, nil
+}
+
+// DeleteRule 删除规则，已触发的提醒保留
+func (s *AlertService) DeleteRule(userID, id int64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	deleted, err := s.store.DeleteAlertRule(userID, id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrAlertRuleNotFound
+	}
+	s.invalidateRules()
+	return nil
+}
+
+// ListAlerts 获取用户已触发的提醒（最新的在前），date 非空时只返回该交易日，limit <= 0 时使用默认数量
+func (s *AlertService) ListAlerts(userID int64, date string, limit int) ([]model.Alert, error) {
+	if limit <= 0 {
+		limit = defaultAlertLimit
+	}
+	return s.store.ListAlerts(userID, date, limit)
+}
+
+// Evaluate 计算基金的全部已启用规则，满足条件且当天尚未触发的规则记录一条提醒
+// 作为 IntradayService 的数据点写入回调使用
+func (s *AlertService) Evaluate(event PointEvent) {
+	if event.Point.Value <= 0 {
+		return
+	}
+	rules, err := s.rulesFor(event.Code)
+	if err != nil {
+		log.Printf("❌ 加载提醒规则失败: %v", err)
+		return
+	}
+
+	for _, rule := range rules {
+		if s.firedOn(rule.ID) == event.Date {
+			continue
+		}
+		observed, triggered := s.check(rule, event)
+		if !triggered {
+			continue
+		}
+
+		alert, inserted, err := s.store.RecordAlert(model.Alert{
+			RuleID:    rule.ID,
+			UserID:    rule.UserID,
+			Code:      event.Code,
+			Name:      event.Name,
+			Type:      rule.Type,
+			Threshold: rule.Threshold,
+			Observed:  roundMetric(observed),
+			Date:      event.Date,
+			Time:      event.Point.Time,
+			Value:     event.Point.Value,
+			Rate:      event.Point.Rate,
+			Message:   alertMessage(rule, event, observed),
+			CreatedAt: s.now(),
+		})
+		if err != nil {
+			log.Printf("❌ 保存提醒失败: %v", err)
+			continue
+		}
+		s.markFired(rule.ID, event.Date)
+		if inserted {
+			log.Printf("🔔 [用户 %d] %s", alert.UserID, alert.Message)
+			s.notifyAlert(alert)
+		}
+	}
+}
+
+// notifyAlert 将新触发的提醒分发给所有回调
+func (s *AlertService) notifyAlert(alert model.Alert) {
+	s.listenersMutex.RLock()
+	listeners := s.listeners
+	s.listenersMutex.RUnlock()
+
+	for _, listener := range listeners {
+		listener(alert)
+	}
+}
+
+// check 计算规则的指标值及是否触发
+func (s *AlertService) check(rule model.AlertRule, event PointEvent) (float64, bool) {
+	point := event.Point
+	switch rule.Type {
+	case AlertRateAbove:
+		return point.Rate, point.Rate >= rule.Threshold
+	case AlertRateBelow:
+		return point.Rate, point.Rate <= rule.Threshold
+	case AlertValueAbove:
+		return point.Value, point.Value >= rule.Threshold
+	case AlertValueBelow:
+		return point.Value, point.Value <= rule.Threshold
+	case AlertDrawdown:
+		if event.High <= 0 {
+			return 0, false
+		}
+		drawdown := (event.High - point.Value) / event.High * 100
+		return drawdown, drawdown >= rule.Threshold
+	case AlertNAVDeviation:
+		base := s.baseNAV(event)
+		if base <= 0 {
+			return 0, false
+		}
+		deviation := (point.Value/base - 1) * 100
+		return deviation, math.Abs(deviation) >= rule.Threshold
+	}
+	return 0, false
+}
+
+// baseNAV 上一交易日的官方净值，尚未取得时由估算净值和估算涨跌幅反推
+func (s *AlertService) baseNAV(event PointEvent) float64 {
+	if nav := s.officialBase(event.Code, event.Date); nav > 0 {
+		return nav
+	}
+	if point := event.Point; point.Rate > -100 {
+		return point.Value / (1 + point.Rate/100)
+	}
+	return 0
+}
+
+// prefetchBase 启用的净值偏离规则在创建或修改时提前获取当天的基准净值
+func (s *AlertService) prefetchBase(rule model.AlertRule) {
+	if rule.Enabled && rule.Type == AlertNAVDeviation {
+		s.officialBase(rule.Code, s.now().Format("2006-01-02"))
+	}
+}
+
+// officialBase 已取得的上一交易日官方净值，尚未取得时启动后台获取并返回 0
+// 每只基金每个交易日只获取一次，失败后间隔 navRetryInterval 再重试，采集协程不会因此请求上游
+func (s *AlertService) officialBase(code, date string) float64 {
+	if s.fundService == nil {
+		return 0
+	}
+	s.basesMutex.Lock()
+	defer s.basesMutex.Unlock()
+
+	base, exists := s.bases[code]
+	if exists && base.date == date && (base.nav > 0 || base.loading || s.now().Sub(base.attemptedAt) < navRetryInterval) {
+		return base.nav
+	}
+	s.bases[code] = &alertBase{date: date, loading: true}
+	go s.loadBase(code, date)
+	return 0
+}
+
+// loadBase 后台获取上一交易日官方净值
+func (s *AlertService) loadBase(code, date string) {
+	nav, err := s.fundService.previousNAV(code, date)
+	if err != nil {
+		log.Printf("⚠️  %v, 净值偏离提醒暂用估算涨跌幅反推", err)
+	}
+
+	s.basesMutex.Lock()
+	defer s.basesMutex.Unlock()
+	base, exists := s.bases[code]
+	if !exists || base.date != date {
+		return
+	}
+	base.loading = false
+	base.nav = nav
+	if nav <= 0 {
+		base.attemptedAt = s.now()
+	}
+}
+
+// rulesFor 基金的已启用规则，缓存失效时从存储重新加载全部规则
+func (s *AlertService) rulesFor(code string) ([]model.AlertRule, error) {
+	s.rulesMutex.Lock()
+	defer s.rulesMutex.Unlock()
+
+	if s.rules == nil {
+		rules, err := s.store.EnabledAlertRules()
+		if err != nil {
+			return nil, err
+		}
+		s.rules = make(map[string][]model.AlertRule)
+		for _, rule := range rules {
+			s.rules[rule.Code] = append(s.rules[rule.Code], rule)
+		}
+	}
+	return s.rules[code], nil
+}
+
+// invalidateRules 规则修改后清空缓存，下一个数据点写入时重新加载
+func (s *AlertService) invalidateRules() {
+	s.rulesMutex.Lock()
+	defer s.rulesMutex.Unlock()
+	s.rules = nil
+}
+
+// firedOn 规则最近一次触发的交易日
+func (s *AlertService) firedOn(ruleID int64) string {
+	s.rulesMutex.Lock()
+	defer s.rulesMutex.Unlock()
+	return s.fired[ruleID]
+}
+
+// markFired 记录规则已在该交易日触发
+func (s *AlertService) markFired(ruleID int64, date string) {
+	s.rulesMutex.Lock()
+	defer s.rulesMutex.Unlock()
+	s.fired[ruleID] = date
+}
+
+// normalizeAlertRule 校验规则参数
+func normalizeAlertRule(rule *model.AlertRule) error {
+	if !fundCodePattern.MatchString(rule.Code) {
+		return fmt.Errorf("%w: 基金代码格式错误,应为6位数字", ErrInvalidAlertRule)
+	}
+	if math.IsNaN(rule.Threshold) || math.IsInf(rule.Threshold, 0) {
+		return fmt.Errorf("%w: 阈值无效", ErrInvalidAlertRule)
+	}
+	switch rule.Type {
+	case AlertRateAbove, AlertRateBelow:
+		if math.Abs(rule.Threshold) >= 100 {
+			return fmt.Errorf("%w: 涨跌幅阈值应在 -100%% 到 100%% 之间", ErrInvalidAlertRule)
+		}
+	case AlertValueAbove, AlertValueBelow:
+		if rule.Threshold <= 0 {
+			return fmt.Errorf("%w: 净值阈值应大于 0", ErrInvalidAlertRule)
+		}
+	case AlertDrawdown, AlertNAVDeviation:
+		if rule.Threshold <= 0 || rule.Threshold >= 100 {
+			return fmt.Errorf("%w: 幅度阈值应在 0%% 到 100%% 之间", ErrInvalidAlertRule)
+		}
+	default:
+		return fmt.Errorf("%w: 不支持的规则类型 %q", ErrInvalidAlertRule, rule.Type)
+	}
+	rule.Note = strings.TrimSpace(rule.Note)
+	if utf8.RuneCountInString(rule.Note) > maxAlertNote {
+		return fmt.Errorf("%w: 备注最多 %d 个字符", ErrInvalidAlertRule, maxAlertNote)
+	}
+	return nil
+}
+
+// alertMessage 生成提醒内容
+func alertMessage(rule model.AlertRule, event PointEvent, observed float64) string {
+	name := event.Name
+	if name == "" || name == event.Code {
+		name = event.Code
+	} else {
+		name = fmt.Sprintf("%s(%s)", name, event.Code)
+	}
+
+	var condition string
+	switch rule.Type {
+	case AlertRateAbove:
+		condition = fmt.Sprintf("估算涨跌幅 %.2f%% 高于 %.2f%%", observed, rule.Threshold)
+	case AlertRateBelow:
+		condition = fmt.Sprintf("估算涨跌幅 %.2f%% 低于 %.2f%%", observed, rule.Threshold)
+	case AlertValueAbove:
+		condition = fmt.Sprintf("估算净值 %.4f 高于 %.4f", observed, rule.Threshold)
+	case AlertValueBelow:
+		condition = fmt.Sprintf("估算净值 %.4f 低于 %.4f", observed, rule.Threshold)
+	case AlertDrawdown:
+		condition = fmt.Sprintf("估算净值较当日最高 %.4f 回落 %.2f%%, 超过 %.2f%%", event.High, observed, rule.Threshold)
+	case AlertNAVDeviation:
+		condition = fmt.Sprintf("估算净值偏离上一交易日净值 %.2f%%, 超过 ±%.2f%%", observed, rule.Threshold)
+	}
+	message := fmt.Sprintf("%s %s %s", name, event.Point.Time, condition)
+	if rule.Note != "" {
+		message += " (" + rule.Note + ")"
+	}
+	return message
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"errors"
+	"fund/internal/fakeupstream"
+	"fund/model"
+	"fund/storage"
+	"testing"
+	"time"
+)
+
+// waitAlertBase 等待基金的净值偏离基准后台获取结束
+func waitAlertBase(t *testing.T, alertService *AlertService, code string) {
+	t.Helper()
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(5 * time.Millisecond) {
+		alertService.basesMutex.Lock()
+		base, exists := alertService.bases[code]
+		loaded := exists && !base.loading
+		alertService.basesMutex.Unlock()
+		if loaded {
+			return
+		}
+	}
+	t.Fatalf("❌ 基金 %s 的基准净值未获取完成", code)
+}
+
+// TestAlertService 测试提醒规则的计算、按交易日去重和用户隔离
+func TestAlertService(t *testing.T) {
+	_, provider := newFakeUpstream(t)
+	fundService := NewFundServiceWithProvider(provider)
+	fundService.SetClock(tradingClock)
+	fundService.navCache["000001"] = &navCacheEntry{
+		refreshedAt: tradingClock(),
+		trend: &model.FundTrend{Code: "000001", Name: "华夏成长混合", Data: []model.TrendPoint{
+			{Date: "2025-06-27", Value: 1.09},
+			{Date: "2025-06-30", Value: 1.1},
+		}},
+	}
+	alertService := NewAlertService(storage.NewFileAlertStore(t.TempDir()), fundService)
+	alertService.SetClock(tradingClock)
+
+	for _, rule := range []model.AlertRule{
+		{Code: "abc", Type: AlertRateAbove, Threshold: 1},
+		{Code: "000001", Type: "volume_above", Threshold: 1},
+		{Code: "000001", Type: AlertDrawdown, Threshold: 0},
+		{Code: "000001", Type: AlertValueBelow, Threshold: -1},
+	} {
+		if _, err := alertService.CreateRule(1, rule); !errors.Is(err, ErrInvalidAlertRule) {
+			t.Errorf("❌ %+v 应返回 ErrInvalidAlertRule: %v", rule, err)
+		}
+	}
+
+	rateRule, err := alertService.CreateRule(1, model.AlertRule{Code: "000001", Type: AlertRateAbove, Threshold: 0.4, Enabled: true, Note: "加仓观察"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	valueRule, _ := alertService.CreateRule(1, model.AlertRule{Code: "000001", Type: AlertValueBelow, Threshold: 1.0, Enabled: true})
+	drawdownRule, _ := alertService.CreateRule(1, model.AlertRule{Code: "000001", Type: AlertDrawdown, Threshold: 1, Enabled: true})
+	deviationRule, _ := alertService.CreateRule(2, model.AlertRule{Code: "000001", Type: AlertNAVDeviation, Threshold: 1.5, Enabled: true})
+	if _, err := alertService.CreateRule(2, model.AlertRule{Code: "110022", Type: AlertRateBelow, Threshold: -2}); err != nil {
+		t.Fatal(err)
+	}
+	// 创建净值偏离规则时在后台获取基准净值
+	waitAlertBase(t, alertService, "000001")
+
+	// 采集写入数据点后计算规则: 估值 1.1166, 涨跌 0.49%, 上一交易日净值 1.1
+	intradayService := newTestIntradayService(t, provider)
+	intradayService.SetWatchSource(func() []string { return []string{"000001"} })
+	if err := intradayService.LoadAllFunds(); err != nil {
+		t.Fatal(err)
+	}
+	intradayService.AddPointListener(alertService.Evaluate)
+	var notified []model.Alert
+	alertService.AddAlertListener(func(alert model.Alert) { notified = append(notified, alert) })
+	intradayService.fetchWatchListRealtime()
+	intradayService.fetchWatchListRealtime()
+
+	alerts, err := alertService.ListAlerts(1, "2025-07-01", 0)
+	if err != nil || len(alerts) != 1 || alerts[0].RuleID != rateRule.ID || alerts[0].Observed != 0.49 ||
+		alerts[0].Time != "10:30" {
+		t.Fatalf("❌ 涨幅提醒异常: %+v %v", alerts, err)
+	}
+	// 测试中未加载基金列表，基金名称为代码
+	if want := "华夏成长混合(000001) 10:30 估算涨跌幅 0.49% 高于 0.40% (加仓观察)"; alerts[0].Message != want {
+		t.Errorf("❌ 提醒内容 %q, 期望 %q", alerts[0].Message, want)
+	}
+	if len(notified) != 2 || notified[0].ID == 0 {
+		t.Errorf("❌ 新触发的提醒应分发给回调: %+v", notified)
+	}
+	others, _ := alertService.ListAlerts(2, "", 0)
+	if len(others) != 1 || others[0].RuleID != deviationRule.ID || others[0].Observed != 1.5091 {
+		t.Errorf("❌ 净值偏离提醒异常（应基于上一交易日官方净值）: %+v", others)
+	}
+
+	// 较当日最高回落 1.49%，同一交易日已触发的涨幅规则不再重复
+	alertService.Evaluate(PointEvent{Code: "000001", Name: "华夏成长混合", Date: "2025-07-01",
+		Point: model.IntradayPoint{Time: "14:00", Value: 1.1, Rate: 0.5}, High: 1.1166, Low: 1.1})
+	alerts, _ = alertService.ListAlerts(1, "", 0)
+	if len(alerts) != 2 || alerts[0].RuleID != drawdownRule.ID || alerts[0].Observed != 1.4867 {
+		t.Errorf("❌ 回落提醒异常: %+v", alerts)
+	}
+
+	// 新的交易日重新计算；停用的规则不再触发
+	if _, err := alertService.UpdateRule(1, drawdownRule.ID, model.AlertRule{Code: "000001", Type: AlertDrawdown, Threshold: 1}); err != nil {
+		t.Fatal(err)
+	}
+	alertService.Evaluate(PointEvent{Code: "000001", Date: "2025-07-02",
+		Point: model.IntradayPoint{Time: "10:00", Value: 0.99, Rate: 0.6}, High: 1.2})
+	alerts, _ = alertService.ListAlerts(1, "2025-07-02", 0)
+	if len(alerts) != 2 || alerts[0].RuleID == drawdownRule.ID || alerts[1].RuleID == drawdownRule.ID {
+		t.Errorf("❌ 次日提醒异常: %+v", alerts)
+	}
+	if alerts[0].RuleID != valueRule.ID && alerts[1].RuleID != valueRule.ID {
+		t.Errorf("❌ 净值低于阈值应触发: %+v", alerts)
+	}
+
+	if err := alertService.DeleteRule(1, deviationRule.ID); !errors.Is(err, ErrAlertRuleNotFound) {
+		t.Errorf("❌ 删除其他用户的规则应返回 ErrAlertRuleNotFound: %v", err)
+	}
+	if _, err := alertService.UpdateRule(2, rateRule.ID, model.AlertRule{Code: "000001", Type: AlertRateAbove, Threshold: 1}); !errors.Is(err, ErrAlertRuleNotFound) {
+		t.Errorf("❌ 修改其他用户的规则应返回 ErrAlertRuleNotFound: %v", err)
+	}
+}
+
+// TestAlertBaseNAV 测试净值偏离基准在后台获取，上游故障时采集协程不重复请求上游
+func TestAlertBaseNAV(t *testing.T) {
+	upstream, provider := newFakeUpstream(t)
+	upstream.SetFault(fakeupstream.RoutePingzhongData, fakeupstream.FaultServerError)
+	fundService := NewFundServiceWithProvider(provider)
+	fundService.SetClock(tradingClock)
+	now := tradingClock()
+	alertService := NewAlertService(storage.NewFileAlertStore(t.TempDir()), fundService)
+	alertService.SetClock(func() time.Time { return now })
+
+	rule, err := alertService.CreateRule(1, model.AlertRule{Code: "110022", Type: AlertNAVDeviation, Threshold: 1, Enabled: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitAlertBase(t, alertService, "110022")
+
+	// 获取失败后使用估算涨跌幅反推的基准，重试间隔内不再请求上游
+	for i := 0; i < 10; i++ {
+		alertService.Evaluate(PointEvent{Code: "110022", Date: "2025-07-01",
+			Point: model.IntradayPoint{Time: "10:30", Value: 1.0, Rate: 1.2}})
+	}
+	if hits := upstream.Hits(fakeupstream.RoutePingzhongData); hits != 1 {
+		t.Errorf("❌ 重试间隔内不应重复请求上游: pingzhongdata %d", hits)
+	}
+	alerts, _ := alertService.ListAlerts(1, "", 0)
+	if len(alerts) != 1 || alerts[0].RuleID != rule.ID || alerts[0].Observed != 1.2 {
+		t.Errorf("❌ 应使用估算涨跌幅反推的基准: %+v", alerts)
+	}
+
+	// 重试间隔后上游恢复，改用官方净值
+	upstream.SetFault(fakeupstream.RoutePingzhongData, fakeupstream.FaultNone)
+	now = now.Add(navRetryInterval)
+	if nav := alertService.officialBase("110022", "2025-07-01"); nav != 0 {
+		t.Errorf("❌ 后台获取完成前应返回 0: %v", nav)
+	}
+	waitAlertBase(t, alertService, "110022")
+	if nav := alertService.officialBase("110022", "2025-07-01"); nav <= 0 || upstream.Hits(fakeupstream.RoutePingzhongData) != 2 {
+		t.Errorf("❌ 重试后应取得官方净值: %v", nav)
+	}
+}
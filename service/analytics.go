@@ -0,0 +1,164 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"fund/model"
+	"math"
+	"time"
+)
+
+const (
+	tradingDaysPerYear  = 252   // 年化波动率使用的年交易日数
+	defaultRiskFreeRate = 1.5   // 默认年化无风险利率（%）
+	analyticsPrecision  = 1e4   // 指标保留 4 位小数
+	daysPerYear         = 365.0 // 年化收益率使用的自然日数
+	metricEpsilon       = 1e-9  // 波动率、跟踪误差小于该值时视为 0（浮点误差），不计算比率
+)
+
+// ErrInsufficientData 区间内净值数据不足，无法计算指标
+var ErrInsufficientData = errors.New("区间内净值数据不足, 至少需要 2 个交易日")
+
+// riskMetrics 由净值序列计算的业绩与风险指标
+type riskMetrics struct {
+	totalReturn      float64
+	annualizedReturn float64
+	volatility       float64
+	maxDrawdown      float64
+	drawdownPeak     string
+	drawdownTrough   string
+	sharpe           float64
+	sortino          float64
+	calmar           float64
+	winRate          float64
+}
+
+// SetRiskFreeRate 设置计算夏普、索提诺比率使用的年化无风险利率（%）
+func (s *FundService) SetRiskFreeRate(rate float64) {
+	s.riskFreeRate = rate
+}
+
+// GetFundAnalytics 计算基金在指定区间的业绩与风险指标
+func (s *FundService) GetFundAnalytics(fundCode string, trendRange TrendRange) (*model.FundAnalytics, error) {
+	trendRange.Adjust = "dividend"
+	trend, err := s.GetFundTrendInRange(fundCode, trendRange)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics, err := computeRiskMetrics(trend.Data, s.riskFreeRate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.FundAnalytics{
+		Code:             fundCode,
+		Name:             trend.Name,
+		Period:           trend.Period,
+		Start:            trend.Data[0].Date,
+		End:              trend.Data[len(trend.Data)-1].Date,
+		Days:             len(trend.Data),
+		TotalReturn:      roundMetric(metrics.totalReturn),
+		AnnualizedReturn: roundMetric(metrics.annualizedReturn),
+		Volatility:       roundMetric(metrics.volatility),
+		MaxDrawdown:      roundMetric(metrics.maxDrawdown),
+		DrawdownPeak:     metrics.drawdownPeak,
+		DrawdownTrough:   metrics.drawdownTrough,
+		SharpeRatio:      roundMetric(metrics.sharpe),
+		SortinoRatio:     roundMetric(metrics.sortino),
+		CalmarRatio:      roundMetric(metrics.calmar),
+		WinRate:          roundMetric(metrics.winRate),
+		RiskFreeRate:     s.riskFreeRate,
+	}, nil
+}
+
+// computeRiskMetrics 根据按日期升序的净值序列计算指标，收益率类指标单位为 %
+// riskFreeRate 为年化无风险利率（%）
+func computeRiskMetrics(points []model.TrendPoint, riskFreeRate float64) (*riskMetrics, error) {
+	if len(points) < 2 {
+		return nil, ErrInsufficientData
+	}
+	first, last := points[0], points[len(points)-1]
+	if first.Value <= 0 {
+		return nil, fmt.Errorf("净值数据异常: %s 净值为 %v", first.Date, first.Value)
+	}
+
+	metrics := &riskMetrics{}
+	metrics.totalReturn = (last.Value/first.Value - 1) * 100
+
+	// 年化收益率按自然日折算
+	startTime, err1 := time.Parse("2006-01-02", first.Date)
+	endTime, err2 := time.Parse("2006-01-02", last.Date)
+	if err1 == nil && err2 == nil && endTime.After(startTime) {
+		years := endTime.Sub(startTime).Hours() / 24 / daysPerYear
+		metrics.annualizedReturn = (math.Pow(last.Value/first.Value, 1/years) - 1) * 100
+	}
+
+	// 日收益率、胜率、最大回撤
+	returns := make([]float64, 0, len(points)-1)
+	wins := 0
+	peak := first
+	for i := 1; i < len(points); i++ {
+		prev, point := points[i-1], points[i]
+		if prev.Value > 0 {
+			r := point.Value/prev.Value - 1
+			returns = append(returns, r)
+			if r > 0 {
+				wins++
+			}
+		}
+
+		if point.Value > peak.Value {
+			peak = point
+		} else if peak.Value > 0 {
+			if drawdown := (1 - point.Value/peak.Value) * 100; drawdown > metrics.maxDrawdown {
+				metrics.maxDrawdown = drawdown
+				metrics.drawdownPeak = peak.Date
+				metrics.drawdownTrough = point.Date
+			}
+		}
+	}
+	if len(returns) == 0 {
+		return metrics, nil
+	}
+	metrics.winRate = float64(wins) / float64(len(returns)) * 100
+
+	// 年化波动率与下行波动率（相对日无风险收益）
+	dailyRiskFree := riskFreeRate / 100 / tradingDaysPerYear
+	var mean float64
+	for _, r := range returns {
+		mean += r
+	}
+	mean /= float64(len(returns))
+	var variance, downside float64
+	for _, r := range returns {
+		variance += (r - mean) * (r - mean)
+		if excess := r - dailyRiskFree; excess < 0 {
+			downside += excess * excess
+		}
+	}
+	if len(returns) > 1 {
+		variance /= float64(len(returns) - 1)
+	}
+	downside /= float64(len(returns))
+	annualization := math.Sqrt(tradingDaysPerYear)
+	metrics.volatility = math.Sqrt(variance) * annualization * 100
+	downsideDeviation := math.Sqrt(downside) * annualization * 100
+
+	excessReturn := metrics.annualizedReturn - riskFreeRate
+	if metrics.volatility > metricEpsilon {
+		metrics.sharpe = excessReturn / metrics.volatility
+	}
+	if downsideDeviation > metricEpsilon {
+		metrics.sortino = excessReturn / downsideDeviation
+	}
+	if metrics.maxDrawdown > 0 {
+		metrics.calmar = metrics.annualizedReturn / metrics.maxDrawdown
+	}
+	return metrics, nil
+}
+
+// roundMetric 指标保留 4 位小数
+func roundMetric(value float64) float64 {
+	return math.Round(value*analyticsPrecision) / analyticsPrecision
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"errors"
+	"fund/model"
+	"math"
+	"testing"
+	"time"
+)
+
+// TestComputeRiskMetrics 使用手工构造的净值序列验证各项指标
+func TestComputeRiskMetrics(t *testing.T) {
+	points := []model.TrendPoint{
+		{Date: "2024-01-02", Value: 1.0},
+		{Date: "2024-01-03", Value: 1.2},
+		{Date: "2024-01-04", Value: 0.9},
+		{Date: "2024-01-05", Value: 1.08},
+		{Date: "2025-01-01", Value: 1.2},
+	}
+	metrics, err := computeRiskMetrics(points, 1.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	approx := func(name string, got, want float64) {
+		t.Helper()
+		if math.Abs(got-want) > 1e-6 {
+			t.Errorf("❌ %s = %.6f, 期望 %.6f", name, got, want)
+		}
+	}
+	annualized := 20.0 // 2024-01-02 至 2025-01-01 恰好 365 天
+	approx("累计收益率", metrics.totalReturn, 20)
+	approx("年化收益率", metrics.annualizedReturn, annualized)
+	approx("最大回撤", metrics.maxDrawdown, 25)
+	approx("胜率", metrics.winRate, 75)
+	approx("卡玛比率", metrics.calmar, annualized/25)
+	if metrics.drawdownPeak != "2024-01-03" || metrics.drawdownTrough != "2024-01-04" {
+		t.Errorf("❌ 最大回撤区间异常: %s ~ %s", metrics.drawdownPeak, metrics.drawdownTrough)
+	}
+	if metrics.volatility <= 0 || metrics.sharpe <= 0 || metrics.sortino <= metrics.sharpe {
+		t.Errorf("❌ 波动率/夏普/索提诺异常: %+v", metrics)
+	}
+
+	// 净值不变时波动率为 0，比率不应出现 NaN/Inf
+	flat := []model.TrendPoint{{Date: "2024-01-02", Value: 1}, {Date: "2024-01-03", Value: 1}}
+	metrics, err = computeRiskMetrics(flat, 1.5)
+	if err != nil || metrics.sharpe != 0 || metrics.calmar != 0 || math.IsNaN(metrics.sortino) {
+		t.Errorf("❌ 平稳序列指标异常: %+v %v", metrics, err)
+	}
+
+	if _, err := computeRiskMetrics(points[:1], 1.5); !errors.Is(err, ErrInsufficientData) {
+		t.Errorf("❌ 数据不足应返回 ErrInsufficientData: %v", err)
+	}
+}
+
+// TestGetFundAnalytics 测试基于模拟上游净值计算指标
+func TestGetFundAnalytics(t *testing.T) {
+	_, provider := newFakeUpstream(t)
+	fundService := NewFundServiceWithProvider(provider)
+	fundService.SetClock(func() time.Time {
+		return time.Date(2025, 7, 1, 10, 30, 0, 0, time.FixedZone("CST", 8*3600))
+	})
+
+	analytics, err := fundService.GetFundAnalytics("000001", TrendRange{Period: "all"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if analytics.Start != "2023-01-03" || analytics.End != "2025-06-30" || analytics.Days < 600 {
+		t.Errorf("❌ 区间异常: %+v", analytics)
+	}
+	if analytics.MaxDrawdown <= 0 || analytics.DrawdownPeak >= analytics.DrawdownTrough || analytics.Volatility <= 0 {
+		t.Errorf("❌ 指标异常: %+v", analytics)
+	}
+	if analytics.RiskFreeRate != defaultRiskFreeRate {
+		t.Errorf("❌ 无风险利率应为默认值: %v", analytics.RiskFreeRate)
+	}
+
+	if _, err := fundService.GetFundAnalytics("000001", TrendRange{Period: "since", Start: "2025-06-30"}); !errors.Is(err, ErrInsufficientData) {
+		t.Errorf("❌ 区间只有一个净值时应返回 ErrInsufficientData: %v", err)
+	}
+}
@@ -0,0 +1,236 @@
+package service
+
+import (
+	"fmt"
+	"fund/model"
+	"math"
+	"time"
+)
+
+// BacktestPlan 定投计划
+type BacktestPlan struct {
+	Start     string  // 开始日期 YYYY-MM-DD
+	End       string  // 结束日期 YYYY-MM-DD，为空表示今天
+	Amount    float64 // 每期投入金额（元，含手续费）
+	Frequency string  // 定投频率: weekly/biweekly/monthly
+	Day       int     // weekly/biweekly 为周几（1-5），monthly 为几号（1-31，超过当月天数时取月末）
+	FeeRate   float64 // 申购费率（%），按外扣法计算: 手续费 = 金额 - 金额/(1+费率)
+}
+
+// cashFlow 现金流（投入为负，赎回/期末市值为正）
+type cashFlow struct {
+	date   time.Time
+	amount float64
+}
+
+// Backtest 按定投计划回放历史净值，计算每期买入、市值曲线、内部收益率，并与一次性投入对比
+// 计划日期不是交易日时顺延到下一个交易日，同一交易日只买入一次；分红按除息日净值再投资
+func (s *FundService) Backtest(fundCode string, plan BacktestPlan) (*model.BacktestResult, error) {
+	history, err := s.fetchNAVHistory(fundCode)
+	if err != nil {
+		return nil, err
+	}
+
+	end := plan.End
+	if end == "" {
+		end = s.now().In(chinaZone).Format("2006-01-02")
+	}
+	points := filterByPeriod(history.Data, plan.Start, end)
+	schedule, err := backtestSchedule(plan, end)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.BacktestResult{
+		Code:      fundCode,
+		Name:      history.Name,
+		Start:     plan.Start,
+		Frequency: plan.Frequency,
+		Day:       plan.Day,
+		Amount:    plan.Amount,
+		FeeRate:   plan.FeeRate,
+		Purchases: []model.BacktestPurchase{},
+		Curve:     []model.BacktestPoint{},
+	}
+
+	var shares, cost, fee float64
+	var lumpUnits float64 // 首期一次性投入 1 元所得份额（含分红再投资）
+	var flows []cashFlow
+	next := 0
+	for _, point := range points {
+		// 除息日持有的份额获得分红并按当日净值再投资
+		shares = reinvestDividend(shares, point)
+		lumpUnits = reinvestDividend(lumpUnits, point)
+
+		// 计划日期已到（含顺延）时买入
+		due := false
+		for next < len(schedule) && schedule[next] <= point.Date {
+			next++
+			due = true
+		}
+		if due && point.Value > 0 {
+			purchaseFee := plan.Amount - plan.Amount/(1+plan.FeeRate/100)
+			purchaseShares := roundMoney((plan.Amount - purchaseFee) / point.Value)
+			purchase := model.BacktestPurchase{
+				Date:   point.Date,
+				NAV:    point.Value,
+				Amount: plan.Amount,
+				Fee:    roundMoney(purchaseFee),
+				Shares: purchaseShares,
+			}
+			result.Purchases = append(result.Purchases, purchase)
+			shares += purchaseShares
+			cost += plan.Amount
+			fee += purchase.Fee
+			if date, err := time.ParseInLocation("2006-01-02", point.Date, chinaZone); err == nil {
+				flows = append(flows, cashFlow{date: date, amount: -plan.Amount})
+			}
+			if len(result.Purchases) == 1 {
+				lumpUnits = 1 / (1 + plan.FeeRate/100) / point.Value
+			}
+		}
+
+		if cost > 0 {
+			value := shares * point.Value
+			result.Curve = append(result.Curve, model.BacktestPoint{
+				Date:   point.Date,
+				Cost:   roundMoney(cost),
+				Shares: roundMoney(shares),
+				Value:  roundMoney(value),
+				Return: roundMetric((value/cost - 1) * 100),
+			})
+		}
+	}
+	if len(result.Purchases) == 0 {
+		return nil, fmt.Errorf("%w: 回测区间内没有可买入的交易日", ErrInsufficientData)
+	}
+
+	last := points[len(points)-1]
+	lastDate, _ := time.ParseInLocation("2006-01-02", last.Date, chinaZone)
+	result.End = last.Date
+	result.TotalCost = roundMoney(cost)
+	result.TotalFee = roundMoney(fee)
+	result.Shares = roundMoney(shares)
+	result.MarketValue = roundMoney(shares * last.Value)
+	result.Profit = roundMoney(result.MarketValue - result.TotalCost)
+	result.ReturnRate = roundMetric(result.Profit / result.TotalCost * 100)
+	result.IRR = roundMetric(xirr(append(flows, cashFlow{date: lastDate, amount: shares * last.Value})))
+
+	// 相同总金额在首期一次性买入
+	first := result.Purchases[0]
+	firstDate, _ := time.ParseInLocation("2006-01-02", first.Date, chinaZone)
+	lumpValue := lumpUnits * cost * last.Value
+	result.LumpSum = model.LumpSumResult{
+		Date:        first.Date,
+		Cost:        result.TotalCost,
+		Shares:      roundMoney(lumpUnits * cost),
+		MarketValue: roundMoney(lumpValue),
+		Profit:      roundMoney(lumpValue - cost),
+		ReturnRate:  roundMetric((lumpValue/cost - 1) * 100),
+		IRR: roundMetric(xirr([]cashFlow{
+			{date: firstDate, amount: -cost},
+			{date: lastDate, amount: lumpValue},
+		})),
+	}
+	return result, nil
+}
+
+// backtestSchedule 生成 [plan.Start, end] 内的计划买入日期（升序）
+func backtestSchedule(plan BacktestPlan, end string) ([]string, error) {
+	start, err := time.ParseInLocation("2006-01-02", plan.Start, chinaZone)
+	if err != nil {
+		return nil, fmt.Errorf("开始日期格式错误: %v", err)
+	}
+	endTime, err := time.ParseInLocation("2006-01-02", end, chinaZone)
+	if err != nil {
+		return nil, fmt.Errorf("结束日期格式错误: %v", err)
+	}
+
+	var dates []string
+	switch plan.Frequency {
+	case "weekly", "biweekly":
+		step := 7
+		if plan.Frequency == "biweekly" {
+			step = 14
+		}
+		// 第一个指定星期几（time.Weekday 周日为 0）
+		offset := (plan.Day - int(start.Weekday()) + 7) % 7
+		for date := start.AddDate(0, 0, offset); !date.After(endTime); date = date.AddDate(0, 0, step) {
+			dates = append(dates, date.Format("2006-01-02"))
+		}
+	case "monthly":
+		for month := time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, chinaZone); !month.After(endTime); month = month.AddDate(0, 1, 0) {
+			day := plan.Day
+			if lastDay := month.AddDate(0, 1, -1).Day(); day > lastDay {
+				day = lastDay
+			}
+			date := time.Date(month.Year(), month.Month(), day, 0, 0, 0, 0, chinaZone)
+			if !date.Before(start) && !date.After(endTime) {
+				dates = append(dates, date.Format("2006-01-02"))
+			}
+		}
+	default:
+		return nil, fmt.Errorf("未知的定投频率: %s", plan.Frequency)
+	}
+	return dates, nil
+}
+
+// reinvestDividend 处理除息日的分红再投资和份额拆分，返回处理后的份额
+func reinvestDividend(shares float64, point model.TrendPoint) float64 {
+	if shares == 0 || point.Dividend == nil || point.Value <= 0 {
+		return shares
+	}
+	if point.Dividend.Split > 0 {
+		shares *= point.Dividend.Split
+	}
+	if point.Dividend.Cash > 0 {
+		shares += shares * point.Dividend.Cash / point.Value
+	}
+	return shares
+}
+
+// xirr 计算不定期现金流的年化内部收益率（%），无解时返回 0
+func xirr(flows []cashFlow) float64 {
+	if len(flows) < 2 {
+		return 0
+	}
+	origin := flows[0].date
+	npv := func(rate float64) float64 {
+		var sum float64
+		for _, flow := range flows {
+			years := flow.date.Sub(origin).Hours() / 24 / daysPerYear
+			sum += flow.amount / math.Pow(1+rate, years)
+		}
+		return sum
+	}
+
+	// 二分法求解 NPV(rate) = 0，投入在前时 NPV 随利率单调递减
+	// 持有期很短时年化收益率可能非常大，逐步扩大上界
+	low, high := -0.9999, 1.0
+	npvLow, npvHigh := npv(low), npv(high)
+	for npvLow*npvHigh > 0 && high < 1e9 {
+		high *= 10
+		npvHigh = npv(high)
+	}
+	if math.IsNaN(npvLow) || math.IsNaN(npvHigh) || npvLow*npvHigh > 0 {
+		return 0
+	}
+	for i := 0; i < 200; i++ {
+		mid := (low + high) / 2
+		npvMid := npv(mid)
+		if math.Abs(npvMid) < 1e-9 {
+			return mid * 100
+		}
+		if (npvMid > 0) == (npvLow > 0) {
+			low, npvLow = mid, npvMid
+		} else {
+			high = mid
+		}
+	}
+	return (low + high) / 2 * 100
+}
+
+// roundMoney 金额、份额保留 2 位小数
+func roundMoney(value float64) float64 {
+	return math.Round(value*100) / 100
+}
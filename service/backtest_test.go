@@ -0,0 +1,131 @@
+package service
+
+import (
+	"errors"
+	"fund/model"
+	"math"
+	"testing"
+	"time"
+)
+
+// TestBacktestSchedule 测试定投计划日期生成
+func TestBacktestSchedule(t *testing.T) {
+	cases := []struct {
+		plan BacktestPlan
+		end  string
+		want []string
+	}{
+		{BacktestPlan{Start: "2024-01-01", Frequency: "weekly", Day: 3}, "2024-01-20",
+			[]string{"2024-01-03", "2024-01-10", "2024-01-17"}},
+		{BacktestPlan{Start: "2024-01-04", Frequency: "biweekly", Day: 1}, "2024-02-06",
+			[]string{"2024-01-08", "2024-01-22", "2024-02-05"}},
+		{BacktestPlan{Start: "2024-01-15", Frequency: "monthly", Day: 31}, "2024-04-29",
+			[]string{"2024-01-31", "2024-02-29", "2024-03-31"}},
+		{BacktestPlan{Start: "2024-01-15", Frequency: "monthly", Day: 10}, "2024-03-09",
+			[]string{"2024-02-10"}},
+	}
+	for _, c := range cases {
+		got, err := backtestSchedule(c.plan, c.end)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != len(c.want) {
+			t.Errorf("❌ %+v 计划日期 %v, 期望 %v", c.plan, got, c.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != c.want[i] {
+				t.Errorf("❌ %+v 计划日期 %v, 期望 %v", c.plan, got, c.want)
+				break
+			}
+		}
+	}
+}
+
+// TestXIRR 测试内部收益率
+func TestXIRR(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, chinaZone)
+	irr := xirr([]cashFlow{{start, -100}, {start.AddDate(0, 0, 365), 110}})
+	if math.Abs(irr-10) > 1e-6 {
+		t.Errorf("❌ 一年赚 10%% 的内部收益率应为 10%%, 实际 %v", irr)
+	}
+	irr = xirr([]cashFlow{{start, -100}, {start.AddDate(0, 0, 182), -100}, {start.AddDate(0, 0, 365), 200}})
+	if math.Abs(irr) > 1e-6 {
+		t.Errorf("❌ 不赚不亏的内部收益率应为 0, 实际 %v", irr)
+	}
+}
+
+// TestBacktest 使用手工构造的净值测试买入、手续费、分红再投资和一次性投入对比
+func TestBacktest(t *testing.T) {
+	fundService := NewFundServiceWithProvider(NewEastmoneyProvider())
+	now := time.Date(2024, 1, 31, 21, 0, 0, 0, chinaZone)
+	fundService.SetClock(func() time.Time { return now })
+	fundService.navCache["000001"] = &navCacheEntry{
+		refreshedAt: now,
+		trend: &model.FundTrend{Code: "000001", Name: "测试基金", Data: []model.TrendPoint{
+			{Date: "2024-01-02", Value: 1.0},
+			{Date: "2024-01-03", Value: 1.0},
+			{Date: "2024-01-09", Value: 1.1},
+			{Date: "2024-01-10", Value: 1.0, Dividend: &model.Dividend{Cash: 0.1}}, // 除息日: 1.1 -> 1.0 + 0.1
+			{Date: "2024-01-17", Value: 1.25},
+		}},
+	}
+
+	result, err := fundService.Backtest("000001", BacktestPlan{
+		Start: "2024-01-01", Amount: 1000, Frequency: "weekly", Day: 3, FeeRate: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 每周三买入: 01-03 @1.0、01-10 @1.0、01-17 @1.25
+	if len(result.Purchases) != 3 || result.Purchases[1].Date != "2024-01-10" || result.Purchases[2].NAV != 1.25 {
+		t.Fatalf("❌ 买入记录异常: %+v", result.Purchases)
+	}
+	// 外扣法手续费: 1000 - 1000/1.01 = 9.90
+	if first := result.Purchases[0]; first.Fee != 9.9 || first.Shares != 990.1 {
+		t.Errorf("❌ 手续费/份额异常: %+v", first)
+	}
+	// 01-10 分红 0.1 元/份按 1.0 再投资: 990.1 × 1.1 = 1089.11 份，再买入 990.1 份，01-17 买入 792.08 份
+	wantShares := 990.1*1.1 + 990.1 + 792.08
+	if math.Abs(result.Shares-wantShares) > 0.01 || result.TotalCost != 3000 || result.TotalFee != 29.7 {
+		t.Errorf("❌ 持仓异常: shares=%v cost=%v fee=%v, 期望份额 %v", result.Shares, result.TotalCost, result.TotalFee, wantShares)
+	}
+	if result.End != "2024-01-17" || math.Abs(result.MarketValue-roundMoney(wantShares*1.25)) > 0.02 {
+		t.Errorf("❌ 期末市值异常: %+v", result)
+	}
+	if len(result.Curve) != 4 || result.Curve[0].Date != "2024-01-03" || result.IRR <= 0 {
+		t.Errorf("❌ 市值曲线/内部收益率异常: %+v irr=%v", result.Curve, result.IRR)
+	}
+
+	// 一次性投入: 3000 元在 01-03 买入 2970.3 份，分红后 3267.33 份
+	lump := result.LumpSum
+	if lump.Date != "2024-01-03" || math.Abs(lump.Shares-3267.33) > 0.01 || lump.MarketValue <= result.MarketValue {
+		t.Errorf("❌ 一次性投入对比异常: %+v", lump)
+	}
+
+	if _, err := fundService.Backtest("000001", BacktestPlan{
+		Start: "2024-01-18", Amount: 1000, Frequency: "monthly", Day: 20,
+	}); !errors.Is(err, ErrInsufficientData) {
+		t.Errorf("❌ 区间内没有交易日应返回 ErrInsufficientData: %v", err)
+	}
+}
+
+// TestBacktestUpstream 使用模拟上游的真实净值回测
+func TestBacktestUpstream(t *testing.T) {
+	_, provider := newFakeUpstream(t)
+	fundService := NewFundServiceWithProvider(provider)
+
+	result, err := fundService.Backtest("000001", BacktestPlan{
+		Start: "2024-01-01", End: "2025-06-30", Amount: 500, Frequency: "monthly", Day: 15, FeeRate: 0.15,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Purchases) != 18 || result.TotalCost != 9000 || result.LumpSum.Cost != 9000 {
+		t.Errorf("❌ 回测结果异常: purchases=%d cost=%v", len(result.Purchases), result.TotalCost)
+	}
+	if math.IsNaN(result.IRR) || result.Curve[len(result.Curve)-1].Value != result.MarketValue {
+		t.Errorf("❌ 回测指标异常: irr=%v value=%v", result.IRR, result.MarketValue)
+	}
+}
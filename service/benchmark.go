@@ -0,0 +1,132 @@
+package service
+
+import (
+	"fmt"
+	"fund/model"
+	"math"
+)
+
+// GetFundBenchmark 对比基金与业绩比较基准、同类平均在指定区间的表现
+// 曲线取自上游的累计收益率（通常只覆盖最近一年），按共同交易日对齐并以区间首日为 0%
+func (s *FundService) GetFundBenchmark(fundCode string, trendRange TrendRange) (*model.FundBenchmark, error) {
+	series, err := s.provider.FetchReturnSeries(fundCode)
+	if err != nil {
+		return nil, fmt.Errorf("获取基金 %s 累计收益率失败: %v", fundCode, err)
+	}
+
+	fund, peer, benchmark := -1, -1, -1
+	for i, item := range series {
+		switch {
+		case item.Role == "fund" && fund < 0:
+			fund = i
+		case item.Role == "peer" && peer < 0:
+			peer = i
+		case item.Role == "benchmark" && benchmark < 0:
+			benchmark = i
+		}
+	}
+	if fund < 0 || benchmark < 0 {
+		return nil, fmt.Errorf("基金 %s 没有业绩比较基准数据", fundCode)
+	}
+
+	// 按区间过滤后对齐（累计收益率换算为净值指数 1 + r/100）
+	start, end := s.trendWindow(trendRange)
+	selected := []int{fund, benchmark}
+	if peer >= 0 {
+		selected = append(selected, peer)
+	}
+	trends := make([]*model.FundTrend, len(selected))
+	for j, index := range selected {
+		trend := &model.FundTrend{}
+		for _, point := range series[index].Data {
+			trend.Data = append(trend.Data, model.TrendPoint{Date: point.Date, Value: 1 + point.Return/100})
+		}
+		trend.Data = filterByPeriod(trend.Data, start, end)
+		trends[j] = trend
+	}
+	dates, values := alignTrends(trends)
+	if len(dates) < 2 {
+		return nil, ErrInsufficientData
+	}
+
+	result := &model.FundBenchmark{
+		Code:      fundCode,
+		Name:      series[fund].Name,
+		Benchmark: series[benchmark].Name,
+		Period:    trendRange.Period,
+		Start:     dates[0],
+		End:       dates[len(dates)-1],
+		Series:    make([]model.ReturnSeries, len(selected)),
+	}
+	for j, index := range selected {
+		points := make([]model.ReturnPoint, len(dates))
+		for i, date := range dates {
+			points[i] = model.ReturnPoint{Date: date, Return: roundMetric((values[j][i]/values[j][0] - 1) * 100)}
+		}
+		result.Series[j] = model.ReturnSeries{Name: series[index].Name, Role: series[index].Role, Data: points}
+	}
+
+	fundReturns, benchmarkReturns := dailyReturns(values[0]), dailyReturns(values[1])
+	result.ExcessReturn = roundMetric(result.Series[0].Data[len(dates)-1].Return - result.Series[1].Data[len(dates)-1].Return)
+	metrics := computeRelativeMetrics(fundReturns, benchmarkReturns, s.riskFreeRate)
+	result.TrackingError = roundMetric(metrics.trackingError)
+	result.Beta = roundMetric(metrics.beta)
+	result.Alpha = roundMetric(metrics.alpha)
+	result.InformationRatio = roundMetric(metrics.informationRatio)
+	return result, nil
+}
+
+// relativeMetrics 相对基准的指标
+type relativeMetrics struct {
+	trackingError    float64 // 年化跟踪误差（%）
+	beta             float64 // 贝塔系数
+	alpha            float64 // 年化詹森阿尔法（%）
+	informationRatio float64 // 信息比率
+}
+
+// computeRelativeMetrics 根据等长的基金、基准日收益率计算相对指标，riskFreeRate 为年化无风险利率（%）
+func computeRelativeMetrics(fundReturns, benchmarkReturns []float64, riskFreeRate float64) relativeMetrics {
+	var metrics relativeMetrics
+	n := len(fundReturns)
+	if n == 0 || n != len(benchmarkReturns) {
+		return metrics
+	}
+
+	var meanFund, meanBenchmark float64
+	excess := make([]float64, n)
+	for i := 0; i < n; i++ {
+		meanFund += fundReturns[i]
+		meanBenchmark += benchmarkReturns[i]
+		excess[i] = fundReturns[i] - benchmarkReturns[i]
+	}
+	meanFund /= float64(n)
+	meanBenchmark /= float64(n)
+
+	var cov, varBenchmark float64
+	for i := 0; i < n; i++ {
+		cov += (fundReturns[i] - meanFund) * (benchmarkReturns[i] - meanBenchmark)
+		varBenchmark += (benchmarkReturns[i] - meanBenchmark) * (benchmarkReturns[i] - meanBenchmark)
+	}
+	if varBenchmark > metricEpsilon*metricEpsilon {
+		metrics.beta = cov / varBenchmark
+	}
+
+	// 詹森阿尔法: 基金超额收益 - β × 基准超额收益（日均值年化）
+	dailyRiskFree := riskFreeRate / 100 / tradingDaysPerYear
+	metrics.alpha = ((meanFund - dailyRiskFree) - metrics.beta*(meanBenchmark-dailyRiskFree)) * tradingDaysPerYear * 100
+
+	// 跟踪误差与信息比率
+	meanExcess := meanFund - meanBenchmark
+	var varExcess float64
+	for _, e := range excess {
+		varExcess += (e - meanExcess) * (e - meanExcess)
+	}
+	if n > 1 {
+		varExcess /= float64(n - 1)
+	}
+	metrics.trackingError = math.Sqrt(varExcess) * math.Sqrt(tradingDaysPerYear) * 100
+	if metrics.trackingError > metricEpsilon {
+		metrics.informationRatio = meanExcess * tradingDaysPerYear * 100 / metrics.trackingError
+	}
+	return metrics
+}
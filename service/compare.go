@@ -0,0 +1,150 @@
+package service
+
+import (
+	"fmt"
+	"fund/model"
+	"math"
+	"sync"
+)
+
+// CompareFunds 对比多只基金在指定区间的表现
+// 各基金的复权净值按共同交易日对齐，以第一个共同交易日为基准换算为累计收益率，并计算两两之间日收益率的相关系数
+func (s *FundService) CompareFunds(fundCodes []string, trendRange TrendRange) (*model.FundComparison, error) {
+	trendRange.Adjust = "dividend"
+
+	// 并发获取各基金走势
+	trends := make([]*model.FundTrend, len(fundCodes))
+	errs := make([]error, len(fundCodes))
+	var wg sync.WaitGroup
+	for i, code := range fundCodes {
+		wg.Add(1)
+		go func(i int, code string) {
+			defer wg.Done()
+			trends[i], errs[i] = s.GetFundTrendInRange(code, trendRange)
+		}(i, code)
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("获取基金 %s 走势失败: %v", fundCodes[i], err)
+		}
+	}
+
+	dates, values := alignTrends(trends)
+	if len(dates) < 2 {
+		return nil, ErrInsufficientData
+	}
+
+	comparison := &model.FundComparison{
+		Period:       trends[0].Period,
+		Start:        dates[0],
+		End:          dates[len(dates)-1],
+		Funds:        make([]model.ComparedFund, len(trends)),
+		Rows:         make([]model.ComparisonRow, len(dates)),
+		Correlations: []model.ReturnCorrelation{},
+	}
+	for i, date := range dates {
+		comparison.Rows[i] = model.ComparisonRow{Date: date, Returns: make([]float64, len(trends))}
+	}
+	for j, trend := range trends {
+		base := values[j][0]
+		for i := range dates {
+			comparison.Rows[i].Returns[j] = roundMetric((values[j][i]/base - 1) * 100)
+		}
+		comparison.Funds[j] = model.ComparedFund{
+			Code:        trend.Code,
+			Name:        trend.Name,
+			TotalReturn: comparison.Rows[len(dates)-1].Returns[j],
+		}
+	}
+
+	// 两两相关系数
+	returns := make([][]float64, len(trends))
+	for j := range trends {
+		returns[j] = dailyReturns(values[j])
+	}
+	for a := 0; a < len(trends); a++ {
+		for b := a + 1; b < len(trends); b++ {
+			comparison.Correlations = append(comparison.Correlations, model.ReturnCorrelation{
+				A:     trends[a].Code,
+				B:     trends[b].Code,
+				Value: roundMetric(correlation(returns[a], returns[b])),
+			})
+		}
+	}
+
+	return comparison, nil
+}
+
+// alignTrends 取各走势的共同交易日，返回升序日期和对应的净值（values[基金][日期]）
+// 净值不大于 0 的数据点视为缺失
+func alignTrends(trends []*model.FundTrend) ([]string, [][]float64) {
+	counts := make(map[string]int)
+	for _, trend := range trends {
+		for _, point := range trend.Data {
+			if point.Value > 0 {
+				counts[point.Date]++
+			}
+		}
+	}
+
+	// 走势数据按日期升序，以第一只基金的日期顺序为准
+	var dates []string
+	for _, point := range trends[0].Data {
+		if counts[point.Date] == len(trends) && point.Value > 0 {
+			dates = append(dates, point.Date)
+		}
+	}
+
+	values := make([][]float64, len(trends))
+	for j, trend := range trends {
+		byDate := make(map[string]float64, len(trend.Data))
+		for _, point := range trend.Data {
+			byDate[point.Date] = point.Value
+		}
+		values[j] = make([]float64, len(dates))
+		for i, date := range dates {
+			values[j][i] = byDate[date]
+		}
+	}
+	return dates, values
+}
+
+// dailyReturns 由净值序列计算日收益率
+func dailyReturns(values []float64) []float64 {
+	if len(values) < 2 {
+		return nil
+	}
+	returns := make([]float64, len(values)-1)
+	for i := 1; i < len(values); i++ {
+		returns[i-1] = values[i]/values[i-1] - 1
+	}
+	return returns
+}
+
+// correlation 计算两个等长序列的皮尔逊相关系数，任一序列方差为 0 时返回 0
+func correlation(a, b []float64) float64 {
+	n := len(a)
+	if n == 0 || n != len(b) {
+		return 0
+	}
+	var meanA, meanB float64
+	for i := 0; i < n; i++ {
+		meanA += a[i]
+		meanB += b[i]
+	}
+	meanA /= float64(n)
+	meanB /= float64(n)
+
+	var cov, varA, varB float64
+	for i := 0; i < n; i++ {
+		da, db := a[i]-meanA, b[i]-meanB
+		cov += da * db
+		varA += da * da
+		varB += db * db
+	}
+	if varA == 0 || varB == 0 {
+		return 0
+	}
+	return cov / math.Sqrt(varA*varB)
+}
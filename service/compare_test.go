@@ -0,0 +1,132 @@
+package service
+
+import (
+	"errors"
+	"fund/model"
+	"math"
+	"testing"
+	"time"
+)
+
+// TestAlignAndCorrelation 测试按共同交易日对齐和相关系数
+func TestAlignAndCorrelation(t *testing.T) {
+	a := &model.FundTrend{Code: "000001", Data: []model.TrendPoint{
+		{Date: "2025-06-25", Value: 1.0},
+		{Date: "2025-06-26", Value: 1.1},
+		{Date: "2025-06-27", Value: 1.21},
+		{Date: "2025-06-30", Value: 1.1},
+	}}
+	b := &model.FundTrend{Code: "110022", Data: []model.TrendPoint{
+		{Date: "2025-06-26", Value: 2.0},
+		{Date: "2025-06-27", Value: 2.2},
+		{Date: "2025-06-30", Value: 2.0},
+	}}
+	dates, values := alignTrends([]*model.FundTrend{a, b})
+	if len(dates) != 3 || dates[0] != "2025-06-26" || values[0][0] != 1.1 || values[1][2] != 2.0 {
+		t.Fatalf("❌ 对齐结果异常: %v %v", dates, values)
+	}
+
+	// 两只基金日收益率完全同步
+	if c := correlation(dailyReturns(values[0]), dailyReturns(values[1])); math.Abs(c-1) > 1e-9 {
+		t.Errorf("❌ 同步涨跌的相关系数应为 1: %v", c)
+	}
+	if c := correlation([]float64{0.01, -0.01}, []float64{-0.02, 0.02}); math.Abs(c+1) > 1e-9 {
+		t.Errorf("❌ 反向涨跌的相关系数应为 -1: %v", c)
+	}
+	if c := correlation([]float64{0, 0}, []float64{0.01, 0.02}); c != 0 {
+		t.Errorf("❌ 方差为 0 时相关系数应为 0: %v", c)
+	}
+}
+
+// TestCompareFunds 测试基于模拟上游的多基金对比
+func TestCompareFunds(t *testing.T) {
+	_, provider := newFakeUpstream(t)
+	fundService := NewFundServiceWithProvider(provider)
+	fundService.SetClock(func() time.Time {
+		return time.Date(2025, 7, 1, 10, 30, 0, 0, time.FixedZone("CST", 8*3600))
+	})
+
+	comparison, err := fundService.CompareFunds([]string{"000001", "110022"}, TrendRange{Period: "ytd"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(comparison.Funds) != 2 || len(comparison.Rows) < 100 || len(comparison.Correlations) != 1 {
+		t.Fatalf("❌ 对比结果异常: funds=%d rows=%d", len(comparison.Funds), len(comparison.Rows))
+	}
+	first, last := comparison.Rows[0], comparison.Rows[len(comparison.Rows)-1]
+	if first.Returns[0] != 0 || first.Returns[1] != 0 {
+		t.Errorf("❌ 区间首日收益率应为 0: %+v", first)
+	}
+	if last.Returns[1] != comparison.Funds[1].TotalReturn || comparison.Funds[1].Name != "易方达消费行业股票" {
+		t.Errorf("❌ 累计收益率异常: %+v %+v", last, comparison.Funds[1])
+	}
+	if c := comparison.Correlations[0]; c.A != "000001" || c.B != "110022" || c.Value < -1 || c.Value > 1 {
+		t.Errorf("❌ 相关系数异常: %+v", c)
+	}
+
+	if _, err := fundService.CompareFunds([]string{"000001", "999999"}, TrendRange{Period: "ytd"}); err == nil {
+		t.Error("❌ 未知基金应返回错误")
+	}
+	if _, err := fundService.CompareFunds([]string{"000001", "110022"}, TrendRange{Period: "since", Start: "2025-06-30"}); !errors.Is(err, ErrInsufficientData) {
+		t.Errorf("❌ 共同交易日不足应返回 ErrInsufficientData: %v", err)
+	}
+}
+
+// TestRelativeMetrics 测试贝塔、阿尔法、跟踪误差和信息比率
+func TestRelativeMetrics(t *testing.T) {
+	benchmark := []float64{0.01, -0.02, 0.015, 0.005, -0.01}
+
+	// 基金收益率 = 2 × 基准：β=2，无风险利率为 0 时 α=0
+	doubled := make([]float64, len(benchmark))
+	for i, r := range benchmark {
+		doubled[i] = 2 * r
+	}
+	metrics := computeRelativeMetrics(doubled, benchmark, 0)
+	if math.Abs(metrics.beta-2) > 1e-9 || math.Abs(metrics.alpha) > 1e-9 || metrics.trackingError <= 0 {
+		t.Errorf("❌ 杠杆基金指标异常: %+v", metrics)
+	}
+
+	// 基金每天固定跑赢基准 0.1%：β=1，跟踪误差为 0，α=25.2%
+	ahead := make([]float64, len(benchmark))
+	for i, r := range benchmark {
+		ahead[i] = r + 0.001
+	}
+	metrics = computeRelativeMetrics(ahead, benchmark, 1.5)
+	if math.Abs(metrics.beta-1) > 1e-9 || math.Abs(metrics.alpha-25.2) > 1e-6 ||
+		metrics.trackingError > 1e-9 || metrics.informationRatio != 0 {
+		t.Errorf("❌ 固定超额收益指标异常: %+v", metrics)
+	}
+}
+
+// TestGetFundBenchmark 测试基于模拟上游的基准对比
+func TestGetFundBenchmark(t *testing.T) {
+	_, provider := newFakeUpstream(t)
+	fundService := NewFundServiceWithProvider(provider)
+	fundService.SetClock(func() time.Time {
+		return time.Date(2025, 7, 1, 10, 30, 0, 0, time.FixedZone("CST", 8*3600))
+	})
+
+	result, err := fundService.GetFundBenchmark("000001", TrendRange{Period: "all"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Benchmark != "沪深300" || len(result.Series) != 3 ||
+		result.Series[0].Role != "fund" || result.Series[1].Role != "benchmark" || result.Series[2].Role != "peer" {
+		t.Fatalf("❌ 曲线异常: %+v", result.Series)
+	}
+	// 全部区间的累计收益率与上游一致（上游首日为 0%）
+	fundLast := result.Series[0].Data[len(result.Series[0].Data)-1]
+	benchmarkLast := result.Series[1].Data[len(result.Series[1].Data)-1]
+	if fundLast.Date != "2025-06-30" || math.Abs(fundLast.Return-11.15) > 1e-6 || math.Abs(benchmarkLast.Return-11.1) > 1e-6 {
+		t.Errorf("❌ 累计收益率异常: %+v %+v", fundLast, benchmarkLast)
+	}
+	if math.Abs(result.ExcessReturn-0.05) > 1e-6 || result.TrackingError <= 0 || result.Beta == 0 {
+		t.Errorf("❌ 超额收益指标异常: %+v", result)
+	}
+
+	// 截取区间后重新以首日为 0%
+	quarter, err := fundService.GetFundBenchmark("000001", TrendRange{Period: "quarter"})
+	if err != nil || quarter.Start < "2025-04-01" || quarter.Series[0].Data[0].Return != 0 {
+		t.Errorf("❌ 区间截取异常: %+v %v", quarter, err)
+	}
+}
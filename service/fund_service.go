@@ -1,35 +1,71 @@
 package service
 
 import (
-	"encoding/json"
 	"fmt"
 	"fund/model"
-	"io"
-	"net/http"
-	"regexp"
+	"fund/storage"
+	"log"
+	"math"
+	"sort"
+	"sync"
 	"time"
 )
 
 // FundService 基金服务
 type FundService struct {
-	httpClient *http.Client
+	provider     Provider                  // 上游数据源
+	fundStore    storage.FundStore         // 历史净值存储（可选）
+	navCache     map[string]*navCacheEntry // 历史净值缓存 key: fundCode
+	navLoading   map[string]*navCall       // 进行中的历史净值刷新 key: fundCode
+	navCacheSize int                       // 历史净值缓存的基金数量上限
+	navUses      uint64                    // 缓存访问计数，用于淘汰最久未使用的基金
+	navMutex     sync.Mutex                // 历史净值缓存锁
+	now          func() time.Time          // 时钟（可替换，便于测试）
+	riskFreeRate float64                   // 年化无风险利率（%），用于夏普、索提诺比率
 }
 
-// NewFundService 创建基金服务实例
+// NewFundService 创建基金服务实例（默认使用东方财富数据源）
 func NewFundService() *FundService {
+	return NewFundServiceWithProvider(NewEastmoneyProvider())
+}
+
+// NewFundServiceWithProvider 使用指定数据源创建基金服务实例
+func NewFundServiceWithProvider(provider Provider) *FundService {
 	return &FundService{
-		httpClient: &http.Client{
-			Timeout: 10 * time.Second,
-		},
+		provider:     provider,
+		navCache:     make(map[string]*navCacheEntry),
+		navLoading:   make(map[string]*navCall),
+		navCacheSize: defaultNAVCacheSize,
+		now:          time.Now,
+		riskFreeRate: defaultRiskFreeRate,
 	}
 }
 
+// SetClock 设置时钟，用于测试
+func (s *FundService) SetClock(now func() time.Time) {
+	s.now = now
+}
+
+// SetFundStore 设置历史净值存储，重启后无需重新下载全部历史净值
+func (s *FundService) SetFundStore(fundStore storage.FundStore) {
+	s.fundStore = fundStore
+}
+
+// Provider 获取当前使用的数据源
+func (s *FundService) Provider() Provider {
+	return s.provider
+}
+
 // GetFundDetail 获取基金详细信息
 func (s *FundService) GetFundDetail(fundCode string) (*model.FundDetail, error) {
 	// 获取基金详情
 	detailData, err := s.fetchFundDetail(fundCode)
 	if err != nil {
-		return nil, fmt.Errorf("获取基金详情失败: %v", err)
+		if detailData == nil {
+			return nil, fmt.Errorf("获取基金详情失败: %v", err)
+		}
+		// 部分字段解析失败，使用已解析的数据
+		log.Printf("⚠️  基金 %s 详情部分字段解析失败: %v", fundCode, err)
 	}
 
 	// 获取实时估值
@@ -68,339 +104,168 @@ func (s *FundService) GetFundDetail(fundCode string) (*model.FundDetail, error)
 	return fundDetail, nil
 }
 
-// fetchFundDetail 获取基金详情数据
-func (s *FundService) fetchFundDetail(fundCode string) (map[string]string, error) {
-	timestamp := time.Now().UnixNano() / 1e6
-	url := fmt.Sprintf("http://fund.eastmoney.com/pingzhongdata/%s.js?v=%d", fundCode, timestamp)
-
-	resp, err := s.httpClient.Get(url)
+// GetFundProfile 获取基金档案（持仓、资产配置、规模、基金经理、费率）
+func (s *FundService) GetFundProfile(fundCode string) (*model.FundProfile, error) {
+	profile, err := s.provider.FetchFundProfile(fundCode)
 	if err != nil {
-		return nil, err
+		if profile == nil {
+			return nil, fmt.Errorf("获取基金档案失败: %v", err)
+		}
+		// 部分字段解析失败，使用已解析的数据
+		log.Printf("⚠️  基金 %s 档案部分字段解析失败: %v", fundCode, err)
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
+	return profile, nil
+}
 
-	return s.parseFundDetailJS(string(body))
+// fetchFundDetail 获取基金详情数据
+func (s *FundService) fetchFundDetail(fundCode string) (map[string]string, error) {
+	return s.provider.FetchFundDetail(fundCode)
 }
 
 // fetchRealtimeData 获取实时估值数据
 func (s *FundService) fetchRealtimeData(fundCode string) (*model.RealtimeData, error) {
-	timestamp := time.Now().UnixNano() / 1e6
-	url := fmt.Sprintf("http://fundgz.1234567.com.cn/js/%s.js?rt=%d", fundCode, timestamp)
-
-	resp, err := s.httpClient.Get(url)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	return s.parseRealtimeJS(string(body))
+	return s.provider.FetchRealtimeEstimate(fundCode)
 }
 
-// parseFundDetailJS 解析东方财富基金详情JS
-func (s *FundService) parseFundDetailJS(jsContent string) (map[string]string, error) {
-	result := make(map[string]string)
-
-	// 提取基金名称
-	if name := s.extractPattern(jsContent, `var fS_name = "([^"]+)"`); name != "" {
-		result["name"] = name
-	}
-
-	// 提取基金代码
-	if code := s.extractPattern(jsContent, `var fS_code = "([^"]+)"`); code != "" {
-		result["code"] = code
-	}
-
-	// 提取增长率数据 (Data_netWorthTrend)
-	if trendMatch := s.extractPattern(jsContent, `var Data_netWorthTrend = (\[.*?\]);`); trendMatch != "" {
-		var trendData [][]interface{}
-		jsonStr := regexp.MustCompile(`'`).ReplaceAllString(trendMatch, `"`)
-		if err := json.Unmarshal([]byte(jsonStr), &trendData); err == nil && len(trendData) > 0 {
-			lastData := trendData[len(trendData)-1]
-			if len(lastData) >= 2 {
-				result["currentPrice"] = fmt.Sprintf("%.4f", lastData[1])
-			}
-		}
-	}
-
-	// 提取涨跌幅数据 (Data_rateInSimilarPersent)
-	if rateMatch := s.extractPattern(jsContent, `var Data_rateInSimilarPersent = \[(.*?)\];`); rateMatch != "" {
-		rates := regexp.MustCompile(`[",]`).Split(rateMatch, -1)
-		var cleanRates []string
-		for _, rate := range rates {
-			if rate != "" {
-				cleanRates = append(cleanRates, rate)
-			}
-		}
-		if len(cleanRates) >= 7 {
-			result["dayGrowth"] = cleanRates[0]
-			result["weekGrowth"] = cleanRates[1]
-			result["monthGrowth"] = cleanRates[2]
-			result["threeMonth"] = cleanRates[3]
-			result["sixMonth"] = cleanRates[4]
-			result["yearGrowth"] = cleanRates[5]
-		}
-	}
-
-	// 提取累计收益率 (Data_grandTotal)
-	if totalMatch := s.extractPattern(jsContent, `var Data_grandTotal = \[(.*?)\];`); totalMatch != "" {
-		var totalData [][]interface{}
-		jsonStr := regexp.MustCompile(`'`).ReplaceAllString(totalMatch, `"`)
-		if err := json.Unmarshal([]byte(jsonStr), &totalData); err == nil && len(totalData) > 0 {
-			lastData := totalData[len(totalData)-1]
-			if len(lastData) >= 2 {
-				result["totalGrowth"] = fmt.Sprintf("%.2f", lastData[1])
-			}
-		}
-	}
-
-	return result, nil
-}
-
-// parseRealtimeJS 解析实时估值JS
-func (s *FundService) parseRealtimeJS(jsContent string) (*model.RealtimeData, error) {
-	// 去除jsonpgz()包裹
-	re := regexp.MustCompile(`jsonpgz\((.*?)\);?$`)
-	matches := re.FindStringSubmatch(jsContent)
-	if len(matches) < 2 {
-		return nil, fmt.Errorf("无法解析实时数据")
-	}
-
-	var realtimeData model.RealtimeData
-	if err := json.Unmarshal([]byte(matches[1]), &realtimeData); err != nil {
-		return nil, err
-	}
-
-	return &realtimeData, nil
-}
-
-// extractPattern 提取正则匹配的第一个分组
-func (s *FundService) extractPattern(content, pattern string) string {
-	re := regexp.MustCompile(pattern)
-	matches := re.FindStringSubmatch(content)
-	if len(matches) > 1 {
-		return matches[1]
-	}
-	return ""
+// TrendRange 走势查询区间及复权方式
+// Period 为固定周期（week/month/quarter/half_year/year/three_years/all）时以 End（默认今天）为终点向前推算；
+// ytd 为 End 所在年份年初至 End；since 为 Start 至 End；custom 为 Start（默认最早）至 End
+type TrendRange struct {
+	Period string // 周期类型
+	Start  string // 开始日期 YYYY-MM-DD（含），仅 since/custom 使用
+	End    string // 结束日期 YYYY-MM-DD（含），为空表示今天
+	Adjust string // 复权方式: 空为单位净值，dividend 为分红再投资
 }
 
 // GetFundTrend 获取基金走势数据
 func (s *FundService) GetFundTrend(fundCode, period string) (*model.FundTrend, error) {
-	// 获取基金详情数据
-	timestamp := time.Now().UnixNano() / 1e6
-	url := fmt.Sprintf("http://fund.eastmoney.com/pingzhongdata/%s.js?v=%d", fundCode, timestamp)
-
-	resp, err := s.httpClient.Get(url)
-	if err != nil {
-		return nil, fmt.Errorf("获取基金数据失败: %v", err)
-	}
-	defer resp.Body.Close()
+	return s.GetFundTrendInRange(fundCode, TrendRange{Period: period})
+}
 
-	body, err := io.ReadAll(resp.Body)
+// GetFundTrendInRange 获取基金指定区间的走势数据
+func (s *FundService) GetFundTrendInRange(fundCode string, trendRange TrendRange) (*model.FundTrend, error) {
+	// 获取全部历史净值
+	history, err := s.fetchNAVHistory(fundCode)
 	if err != nil {
-		return nil, fmt.Errorf("读取基金数据失败: %v", err)
+		return nil, err
 	}
 
-	jsContent := string(body)
-
-	// 提取基金名称
-	fundName := s.extractPattern(jsContent, `var fS_name = "([^"]+)"`)
-
-	// 提取净值走势数据
-	trendData, err := s.extractNetWorthTrend(jsContent)
-	if err != nil {
-		return nil, fmt.Errorf("解析走势数据失败: %v", err)
+	// 根据区间过滤数据
+	start, end := s.trendWindow(trendRange)
+	filteredData := filterByPeriod(history.Data, start, end)
+	if trendRange.Adjust == "dividend" {
+		filteredData = adjustForDividends(filteredData)
 	}
 
-	// 根据周期类型过滤数据
-	filteredData := s.filterByPeriod(trendData, period)
-
 	return &model.FundTrend{
 		Code:   fundCode,
-		Name:   fundName,
-		Period: period,
+		Name:   history.Name,
+		Period: trendRange.Period,
+		Start:  start,
+		End:    end,
+		Adjust: trendRange.Adjust,
 		Data:   filteredData,
 	}, nil
 }
 
-// extractNetWorthTrend 提取净值走势数据
-func (s *FundService) extractNetWorthTrend(jsContent string) ([]model.TrendPoint, error) {
-	// 提取 Data_netWorthTrend 数组
-	pattern := `var Data_netWorthTrend = (\[.*?\]);`
-	trendMatch := s.extractPattern(jsContent, pattern)
-	if trendMatch == "" {
-		return nil, fmt.Errorf("未找到走势数据")
-	}
-
-	// 替换单引号为双引号
-	jsonStr := regexp.MustCompile(`'`).ReplaceAllString(trendMatch, `"`)
-
-	// 解析为 map 数组
-	var rawData []map[string]interface{}
-	if err := json.Unmarshal([]byte(jsonStr), &rawData); err != nil {
-		return nil, err
-	}
-
-	// 转换为 TrendPoint 数组
-	var result []model.TrendPoint
-	for _, item := range rawData {
-		timestamp, ok1 := item["x"].(float64)
-		value, ok2 := item["y"].(float64)
-
-		if !ok1 || !ok2 {
-			continue
+// trendWindow 计算查询区间的起止日期（YYYY-MM-DD，均包含），开始日期为空表示不限
+func (s *FundService) trendWindow(trendRange TrendRange) (string, string) {
+	endTime := s.now().In(chinaZone)
+	if trendRange.End != "" {
+		if t, err := time.ParseInLocation("2006-01-02", trendRange.End, chinaZone); err == nil {
+			endTime = t
 		}
-
-		// 转换时间戳为日期字符串
-		date := time.Unix(int64(timestamp)/1000, 0).Format("2006-01-02")
-
-		result = append(result, model.TrendPoint{
-			Date:  date,
-			Value: value,
-		})
-	}
-
-	return result, nil
-}
-
-// filterByPeriod 根据周期过滤数据
-func (s *FundService) filterByPeriod(data []model.TrendPoint, period string) []model.TrendPoint {
-	if len(data) == 0 {
-		return data
 	}
+	end := endTime.Format("2006-01-02")
 
-	now := time.Now()
 	var startTime time.Time
-
-	switch period {
+	switch trendRange.Period {
 	case "week":
 		// 最近一周
-		startTime = now.AddDate(0, 0, -7)
+		startTime = endTime.AddDate(0, 0, -7)
 	case "month":
 		// 最近一个月
-		startTime = now.AddDate(0, -1, 0)
+		startTime = endTime.AddDate(0, -1, 0)
 	case "quarter":
 		// 最近一个季度(3个月)
-		startTime = now.AddDate(0, -3, 0)
+		startTime = endTime.AddDate(0, -3, 0)
 	case "half_year":
 		// 最近半年
-		startTime = now.AddDate(0, -6, 0)
+		startTime = endTime.AddDate(0, -6, 0)
 	case "year":
 		// 最近一年
-		startTime = now.AddDate(-1, 0, 0)
+		startTime = endTime.AddDate(-1, 0, 0)
 	case "three_years":
 		// 最近三年
-		startTime = now.AddDate(-3, 0, 0)
+		startTime = endTime.AddDate(-3, 0, 0)
+	case "ytd":
+		// 今年以来
+		startTime = time.Date(endTime.Year(), time.January, 1, 0, 0, 0, 0, chinaZone)
 	case "all":
 		// 全部数据
-		return data
+		return "", end
+	case "since", "custom":
+		// 指定开始日期
+		return trendRange.Start, end
 	default:
 		// 默认返回最近一个月
-		startTime = now.AddDate(0, -1, 0)
+		startTime = endTime.AddDate(0, -1, 0)
 	}
+	return startTime.Format("2006-01-02"), end
+}
 
-	// 过滤数据
-	var result []model.TrendPoint
-	for _, point := range data {
-		pointTime, err := time.Parse("2006-01-02", point.Date)
-		if err != nil {
-			continue
-		}
-		if pointTime.After(startTime) || pointTime.Equal(startTime) {
-			result = append(result, point)
+// filterByPeriod 过滤出 [start, end] 区间内的数据（data 按日期升序，start 为空表示不限）
+func filterByPeriod(data []model.TrendPoint, start, end string) []model.TrendPoint {
+	from := sort.Search(len(data), func(i int) bool {
+		return data[i].Date >= start
+	})
+	to := sort.Search(len(data), func(i int) bool {
+		return data[i].Date > end
+	})
+	if from >= to {
+		return []model.TrendPoint{}
+	}
+	return data[from:to]
+}
+
+// adjustForDividends 计算分红再投资的复权净值（以区间第一天的单位净值为基准）
+// 返回新的切片，不修改缓存中的数据
+func adjustForDividends(data []model.TrendPoint) []model.TrendPoint {
+	result := make([]model.TrendPoint, len(data))
+	copy(result, data)
+	if len(data) == 0 {
+		return result
+	}
+	adjusted := data[0].Value
+	for i := 1; i < len(result); i++ {
+		prev, point := data[i-1], data[i]
+		growth := 1 + point.DailyReturn/100
+		if prev.Value > 0 {
+			growth = point.Value / prev.Value
+			if dividend := point.Dividend; dividend != nil {
+				switch {
+				case dividend.Cash > 0 || dividend.Split > 0:
+					// 分红当日净值除息，现金按当日净值再投资；拆分后份额按比例增加
+					split := dividend.Split
+					if split <= 0 {
+						split = 1
+					}
+					growth = (point.Value + dividend.Cash) * split / prev.Value
+				default:
+					// 无法识别的分红描述，使用上游已计入分红的日增长率
+					growth = 1 + point.DailyReturn/100
+				}
+			}
 		}
+		adjusted *= growth
+		result[i].Value = math.Round(adjusted*1e4) / 1e4
 	}
-
 	return result
 }
 
 // FetchBatchFundsForRealtime 批量获取基金实时数据（用于实时数据服务）
 // 返回 map[基金代码] = {净值, 涨跌幅, 更新时间}
 func (s *FundService) FetchBatchFundsForRealtime(page, pageSize int) (map[string]map[string]interface{}, error) {
-	timestamp := time.Now().UnixNano() / 1e6
-	// 东方财富批量基金接口
-	url := fmt.Sprintf("https://fund.eastmoney.com/Data/Fund_JJJZ_Data.aspx?t=10&lx=1&letter=&gsid=&text=&sort=rzdf,desc&page=%d,%d&dt=%d&atfc=&onlySale=0&isLatest=0&_=%d",
-		page, pageSize, timestamp, timestamp)
-
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("创建请求失败: %v", err)
-	}
-
-	// 设置请求头，模拟浏览器
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36")
-	req.Header.Set("Referer", "https://fund.eastmoney.com/data/fundranking.html")
-
-	resp, err := s.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("请求失败: %v", err)
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("读取响应失败: %v", err)
-	}
-
-	// 解析响应数据
-	return s.parseBatchFundsForRealtime(string(body))
-}
-
-// parseBatchFundsForRealtime 解析批量基金响应为实时数据格式
-func (s *FundService) parseBatchFundsForRealtime(content string) (map[string]map[string]interface{}, error) {
-	result := make(map[string]map[string]interface{})
-
-	// 提取基金数据数组
-	dataRe := regexp.MustCompile(`datas:\[(.*?)\],count`)
-	dataMatches := dataRe.FindStringSubmatch(content)
-	if len(dataMatches) < 2 {
-		return nil, fmt.Errorf("未找到基金数据")
-	}
-
-	dataStr := dataMatches[1]
-
-	// 按记录分割（每条记录用 "],["分隔）
-	recordRe := regexp.MustCompile(`\],\[`)
-	records := recordRe.Split(dataStr, -1)
-
-	for _, record := range records {
-		// 清理首尾的括号和引号
-		record = regexp.MustCompile(`^\["|"\]$`).ReplaceAllString(record, "")
-
-		// 按 "," 分割字段
-		fields := regexp.MustCompile(`","`).Split(record, -1)
-
-		// 至少要有基本字段
-		if len(fields) < 7 {
-			continue
-		}
-
-		// 清理首尾引号
-		code := regexp.MustCompile(`^"|"$`).ReplaceAllString(fields[0], "")
-		name := regexp.MustCompile(`^"|"$`).ReplaceAllString(fields[1], "")
-		netValue := regexp.MustCompile(`^"|"$`).ReplaceAllString(fields[4], "")
-		dayGrowth := regexp.MustCompile(`^"|"$`).ReplaceAllString(fields[6], "")
-		updateDate := ""
-		if len(fields) > 16 {
-			updateDate = fields[16]
-		}
-
-		result[code] = map[string]interface{}{
-			"name":       name,
-			"netValue":   netValue,
-			"dayGrowth":  dayGrowth,
-			"updateDate": updateDate,
-		}
-	}
-
-	return result, nil
+	return s.provider.FetchBatchQuotes(page, pageSize)
 }
@@ -1,13 +1,45 @@
 package service
 
 import (
+	"fund/internal/fakeupstream"
+	"fund/model"
+	"fund/storage"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 )
 
+// tradingClock 固定在交易时间内的时钟（2025-07-01 周二 10:30）
+func tradingClock() time.Time {
+	return time.Date(2025, 7, 1, 10, 30, 0, 0, time.FixedZone("CST", 8*3600))
+}
+
+// newFakeUpstream 启动模拟上游并创建指向它的数据源
+func newFakeUpstream(t *testing.T) (*fakeupstream.Server, *EastmoneyProvider) {
+	t.Helper()
+	upstream := fakeupstream.New()
+	t.Cleanup(upstream.Close)
+	return upstream, NewEastmoneyProviderWithBaseURL(upstream.URL)
+}
+
+// newTestIntradayService 创建使用模拟上游、临时数据目录的日内服务
+func newTestIntradayService(t *testing.T, provider Provider) *IntradayService {
+	t.Helper()
+	dir := t.TempDir()
+	intradayService := NewIntradayServiceWithProvider(provider)
+	intradayService.SetDataDir(filepath.Join(dir, "data"))
+	intradayService.SetConfigFile(filepath.Join(dir, "watch_funds.json"))
+	intradayService.SetClock(tradingClock)
+	return intradayService
+}
+
 // TestFetchBatchFundsForRealtime 测试批量获取基金实时数据
 func TestFetchBatchFundsForRealtime(t *testing.T) {
-	service := NewFundService()
+	_, provider := newFakeUpstream(t)
+	service := NewFundServiceWithProvider(provider)
 
 	// 获取第一页数据
 	data, err := service.FetchBatchFundsForRealtime(1, 200)
@@ -68,7 +100,8 @@ func TestBatchFetchAndMemoryWrite(t *testing.T) {
 	t.Log("🧪 开始压力测试：每500ms获取一次，共10次")
 	t.Log("=" + "==============================================")
 
-	intradayService := NewIntradayService()
+	_, provider := newFakeUpstream(t)
+	intradayService := newTestIntradayService(t, provider)
 
 	// 获取次数
 	rounds := 10
@@ -113,3 +146,442 @@ func TestBatchFetchAndMemoryWrite(t *testing.T) {
 		t.Error("❌ 内存中没有数据")
 	}
 }
+
+// TestGetFundDetailAndTrend 测试基金详情和走势接口
+func TestGetFundDetailAndTrend(t *testing.T) {
+	_, provider := newFakeUpstream(t)
+	service := NewFundServiceWithProvider(provider)
+
+	detail, err := service.GetFundDetail("000001")
+	if err != nil {
+		t.Fatalf("❌ 获取基金详情失败: %v", err)
+	}
+	if detail.Name != "华夏成长混合" || detail.EstimatePrice != "1.1166" || detail.CurrentPrice != "1.1112" {
+		t.Errorf("❌ 基金详情不符: %+v", detail)
+	}
+
+	trend, err := service.GetFundTrend("110022", "all")
+	if err != nil {
+		t.Fatalf("❌ 获取走势失败: %v", err)
+	}
+	if trend.Name != "易方达消费行业股票" || len(trend.Data) == 0 {
+		t.Fatalf("❌ 走势数据不符: name=%s, points=%d", trend.Name, len(trend.Data))
+	}
+
+	if _, err := service.GetFundTrend("999999", "all"); err == nil {
+		t.Error("❌ 未知基金应返回错误")
+	}
+}
+
+// TestUpstreamFaults 测试上游故障时的错误返回
+func TestUpstreamFaults(t *testing.T) {
+	upstream, provider := newFakeUpstream(t)
+	service := NewFundServiceWithProvider(provider)
+
+	upstream.SetFault(fakeupstream.RouteBatch, fakeupstream.FaultServerError)
+	if _, err := service.FetchBatchFundsForRealtime(1, 200); err == nil {
+		t.Error("❌ 批量接口返回5xx时应报错")
+	}
+
+	upstream.SetFault(fakeupstream.RouteEstimate, fakeupstream.FaultMalformed)
+	if _, err := service.GetFundDetail("000001"); err == nil {
+		t.Error("❌ 实时估值响应损坏时应报错")
+	}
+
+	upstream.SetFault(fakeupstream.RouteEstimate, fakeupstream.FaultNone)
+	upstream.SetLatency(50 * time.Millisecond)
+	if _, err := service.GetFundDetail("000001"); err != nil {
+		t.Errorf("❌ 上游有延迟时应正常返回: %v", err)
+	}
+}
+
+// countingStore 记录 Append 调用的日内数据存储
+type countingStore struct {
+	storage.IntradayStore
+	dataMutex *sync.RWMutex // 日内服务的数据锁
+	appends   int           // Append 调用次数
+	records   int           // 写入的数据点数量
+	locked    bool          // 写入时数据锁是否被持有
+}
+
+// Append 记录调用次数和写入时的数据锁状态
+func (c *countingStore) Append(records ...storage.Record) error {
+	c.appends++
+	c.records += len(records)
+	if c.dataMutex.TryLock() {
+		c.dataMutex.Unlock()
+	} else {
+		c.locked = true
+	}
+	return nil
+}
+
+// TestBatchAppend 测试批量采集的一页数据在释放数据锁后一次写入存储
+func TestBatchAppend(t *testing.T) {
+	_, provider := newFakeUpstream(t)
+	intradayService := newTestIntradayService(t, provider)
+	store := &countingStore{dataMutex: &intradayService.dataMutex}
+	intradayService.SetStore(store)
+
+	intradayService.processBatchFundsData(map[string]map[string]interface{}{
+		"000001": {"name": "华夏成长混合", "netValue": "1.1162", "dayGrowth": "0.45"},
+		"110022": {"name": "易方达消费行业股票", "netValue": "3.2100", "dayGrowth": "-0.31"},
+		"161725": {"name": "招商中证白酒指数(LOF)A", "netValue": "---", "dayGrowth": "---"},
+	}, "2025-07-01", "10:30")
+
+	if store.appends != 1 || store.records != 2 || store.locked {
+		t.Errorf("❌ 一页数据应在释放锁后一次写入: 调用 %d 次, %d 个数据点, 持有锁 %v", store.appends, store.records, store.locked)
+	}
+}
+
+// TestIntradayArchive 测试日内数据按交易日归档、按日期查询和过期清理
+func TestIntradayArchive(t *testing.T) {
+	_, provider := newFakeUpstream(t)
+	intradayService := newTestIntradayService(t, provider)
+	intradayService.SetArchiveRetention(30)
+
+	zone := time.FixedZone("CST", 8*3600)
+	now := time.Date(2025, 7, 1, 10, 30, 0, 0, zone)
+	intradayService.SetClock(func() time.Time { return now })
+
+	write := func(date, hhmm string, value float64) {
+		intradayService.dataMutex.Lock()
+		event := intradayService.upsertPointLocked("000001", "华夏成长混合", date, model.IntradayPoint{Time: hhmm, Value: value})
+		intradayService.dataMutex.Unlock()
+		intradayService.appendPoints(event)
+	}
+
+	// 过期归档应被清理
+	expired := filepath.Join(intradayService.archiveDir(), "2025-05-01")
+	if err := os.MkdirAll(expired, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	// 收盘后保存时归档当天数据
+	write("2025-07-01", "10:30", 1.11)
+	write("2025-07-01", "14:59", 1.12)
+	now = time.Date(2025, 7, 1, 16, 5, 0, 0, zone)
+	if err := intradayService.SaveToDisk(); err != nil {
+		t.Fatalf("❌ 保存失败: %v", err)
+	}
+	if _, err := os.Stat(expired); !os.IsNotExist(err) {
+		t.Errorf("❌ 过期归档未被清理: %v", err)
+	}
+
+	// 收盘归档之后又采集到的数据同样写入归档
+	write("2025-07-01", "15:30", 1.13)
+	now = time.Date(2025, 7, 2, 9, 30, 0, 0, zone)
+	write("2025-07-02", "09:30", 1.2)
+	if err := intradayService.SaveToDisk(); err != nil {
+		t.Fatalf("❌ 保存失败: %v", err)
+	}
+
+	dates, err := intradayService.ArchiveDates()
+	if err != nil || len(dates) != 2 || dates[0] != "2025-07-01" || dates[1] != "2025-07-02" {
+		t.Fatalf("❌ 归档日期异常: %v %v", dates, err)
+	}
+
+	history, err := intradayService.GetIntradayDataByDate("000001", "2025-07-01")
+	if err != nil {
+		t.Fatalf("❌ 查询归档失败: %v", err)
+	}
+	if history.Date != "2025-07-01" || len(history.Data) != 3 {
+		t.Errorf("❌ 归档数据异常: %+v", history)
+	}
+
+	current, err := intradayService.GetIntradayDataByDate("000001", "2025-07-02")
+	if err != nil || len(current.Data) != 1 || current.Data[0].Value != 1.2 {
+		t.Errorf("❌ 当日数据异常: %+v %v", current, err)
+	}
+
+	if _, err := intradayService.GetIntradayDataByDate("000001", "2025-06-30"); err == nil {
+		t.Error("❌ 不存在的日期应返回错误")
+	}
+
+	// 重启后从存储恢复最近一个交易日的数据
+	if err := intradayService.store.Close(); err != nil {
+		t.Fatal(err)
+	}
+	restarted := newTestIntradayService(t, provider)
+	restarted.SetDataDir(intradayService.dataDir)
+	if err := restarted.LoadFromDisk(); err != nil {
+		t.Fatalf("❌ 加载失败: %v", err)
+	}
+	recovered, err := restarted.GetIntradayData("000001")
+	if err != nil || recovered.Date != "2025-07-02" || len(recovered.Data) != 1 {
+		t.Errorf("❌ 重启后恢复数据异常: %+v %v", recovered, err)
+	}
+}
+
+// TestSQLiteFundStore 测试上游不可用时使用 SQLite 中保存的基金列表和历史净值
+func TestSQLiteFundStore(t *testing.T) {
+	upstream, provider := newFakeUpstream(t)
+	store, err := storage.OpenSQLite(filepath.Join(t.TempDir(), "fund.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	fundService := NewFundServiceWithProvider(provider)
+	fundService.SetFundStore(store)
+	intradayService := newTestIntradayService(t, provider)
+	intradayService.SetFundStore(store)
+	intradayService.SetStore(store)
+	intradayService.SetClock(time.Now) // 基金列表更新时间为实际时间
+
+	// 首次启动从上游获取并保存
+	if err := intradayService.LoadAllFunds(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := fundService.GetFundTrend("110022", "all"); err != nil {
+		t.Fatal(err)
+	}
+
+	// 当天重启不再请求上游
+	hits := upstream.Hits(fakeupstream.RouteFundList)
+	if err := intradayService.LoadAllFunds(); err != nil || upstream.Hits(fakeupstream.RouteFundList) != hits {
+		t.Errorf("❌ 当天已保存的基金列表应直接使用: %v", err)
+	}
+
+	// 次日上游故障时使用已保存的数据
+	intradayService.SetClock(func() time.Time { return time.Now().AddDate(0, 0, 1) })
+	upstream.SetFault(fakeupstream.RouteFundList, fakeupstream.FaultServerError)
+	upstream.SetFault(fakeupstream.RoutePingzhongData, fakeupstream.FaultServerError)
+
+	if err := intradayService.LoadAllFunds(); err != nil || len(intradayService.fundList) != 5 {
+		t.Errorf("❌ 上游故障时应使用已保存的基金列表: %d %v", len(intradayService.fundList), err)
+	}
+	trend, err := fundService.GetFundTrend("110022", "all")
+	if err != nil || len(trend.Data) == 0 || trend.Name != "易方达消费行业股票" {
+		t.Errorf("❌ 上游故障时应使用已保存的历史净值: %v", err)
+	}
+
+	// 日内数据写入 SQLite
+	intradayService.processBatchFundsData(map[string]map[string]interface{}{
+		"000001": {"name": "华夏成长混合", "netValue": "1.1162", "dayGrowth": "0.45"},
+	}, "2025-07-01", "10:30")
+	if fundData, err := store.Day("000001", "2025-07-01"); err != nil || fundData == nil || fundData.Data[0].Value != 1.1162 {
+		t.Errorf("❌ 日内数据未写入 SQLite: %+v %v", fundData, err)
+	}
+}
+
+// TestNAVCache 测试历史净值缓存：当天只下载一次、重启后从磁盘加载、次日增量刷新、上游故障时使用缓存
+func TestNAVCache(t *testing.T) {
+	upstream, provider := newFakeUpstream(t)
+	zone := time.FixedZone("CST", 8*3600)
+	dir := t.TempDir()
+
+	full, err := provider.FetchNAVHistory("000001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	baseline := upstream.Hits(fakeupstream.RoutePingzhongData)
+
+	// 磁盘上已有截至 2025-06-20 的历史净值
+	fundStore := storage.NewFileFundStore(dir)
+	var stale []model.TrendPoint
+	for _, point := range full.Data {
+		if point.Date <= "2025-06-20" {
+			stale = append(stale, point)
+		}
+	}
+	savedAt := time.Date(2025, 6, 20, 21, 0, 0, 0, zone)
+	if err := fundStore.SaveNAVHistory(&model.FundTrend{Code: "000001", Name: full.Name, Data: stale}, savedAt); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Date(2025, 6, 30, 21, 0, 0, 0, zone)
+	newService := func() *FundService {
+		fundService := NewFundServiceWithProvider(provider)
+		fundService.SetFundStore(fundStore)
+		fundService.SetClock(func() time.Time { return now })
+		return fundService
+	}
+	fundService := newService()
+
+	// 缓存过期，增量下载新增净值
+	trend, err := fundService.GetFundTrend("000001", "all")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(trend.Data) != len(full.Data) || trend.Data[len(trend.Data)-1] != full.Data[len(full.Data)-1] {
+		t.Errorf("❌ 增量刷新后数据不完整: %d vs %d", len(trend.Data), len(full.Data))
+	}
+	if upstream.Hits(fakeupstream.RoutePingzhongData) != baseline || upstream.Hits(fakeupstream.RouteNAVHistory) != 1 {
+		t.Errorf("❌ 应只请求增量接口: pingzhongdata %d, lsjz %d",
+			upstream.Hits(fakeupstream.RoutePingzhongData)-baseline, upstream.Hits(fakeupstream.RouteNAVHistory))
+	}
+
+	// 当天再次请求、重启后请求都不访问上游
+	fundService.GetFundTrend("000001", "week")
+	if _, err := newService().GetFundTrend("000001", "all"); err != nil {
+		t.Fatal(err)
+	}
+	if upstream.Hits(fakeupstream.RouteNAVHistory) != 1 {
+		t.Errorf("❌ 缓存未过期时不应访问上游: lsjz %d", upstream.Hits(fakeupstream.RouteNAVHistory))
+	}
+
+	// 次日净值公布后上游故障，返回缓存数据，重试间隔内不再请求
+	now = time.Date(2025, 7, 1, 20, 30, 0, 0, zone)
+	upstream.SetFault(fakeupstream.RouteNAVHistory, fakeupstream.FaultServerError)
+	for i := 0; i < 3; i++ {
+		trend, err := fundService.GetFundTrend("000001", "all")
+		if err != nil || len(trend.Data) != len(full.Data) {
+			t.Fatalf("❌ 上游故障时应返回缓存数据: %v", err)
+		}
+	}
+	if hits := upstream.Hits(fakeupstream.RouteNAVHistory); hits != 2 {
+		t.Errorf("❌ 重试间隔内不应重复请求: lsjz %d", hits)
+	}
+
+	// 上游恢复但尚未公布 07-01 净值，刷新成功也不视为最新，重试间隔后再刷新（重启后同样）
+	upstream.SetFault(fakeupstream.RouteNAVHistory, fakeupstream.FaultNone)
+	now = now.Add(navRetryInterval)
+	for i := 0; i < 3; i++ {
+		if _, err := fundService.GetFundTrend("000001", "all"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if hits := upstream.Hits(fakeupstream.RouteNAVHistory); hits != 3 {
+		t.Errorf("❌ 重试间隔内不应重复请求: lsjz %d", hits)
+	}
+	if _, err := newService().GetFundTrend("000001", "all"); err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(navRetryInterval)
+	fundService.GetFundTrend("000001", "all")
+	if hits := upstream.Hits(fakeupstream.RouteNAVHistory); hits != 5 {
+		t.Errorf("❌ 尚未取得最新净值时应在重试间隔后再次刷新: lsjz %d", hits)
+	}
+
+	// 没有缓存的基金全量下载
+	if _, err := fundService.GetFundTrend("110022", "all"); err != nil {
+		t.Fatal(err)
+	}
+	if upstream.Hits(fakeupstream.RoutePingzhongData) != baseline+1 {
+		t.Error("❌ 无缓存时应全量下载")
+	}
+
+	// 同一基金并发的缓存未命中只下载一次
+	coldService := NewFundServiceWithProvider(provider)
+	coldService.SetClock(func() time.Time { return now })
+	upstream.SetLatency(50 * time.Millisecond)
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := coldService.GetFundTrend("110022", "all"); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	upstream.SetLatency(0)
+	if hits := upstream.Hits(fakeupstream.RoutePingzhongData); hits != baseline+2 {
+		t.Errorf("❌ 并发请求应只下载一次: pingzhongdata %d", hits-baseline-1)
+	}
+
+	// 超出缓存上限时淘汰最久未使用的基金
+	coldService.navCacheSize = 1
+	if _, err := coldService.GetFundTrend("000001", "all"); err != nil {
+		t.Fatal(err)
+	}
+	coldService.navMutex.Lock()
+	_, kept := coldService.navCache["110022"]
+	cached := len(coldService.navCache)
+	coldService.navMutex.Unlock()
+	if cached != 1 || kept {
+		t.Errorf("❌ 缓存应淘汰最久未使用的 110022: %d 只", cached)
+	}
+}
+
+// TestFundTrendRange 测试走势查询区间：固定周期、今年以来、指定开始日期、自定义区间
+func TestFundTrendRange(t *testing.T) {
+	_, provider := newFakeUpstream(t)
+	fundService := NewFundServiceWithProvider(provider)
+	fundService.SetClock(func() time.Time {
+		return time.Date(2025, 7, 1, 10, 30, 0, 0, time.FixedZone("CST", 8*3600))
+	})
+
+	cases := []struct {
+		trendRange  TrendRange
+		first, last string
+	}{
+		{TrendRange{Period: "week"}, "2025-06-24", "2025-06-30"},
+		{TrendRange{Period: "ytd"}, "2025-01-01", "2025-06-30"},
+		{TrendRange{Period: "ytd", End: "2024-01-10"}, "2024-01-01", "2024-01-10"},
+		{TrendRange{Period: "month", End: "2025-01-10"}, "2024-12-10", "2025-01-10"},
+		{TrendRange{Period: "since", Start: "2025-06-28"}, "2025-06-30", "2025-06-30"},
+		{TrendRange{Period: "custom", Start: "2024-12-28", End: "2025-01-05"}, "2024-12-30", "2025-01-03"},
+		{TrendRange{Period: "custom", End: "2023-01-04"}, "2023-01-03", "2023-01-04"},
+		{TrendRange{Period: "all"}, "2023-01-03", "2025-06-30"},
+	}
+	for _, c := range cases {
+		trend, err := fundService.GetFundTrendInRange("110022", c.trendRange)
+		if err != nil {
+			t.Fatalf("❌ 获取走势失败 %+v: %v", c.trendRange, err)
+		}
+		if len(trend.Data) == 0 || trend.Data[0].Date < c.first || trend.Data[len(trend.Data)-1].Date != c.last {
+			t.Errorf("❌ 区间 %+v 数据范围异常: %d 条 %v", c.trendRange, len(trend.Data), trend.Data)
+			continue
+		}
+		if trend.Data[0].Date > c.first && c.trendRange.Period != "week" && c.trendRange.Period != "month" {
+			t.Errorf("❌ 区间 %+v 起点应为 %s, 实际 %s", c.trendRange, c.first, trend.Data[0].Date)
+		}
+	}
+
+	// 区间内没有数据时返回空数组
+	trend, err := fundService.GetFundTrendInRange("110022", TrendRange{Period: "since", Start: "2025-07-01"})
+	if err != nil || trend.Data == nil || len(trend.Data) != 0 {
+		t.Errorf("❌ 空区间应返回空数组: %+v %v", trend, err)
+	}
+}
+
+// TestDividendAdjustedTrend 测试累计净值、分红事件解析和分红再投资复权净值
+func TestDividendAdjustedTrend(t *testing.T) {
+	_, provider := newFakeUpstream(t)
+	fundService := NewFundServiceWithProvider(provider)
+	trendRange := TrendRange{Period: "custom", Start: "2024-01-17", End: "2024-01-19"}
+
+	raw, err := fundService.GetFundTrendInRange("000001", trendRange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(raw.Data) != 3 || raw.Data[0].AccValue == 0 {
+		t.Fatalf("❌ 走势数据异常: %+v", raw.Data)
+	}
+	dividend := raw.Data[1].Dividend
+	if dividend == nil || dividend.Cash != 0.05 || dividend.Text != "每份派现金0.0500元" {
+		t.Fatalf("❌ 分红事件解析异常: %+v", dividend)
+	}
+
+	trendRange.Adjust = "dividend"
+	adjusted, err := fundService.GetFundTrendInRange("000001", trendRange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if adjusted.Adjust != "dividend" || adjusted.Data[0].Value != raw.Data[0].Value {
+		t.Fatalf("❌ 复权净值应以区间首日单位净值为基准: %+v", adjusted.Data)
+	}
+	// 分红当日: 1.1589 -> 1.1099 + 0.05 再投资
+	want := raw.Data[0].Value * (raw.Data[1].Value + 0.05) / raw.Data[0].Value
+	if math.Abs(adjusted.Data[1].Value-want) > 1e-4 {
+		t.Errorf("❌ 分红当日复权净值 %.4f, 期望 %.4f", adjusted.Data[1].Value, want)
+	}
+	// 分红之后按单位净值涨跌幅延续
+	wantNext := want * raw.Data[2].Value / raw.Data[1].Value
+	if math.Abs(adjusted.Data[2].Value-wantNext) > 1e-4 {
+		t.Errorf("❌ 分红次日复权净值 %.4f, 期望 %.4f", adjusted.Data[2].Value, wantNext)
+	}
+
+	// 复权计算不影响缓存中的单位净值
+	again, _ := fundService.GetFundTrendInRange("000001", TrendRange{Period: "custom", Start: "2024-01-17", End: "2024-01-19"})
+	if again.Data[1].Value != raw.Data[1].Value {
+		t.Errorf("❌ 缓存数据被修改: %.4f", again.Data[1].Value)
+	}
+
+	if d := parseDividend("拆分：每份基金份额折算1.0203份"); d == nil || d.Split != 1.0203 || d.Cash != 0 {
+		t.Errorf("❌ 拆分事件解析异常: %+v", d)
+	}
+}
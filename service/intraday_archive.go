@@ -0,0 +1,166 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"fund/model"
+	"fund/storage"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// 日内数据写入 storage.IntradayStore（默认为 <dataDir>/intraday 下的 TSDB），
+// 每个数据点实时追加到预写日志，收盘后合并为按交易日、基金分段的归档文件
+
+// archiveDir 归档根目录
+func (s *IntradayService) archiveDir() string {
+	return filepath.Join(s.dataDir, "intraday")
+}
+
+// SetArchiveRetention 设置归档保留天数，小于等于0表示永久保留
+func (s *IntradayService) SetArchiveRetention(days int) {
+	s.archiveRetention = days
+}
+
+// SetStore 设置日内数据存储引擎（需在 LoadFromDisk 之前调用）
+func (s *IntradayService) SetStore(store storage.IntradayStore) {
+	s.store = store
+}
+
+// openStore 打开日内数据存储，并把尚未持久化的内存数据写入
+func (s *IntradayService) openStore() error {
+	s.dataMutex.Lock()
+	defer s.dataMutex.Unlock()
+
+	if s.store != nil {
+		return nil
+	}
+
+	store, err := storage.OpenTSDB(s.archiveDir(), 0)
+	if err != nil {
+		return fmt.Errorf("打开日内数据存储失败: %v", err)
+	}
+	s.store = store
+
+	var records []storage.Record
+	for _, fundData := range s.intradayData {
+		records = append(records, intradayRecords(fundData)...)
+	}
+	return s.store.Append(records...)
+}
+
+// importLegacyData 导入旧版本保存的 intraday_data.json，导入后重命名为 .bak
+func (s *IntradayService) importLegacyData() error {
+	dataFile := filepath.Join(s.dataDir, "intraday_data.json")
+
+	file, err := os.Open(dataFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("打开数据文件失败: %v", err)
+	}
+
+	var diskData map[string]*model.FundIntradayData
+	err = json.NewDecoder(file).Decode(&diskData)
+	file.Close()
+	if err != nil {
+		return fmt.Errorf("解析数据文件失败: %v", err)
+	}
+
+	var records []storage.Record
+	for _, fundData := range diskData {
+		records = append(records, intradayRecords(fundData)...)
+	}
+	if err := s.store.Append(records...); err != nil {
+		return err
+	}
+	if err := os.Rename(dataFile, dataFile+".bak"); err != nil {
+		return fmt.Errorf("重命名旧数据文件失败: %v", err)
+	}
+
+	log.Printf("📦 已导入旧版日内数据: %d 只基金, %d 个数据点", len(diskData), len(records))
+	return nil
+}
+
+// intradayRecords 将基金日内数据转换为存储记录
+func intradayRecords(fundData *model.FundIntradayData) []storage.Record {
+	records := make([]storage.Record, 0, len(fundData.Data))
+	for _, point := range fundData.Data {
+		records = append(records, storage.Record{
+			Code:  fundData.Code,
+			Name:  fundData.Name,
+			Date:  fundData.Date,
+			Point: point,
+		})
+	}
+	return records
+}
+
+// isAfterClose 判断是否为交易日收盘后（采集窗口已结束）
+func (s *IntradayService) isAfterClose(t time.Time) bool {
+	weekday := t.Weekday()
+	if weekday == time.Saturday || weekday == time.Sunday {
+		return false
+	}
+	return t.Hour() >= 15 && !s.isTradingTime(t)
+}
+
+// archiveIfClosed 收盘后合并当天数据并清理过期归档（每天只执行一次）
+func (s *IntradayService) archiveIfClosed() error {
+	now := s.now()
+	today := now.Format("2006-01-02")
+	if !s.isAfterClose(now) || s.lastArchived == today {
+		return nil
+	}
+
+	if err := s.store.Compact(); err != nil {
+		return err
+	}
+	s.lastArchived = today
+	log.Printf("🗄️  已归档 %s 日内数据", today)
+
+	if s.archiveRetention <= 0 {
+		return nil
+	}
+	cutoff := now.AddDate(0, 0, -s.archiveRetention).Format("2006-01-02")
+	return s.store.Prune(cutoff)
+}
+
+// ArchiveDates 获取已有日内数据的交易日（升序）
+func (s *IntradayService) ArchiveDates() ([]string, error) {
+	if err := s.openStore(); err != nil {
+		return nil, err
+	}
+	return s.store.Dates()
+}
+
+// GetIntradayDataByDate 获取指定基金某个交易日的日内数据
+// date 为空或为内存中的当前交易日时返回实时数据，否则读取归档
+func (s *IntradayService) GetIntradayDataByDate(fundCode, date string) (*model.FundIntradayData, error) {
+	if date == "" {
+		return s.GetIntradayData(fundCode)
+	}
+
+	s.dataMutex.RLock()
+	current, exists := s.intradayData[fundCode]
+	isCurrent := exists && current.Date == date
+	s.dataMutex.RUnlock()
+	if isCurrent {
+		return s.GetIntradayData(fundCode)
+	}
+
+	if err := s.openStore(); err != nil {
+		return nil, err
+	}
+	fundData, err := s.store.Day(fundCode, date)
+	if err != nil {
+		return nil, fmt.Errorf("读取归档失败: %v", err)
+	}
+	if fundData == nil {
+		return nil, fmt.Errorf("暂无该基金 %s 的日内数据", date)
+	}
+	return fundData, nil
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"fund/model"
+	"sync"
+)
+
+// PointEvent 写入一个日内数据点的事件
+type PointEvent struct {
+	Code  string              `json:"code"`  // 基金代码
+	Name  string              `json:"name"`  // 基金名称
+	Date  string              `json:"date"`  // 交易日 YYYY-MM-DD
+	Point model.IntradayPoint `json:"point"` // 新写入的数据点
+	High  float64             `json:"high"`  // 当日最高估算净值（含本数据点）
+	Low   float64             `json:"low"`   // 当日最低估算净值（含本数据点）
+}
+
+// PointListener 日内数据点写入后的回调
+// 在采集协程中按写入顺序同步调用，调用时不持有数据锁，耗时操作应自行异步处理
+type PointListener func(event PointEvent)
+
+// pointListeners 已注册的回调
+type pointListeners struct {
+	mutex     sync.RWMutex
+	listeners []PointListener
+}
+
+// AddPointListener 注册日内数据点写入回调（如提醒规则计算）
+func (s *IntradayService) AddPointListener(listener PointListener) {
+	s.listeners.mutex.Lock()
+	defer s.listeners.mutex.Unlock()
+	s.listeners.listeners = append(s.listeners.listeners, listener)
+}
+
+// notifyPoints 将写入事件推送给订阅者，并依次分发给所有回调（调用方不能持有 dataMutex）
+func (s *IntradayService) notifyPoints(events ...PointEvent) {
+	s.stream.publishPoints(events...)
+
+	s.listeners.mutex.RLock()
+	listeners := s.listeners.listeners
+	s.listeners.mutex.RUnlock()
+
+	for _, event := range events {
+		for _, listener := range listeners {
+			listener(event)
+		}
+	}
+}
+
+// newPointEvent 根据当日已有数据生成写入事件（调用方需持有 dataMutex）
+func newPointEvent(fundData *model.FundIntradayData, point model.IntradayPoint) PointEvent {
+	event := PointEvent{
+		Code:  fundData.Code,
+		Name:  fundData.Name,
+		Date:  fundData.Date,
+		Point: point,
+		High:  point.Value,
+		Low:   point.Value,
+	}
+	for _, p := range fundData.Data {
+		if p.Value <= 0 {
+			continue
+		}
+		if p.Value > event.High {
+			event.High = p.Value
+		}
+		if p.Value < event.Low || event.Low <= 0 {
+			event.Low = p.Value
+		}
+	}
+	return event
+}
@@ -1,16 +1,11 @@
 package service
 
 import (
-	"context"
-	"encoding/json"
 	"fmt"
 	"fund/model"
-	"io"
+	"fund/storage"
 	"log"
-	"net/http"
 	"os"
-	"path/filepath"
-	"regexp"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -23,196 +18,243 @@ type WatchConfig struct {
 	FetchInterval int      `json:"fetch_interval"` // 采集周期（秒）
 }
 
+// WatchSource 额外的监控基金来源（如用户自选），返回的基金与配置文件中的监控列表合并后按采集周期高频采集
+type WatchSource func() []string
+
+// defaultFetchInterval 默认监控采集周期（秒）
+const defaultFetchInterval = 30
+
 // IntradayService 日内实时数据服务
 type IntradayService struct {
-	httpClient   *http.Client
+	provider     Provider                           // 上游数据源
 	fundList     []model.FundBasicInfo              // 基金列表
+	fundNames    map[string]string                  // 基金代码 -> 名称（基金列表的索引）
 	intradayData map[string]*model.FundIntradayData // 日内数据存储 key: fundCode
 	dataMutex    sync.RWMutex                       // 数据锁
 	stopChan     chan struct{}                      // 停止信号
 	isRunning    bool                               // 是否正在运行
 	dataDir      string                             // 数据存储目录
 	watchConfig  *WatchConfig                       // 监控配置
+	configData   []byte                             // 最近一次加载或写入的配置文件内容
+	configMutex  sync.RWMutex                       // 监控配置锁
 	configFile   string                             // 配置文件路径
+	configChange chan struct{}                      // 监控配置变更通知（采集周期变化时重置定时器）
+	watchSource  WatchSource                        // 额外的监控基金来源（可选）
 	fundService  *FundService                       // 基金服务（用于批量获取）
+	now          func() time.Time                   // 时钟（可替换，便于测试）
+	listeners    pointListeners                     // 日内数据点写入回调
+	stream       *streamHub                         // 日内数据推送（SSE、WebSocket 长连接订阅）
+
+	store            storage.IntradayStore // 日内数据存储引擎
+	fundStore        storage.FundStore     // 基金列表存储（可选）
+	archiveRetention int                   // 归档保留天数，<=0 表示永久保留
+	lastArchived     string                // 最近一次收盘归档的日期
 }
 
-// NewIntradayService 创建日内服务实例
+// NewIntradayService 创建日内服务实例（默认使用东方财富数据源）
 func NewIntradayService() *IntradayService {
+	return NewIntradayServiceWithProvider(NewEastmoneyProvider())
+}
+
+// NewIntradayServiceWithProvider 使用指定数据源创建日内服务实例
+func NewIntradayServiceWithProvider(provider Provider) *IntradayService {
 	return &IntradayService{
-		httpClient: &http.Client{
-			Timeout: 10 * time.Second,
-		},
+		provider:     provider,
 		intradayData: make(map[string]*model.FundIntradayData),
 		stopChan:     make(chan struct{}),
-		dataDir:      "./data",             // 数据存储目录
-		configFile:   "./watch_funds.json", // 配置文件路径
-		fundService:  NewFundService(),     // 初始化基金服务
+		configChange: make(chan struct{}, 1),
+		dataDir:      "./data",                             // 数据存储目录
+		configFile:   "./watch_funds.json",                 // 配置文件路径
+		fundService:  NewFundServiceWithProvider(provider), // 初始化基金服务
+		now:          time.Now,
+		stream:       newStreamHub(),
 	}
 }
 
+// SetDataDir 设置数据存储目录
+func (s *IntradayService) SetDataDir(dir string) {
+	s.dataDir = dir
+}
+
+// SetConfigFile 设置监控配置文件路径
+func (s *IntradayService) SetConfigFile(path string) {
+	s.configFile = path
+}
+
+// SetClock 设置时钟，用于测试或离线回放
+func (s *IntradayService) SetClock(now func() time.Time) {
+	s.now = now
+	s.stream.now = now
+}
+
 // LoadWatchConfig 加载监控配置
 func (s *IntradayService) LoadWatchConfig() error {
-	// 检查配置文件是否存在
-	if _, err := os.Stat(s.configFile); os.IsNotExist(err) {
-		log.Printf("⚠️  配置文件不存在: %s, 将采集全量基金", s.configFile)
-		return nil
-	}
-
-	// 读取配置文件
-	file, err := os.Open(s.configFile)
+	config, data, err := s.readWatchConfig()
 	if err != nil {
-		return fmt.Errorf("打开配置文件失败: %v", err)
+		return err
 	}
-	defer file.Close()
-
-	// 解析JSON
-	var config WatchConfig
-	decoder := json.NewDecoder(file)
-	if err := decoder.Decode(&config); err != nil {
-		return fmt.Errorf("解析配置文件失败: %v", err)
+	if config == nil {
+		log.Printf("⚠️  配置文件不存在: %s, 将采集全量基金", s.configFile)
+		return nil
 	}
+	s.applyWatchConfig(config, data)
 
 	// 验证配置
 	if len(config.WatchList) == 0 {
 		log.Printf("⚠️  配置文件中监控列表为空，将采集全量基金")
 		return nil
 	}
-	if config.FetchInterval <= 0 {
-		config.FetchInterval = 30 // 默认30秒
-	}
-
-	s.watchConfig = &config
 	log.Printf("✅ 加载监控配置: %d 只基金, 采集周期 %d 秒", len(config.WatchList), config.FetchInterval)
 	log.Printf("📋 监控基金: %v", config.WatchList)
 
 	return nil
 }
 
-// LoadAllFunds 加载所有基金列表
-func (s *IntradayService) LoadAllFunds() error {
-	url := "http://fund.eastmoney.com/js/fundcode_search.js"
+// SetWatchSource 设置额外的监控基金来源
+// 配置文件中没有监控列表（全量模式）时，这些基金在全量批量采集之外按默认周期单独高频采集
+func (s *IntradayService) SetWatchSource(source WatchSource) {
+	s.watchSource = source
+}
 
-	resp, err := s.httpClient.Get(url)
-	if err != nil {
-		return fmt.Errorf("获取基金列表失败: %v", err)
+// watchList 本轮需要高频采集的基金：配置文件中的监控列表在前，额外来源中的新基金依次追加
+// 额外来源只采集基金列表中存在的基金，合并后最多 maxWatchCodes 只，超出部分按来源顺序舍弃
+func (s *IntradayService) watchList() []string {
+	codes := s.GetWatchConfig().WatchList
+	if s.watchSource == nil {
+		return codes
 	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return fmt.Errorf("读取基金列表失败: %v", err)
+	seen := make(map[string]bool, len(codes))
+	for _, code := range codes {
+		seen[code] = true
 	}
-
-	// 解析 JS 格式: var r = [["000001","HXCZHH","华夏成长混合","混合型-偏股","HUAXIACHENGZHANGHUNHE"],...]
-	content := string(body)
-	pattern := regexp.MustCompile(`var r = (\[\[.*?\]\]);`)
-	matches := pattern.FindStringSubmatch(content)
-	if len(matches) < 2 {
-		return fmt.Errorf("解析基金列表失败")
+	var unknown, dropped int
+	for _, code := range s.watchSource() {
+		if seen[code] {
+			continue
+		}
+		seen[code] = true
+		if _, exists := s.fundNames[code]; !exists {
+			unknown++
+		} else if len(codes) >= maxWatchCodes {
+			dropped++
+		} else {
+			codes = append(codes, code)
+		}
+	}
+	if unknown > 0 || dropped > 0 {
+		log.Printf("⚠️  额外监控来源中 %d 只基金不在基金列表中, %d 只超出监控上限 %d, 本轮不采集",
+			unknown, dropped, maxWatchCodes)
 	}
+	return codes
+}
 
-	// 解析 JSON
-	var rawList [][]string
-	if err := json.Unmarshal([]byte(matches[1]), &rawList); err != nil {
-		return fmt.Errorf("解析基金数据失败: %v", err)
+// fetchInterval 监控采集周期（秒）
+func (s *IntradayService) fetchInterval() int {
+	return s.GetWatchConfig().FetchInterval
+}
+
+// SetFundStore 设置基金列表存储，重启时优先使用当天已保存的列表
+func (s *IntradayService) SetFundStore(fundStore storage.FundStore) {
+	s.fundStore = fundStore
+}
+
+// LoadAllFunds 加载所有基金列表
+// 配置了基金列表存储时：当天已保存过则直接使用；否则从上游获取并保存，上游失败时使用已保存的列表
+func (s *IntradayService) LoadAllFunds() error {
+	var cached []model.FundBasicInfo
+	if s.fundStore != nil {
+		fundList, updatedAt, err := s.fundStore.LoadFundList()
+		if err != nil {
+			log.Printf("⚠️  读取已保存的基金列表失败: %v", err)
+		} else if len(fundList) > 0 {
+			cached = fundList
+			if updatedAt.Format("2006-01-02") == s.now().Format("2006-01-02") {
+				s.setFundList(fundList)
+				log.Printf("✅ 从存储加载 %d 只基金", len(s.fundList))
+				return nil
+			}
+		}
 	}
 
-	// 转换为基金信息列表
-	s.fundList = make([]model.FundBasicInfo, 0, len(rawList))
-	for _, item := range rawList {
-		if len(item) >= 4 {
-			s.fundList = append(s.fundList, model.FundBasicInfo{
-				Code: item[0],
-				Name: item[2],
-				Type: item[3],
-			})
+	fundList, err := s.provider.FetchFundList()
+	if err != nil {
+		if len(cached) == 0 {
+			return err
+		}
+		log.Printf("⚠️  获取基金列表失败: %v, 使用已保存的 %d 只基金", err, len(cached))
+		fundList = cached
+	} else if s.fundStore != nil {
+		if err := s.fundStore.SaveFundList(fundList, s.now()); err != nil {
+			log.Printf("⚠️  保存基金列表失败: %v", err)
 		}
 	}
 
+	s.setFundList(fundList)
+
 	log.Printf("✅ 成功加载 %d 只基金", len(s.fundList))
 	return nil
 }
 
+// setFundList 设置基金列表并建立代码索引
+func (s *IntradayService) setFundList(fundList []model.FundBasicInfo) {
+	fundNames := make(map[string]string, len(fundList))
+	for _, fund := range fundList {
+		fundNames[fund.Code] = fund.Name
+	}
+	s.fundList = fundList
+	s.fundNames = fundNames
+}
+
 // LoadFromDisk 从硬盘加载实时数据到内存
+// 打开日内数据存储（回放预写日志完成崩溃恢复），恢复每只基金最近一个交易日的数据
 func (s *IntradayService) LoadFromDisk() error {
 	// 确保数据目录存在
 	if err := os.MkdirAll(s.dataDir, 0755); err != nil {
 		return fmt.Errorf("创建数据目录失败: %v", err)
 	}
 
-	dataFile := filepath.Join(s.dataDir, "intraday_data.json")
-
-	// 检查文件是否存在
-	if _, err := os.Stat(dataFile); os.IsNotExist(err) {
-		log.Println("💾 未找到持久化数据文件，将使用空数据")
-		return nil
+	if err := s.openStore(); err != nil {
+		return err
 	}
-
-	// 读取文件
-	file, err := os.Open(dataFile)
-	if err != nil {
-		return fmt.Errorf("打开数据文件失败: %v", err)
+	if err := s.importLegacyData(); err != nil {
+		log.Printf("⚠️  导入旧版日内数据失败: %v", err)
 	}
-	defer file.Close()
 
-	// 解析JSON
-	var diskData map[string]*model.FundIntradayData
-	decoder := json.NewDecoder(file)
-	if err := decoder.Decode(&diskData); err != nil {
-		return fmt.Errorf("解析数据文件失败: %v", err)
+	latest, err := s.store.Latest()
+	if err != nil {
+		return fmt.Errorf("恢复日内数据失败: %v", err)
 	}
 
 	// 加载到内存
 	s.dataMutex.Lock()
-	s.intradayData = diskData
+	for code, fundData := range latest {
+		if current, exists := s.intradayData[code]; exists && current.Date > fundData.Date {
+			continue
+		}
+		s.intradayData[code] = fundData
+	}
 	s.dataMutex.Unlock()
 
-	log.Printf("✅ 从硬盘加载了 %d 只基金的实时数据", len(diskData))
+	log.Printf("✅ 从硬盘加载了 %d 只基金的实时数据", len(latest))
 	return nil
 }
 
-// SaveToDisk 将内存中的实时数据保存到硬盘
+// SaveToDisk 将已写入的实时数据刷到硬盘
+// 数据点在采集时已写入存储，这里只同步预写日志，收盘后合并归档
 func (s *IntradayService) SaveToDisk() error {
-	// 确保数据目录存在
-	if err := os.MkdirAll(s.dataDir, 0755); err != nil {
-		return fmt.Errorf("创建数据目录失败: %v", err)
-	}
-
-	dataFile := filepath.Join(s.dataDir, "intraday_data.json")
-
-	// 读取内存数据
-	s.dataMutex.RLock()
-	dataCopy := make(map[string]*model.FundIntradayData, len(s.intradayData))
-	for k, v := range s.intradayData {
-		dataCopy[k] = v
-	}
-	s.dataMutex.RUnlock()
-
-	// 写入临时文件
-	tmpFile := dataFile + ".tmp"
-	file, err := os.Create(tmpFile)
-	if err != nil {
-		return fmt.Errorf("创建临时文件失败: %v", err)
+	if err := s.openStore(); err != nil {
+		return err
 	}
 
-	// 编码JSON
-	encoder := json.NewEncoder(file)
-	encoder.SetIndent("", "  ")
-	if err := encoder.Encode(dataCopy); err != nil {
-		file.Close()
-		os.Remove(tmpFile)
-		return fmt.Errorf("编码数据失败: %v", err)
+	if err := s.store.Sync(); err != nil {
+		return fmt.Errorf("同步日内数据失败: %v", err)
 	}
-	file.Close()
 
-	// 原子性替换文件
-	if err := os.Rename(tmpFile, dataFile); err != nil {
-		os.Remove(tmpFile)
-		return fmt.Errorf("替换数据文件失败: %v", err)
+	if err := s.archiveIfClosed(); err != nil {
+		log.Printf("❌ 归档日内数据失败: %v", err)
 	}
 
-	log.Printf("💾 已保存 %d 只基金的实时数据到硬盘", len(dataCopy))
+	log.Printf("💾 已同步 %d 只基金的实时数据到硬盘", s.GetDataCount())
 	return nil
 }
 
@@ -239,45 +281,12 @@ func (s *IntradayService) fetchRealtimeEstimate(fundCode string) (*model.Realtim
 			time.Sleep(time.Duration(attempt*500) * time.Millisecond)
 		}
 
-		timestamp := time.Now().UnixNano() / 1e6
-		url := fmt.Sprintf("http://fundgz.1234567.com.cn/js/%s.js?rt=%d", fundCode, timestamp)
-
-		// 创建带超时的请求
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-
-		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-		if err != nil {
-			cancel()
-			continue
-		}
-
-		resp, err := s.httpClient.Do(req)
-		if err != nil {
-			cancel()
-			continue
-		}
-
-		body, err := io.ReadAll(resp.Body)
-		resp.Body.Close()
-		cancel()
-
+		realtimeData, err := s.provider.FetchRealtimeEstimate(fundCode)
 		if err != nil {
 			continue
 		}
 
-		// 解析 jsonpgz({...})
-		re := regexp.MustCompile(`jsonpgz\((.*?)\);?$`)
-		matches := re.FindStringSubmatch(string(body))
-		if len(matches) < 2 {
-			continue
-		}
-
-		var realtimeData model.RealtimeData
-		if err := json.Unmarshal([]byte(matches[1]), &realtimeData); err != nil {
-			continue
-		}
-
-		return &realtimeData, nil
+		return realtimeData, nil
 	}
 
 	return nil, fmt.Errorf("获取失败，已重试%d次", maxRetries)
@@ -285,7 +294,7 @@ func (s *IntradayService) fetchRealtimeEstimate(fundCode string) (*model.Realtim
 
 // fetchAllFundsRealtime 批量获取全量基金的实时数据（并发版本）
 func (s *IntradayService) fetchAllFundsRealtime() {
-	now := time.Now()
+	now := s.now()
 
 	// 判断是否在交易时间
 	if !s.isTradingTime(now) {
@@ -334,51 +343,12 @@ func (s *IntradayService) fetchAllFundsRealtime() {
 					return
 				}
 
-				// 解析估算净值和涨跌幅
-				value, _ := strconv.ParseFloat(realtime.Gsz, 64)
-				rate, _ := strconv.ParseFloat(realtime.GsZzl, 64)
-
 				// 存储数据
 				s.dataMutex.Lock()
-
-				if _, exists := s.intradayData[f.Code]; !exists {
-					// 首次创建
-					s.intradayData[f.Code] = &model.FundIntradayData{
-						Code: f.Code,
-						Name: f.Name,
-						Date: today,
-						Data: []model.IntradayPoint{},
-					}
-				}
-
-				// 更新或添加最新数据点
-				fundData := s.intradayData[f.Code]
-
-				// 检查日期是否需要清空（新的一天）
-				if fundData.Date != today {
-					fundData.Date = today
-					fundData.Data = []model.IntradayPoint{}
-				}
-
-				// 添加或更新当前时间点的数据
-				found := false
-				for i := range fundData.Data {
-					if fundData.Data[i].Time == currentTime {
-						fundData.Data[i].Value = value
-						fundData.Data[i].Rate = rate
-						found = true
-						break
-					}
-				}
-				if !found {
-					fundData.Data = append(fundData.Data, model.IntradayPoint{
-						Time:  currentTime,
-						Value: value,
-						Rate:  rate,
-					})
-				}
-
+				event := s.upsertPointLocked(f.Code, f.Name, today, s.realtimeToPoint(realtime, currentTime))
 				s.dataMutex.Unlock()
+				s.appendPoints(event)
+				s.notifyPoints(event)
 
 				atomic.AddInt64(&successCount, 1)
 			}(fund)
@@ -423,17 +393,17 @@ func (s *IntradayService) fetchAllFundsRealtime() {
 
 // fetchAllFundsRealtimeBatch 使用批量接口获取全量基金实时数据
 func (s *IntradayService) fetchAllFundsRealtimeBatch() {
-	now := time.Now()
+	now := s.now()
+	today := now.Format("2006-01-02")
+	currentTime := now.Format("15:04")
 
 	// 判断是否在交易时间
 	if !s.isTradingTime(now) {
 		log.Printf("⏸️  非交易时间 [%s], 跳过本次采集", now.Format("15:04"))
+		s.publishStatus(model.CollectorStatus{Mode: "batch", State: "closed", Date: today, Time: currentTime})
 		return
 	}
 
-	today := now.Format("2006-01-02")
-	currentTime := now.Format("15:04")
-
 	log.Printf("📊 开始使用批量接口获取全量基金实时数据 [%s]", currentTime)
 
 	startTime := time.Now()
@@ -441,8 +411,13 @@ func (s *IntradayService) fetchAllFundsRealtimeBatch() {
 	// 先获取第一页以获取总数
 	firstPageData, err := s.fundService.FetchBatchFundsForRealtime(1, 200)
 	if err != nil {
-		log.Printf("❌ 获取第一页失败: %v", err)
-		return
+		if firstPageData == nil {
+			log.Printf("❌ 获取第一页失败: %v", err)
+			s.publishStatus(model.CollectorStatus{Mode: "batch", State: "failed", Date: today, Time: currentTime,
+				Failed: 1, ElapsedMs: time.Since(startTime).Milliseconds()})
+			return
+		}
+		log.Printf("⚠️  第一页部分记录解析失败: %v", err)
 	}
 
 	// 假设总基金数（可以从之前加载的基金列表获取）
@@ -467,9 +442,12 @@ func (s *IntradayService) fetchAllFundsRealtimeBatch() {
 
 		pageData, err := s.fundService.FetchBatchFundsForRealtime(page, pageSize)
 		if err != nil {
-			log.Printf("⚠️  获取第 %d 页失败: %v", page, err)
-			failCount++
-			continue
+			if pageData == nil {
+				log.Printf("⚠️  获取第 %d 页失败: %v", page, err)
+				failCount++
+				continue
+			}
+			log.Printf("⚠️  第 %d 页部分记录解析失败: %v", page, err)
 		}
 
 		s.processBatchFundsData(pageData, today, currentTime)
@@ -482,10 +460,19 @@ func (s *IntradayService) fetchAllFundsRealtimeBatch() {
 	elapsed := time.Since(startTime)
 	log.Printf("✅ 批量采集完成: 成功 %d 只基金, 失败 %d 页, 耗时 %v",
 		successCount, failCount, elapsed)
+	s.publishStatus(model.CollectorStatus{Mode: "batch", State: "done", Date: today, Time: currentTime,
+		Success: successCount, Failed: failCount, ElapsedMs: elapsed.Milliseconds()})
 }
 
 // processBatchFundsData 处理批量基金数据
 func (s *IntradayService) processBatchFundsData(fundsData map[string]map[string]interface{}, today, currentTime string) {
+	// 释放数据锁后一次写入存储，再触发回调
+	events := make([]PointEvent, 0, len(fundsData))
+	defer func() {
+		s.appendPoints(events...)
+		s.notifyPoints(events...)
+	}()
+
 	s.dataMutex.Lock()
 	defer s.dataMutex.Unlock()
 
@@ -510,67 +497,42 @@ func (s *IntradayService) processBatchFundsData(fundsData map[string]map[string]
 			continue
 		}
 
-		// 存储数据
-		if _, exists := s.intradayData[fundCode]; !exists {
-			// 首次创建
-			s.intradayData[fundCode] = &model.FundIntradayData{
-				Code: fundCode,
-				Name: fundName,
-				Date: today,
-				Data: []model.IntradayPoint{},
-			}
-		}
-
-		// 更新或添加最新数据点
-		fundData := s.intradayData[fundCode]
-
-		// 检查日期是否需要清空（新的一天）
-		if fundData.Date != today {
-			fundData.Date = today
-			fundData.Data = []model.IntradayPoint{}
+		// 数据来源
+		source := s.provider.Name()
+		if src, ok := data["source"].(string); ok && src != "" {
+			source = src
 		}
 
-		// 添加或更新当前时间点的数据
-		found := false
-		for i := range fundData.Data {
-			if fundData.Data[i].Time == currentTime {
-				fundData.Data[i].Value = value
-				fundData.Data[i].Rate = rate
-				found = true
-				break
-			}
-		}
-		if !found {
-			fundData.Data = append(fundData.Data, model.IntradayPoint{
-				Time:  currentTime,
-				Value: value,
-				Rate:  rate,
-			})
-		}
+		// 存储数据
+		events = append(events, s.upsertPointLocked(fundCode, fundName, today, model.IntradayPoint{
+			Time:   currentTime,
+			Value:  value,
+			Rate:   rate,
+			Source: source,
+		}))
 	}
 }
 
-// fetchWatchListRealtime 获取监控列表中基金的实时数据（均匀分布）
+// fetchWatchListRealtime 获取监控列表（含用户自选）中基金的实时数据（均匀分布）
 func (s *IntradayService) fetchWatchListRealtime() {
-	if s.watchConfig == nil || len(s.watchConfig.WatchList) == 0 {
-		log.Printf("⚠️  监控列表为空，跳过采集")
+	watchList := s.watchList()
+	if len(watchList) == 0 {
 		return
 	}
 
-	now := time.Now()
+	now := s.now()
+	today := now.Format("2006-01-02")
+	currentTime := now.Format("15:04")
 
 	// 判断是否在交易时间
 	if !s.isTradingTime(now) {
 		log.Printf("⏸️  非交易时间 [%s], 跳过本次采集", now.Format("15:04"))
+		s.publishStatus(model.CollectorStatus{Mode: "watch", State: "closed", Date: today, Time: currentTime})
 		return
 	}
 
-	today := now.Format("2006-01-02")
-	currentTime := now.Format("15:04")
-
-	watchList := s.watchConfig.WatchList
 	totalFunds := len(watchList)
-	fetchInterval := s.watchConfig.FetchInterval
+	fetchInterval := s.fetchInterval()
 
 	log.Printf("📊 开始获取监控列表基金实时数据 [%s], 基金数: %d, 周期: %d秒",
 		currentTime, totalFunds, fetchInterval)
@@ -584,12 +546,9 @@ func (s *IntradayService) fetchWatchListRealtime() {
 
 	for i, fundCode := range watchList {
 		// 获取基金名称
-		fundName := fundCode
-		for _, fund := range s.fundList {
-			if fund.Code == fundCode {
-				fundName = fund.Name
-				break
-			}
+		fundName, exists := s.fundNames[fundCode]
+		if !exists {
+			fundName = fundCode
 		}
 
 		// 获取实时估值
@@ -599,54 +558,16 @@ func (s *IntradayService) fetchWatchListRealtime() {
 				i+1, totalFunds, fundName, fundCode, err)
 			failCount++
 		} else {
-			// 解析估算净值和涨跌幅
-			value, _ := strconv.ParseFloat(realtime.Gsz, 64)
-			rate, _ := strconv.ParseFloat(realtime.GsZzl, 64)
-
 			// 存储数据
+			point := s.realtimeToPoint(realtime, currentTime)
 			s.dataMutex.Lock()
-
-			if _, exists := s.intradayData[fundCode]; !exists {
-				// 首次创建
-				s.intradayData[fundCode] = &model.FundIntradayData{
-					Code: fundCode,
-					Name: fundName,
-					Date: today,
-					Data: []model.IntradayPoint{},
-				}
-			}
-
-			// 更新或添加最新数据点
-			fundData := s.intradayData[fundCode]
-
-			// 检查日期是否需要清空（新的一天）
-			if fundData.Date != today {
-				fundData.Date = today
-				fundData.Data = []model.IntradayPoint{}
-			}
-
-			// 添加或更新当前时间点的数据
-			found := false
-			for j := range fundData.Data {
-				if fundData.Data[j].Time == currentTime {
-					fundData.Data[j].Value = value
-					fundData.Data[j].Rate = rate
-					found = true
-					break
-				}
-			}
-			if !found {
-				fundData.Data = append(fundData.Data, model.IntradayPoint{
-					Time:  currentTime,
-					Value: value,
-					Rate:  rate,
-				})
-			}
-
+			event := s.upsertPointLocked(fundCode, fundName, today, point)
 			s.dataMutex.Unlock()
+			s.appendPoints(event)
+			s.notifyPoints(event)
 
-			log.Printf("✅ [%d/%d] %s (%s) 估值: %.4f, 涨跌: %.2f%%",
-				i+1, totalFunds, fundName, fundCode, value, rate)
+			log.Printf("✅ [%d/%d] %s (%s) 估值: %.4f, 涨跌: %.2f%%, 来源: %s",
+				i+1, totalFunds, fundName, fundCode, point.Value, point.Rate, point.Source)
 			successCount++
 		}
 
@@ -659,6 +580,85 @@ func (s *IntradayService) fetchWatchListRealtime() {
 	elapsed := time.Since(startTime)
 	log.Printf("✅ 监控列表采集完成: 成功 %d, 失败 %d, 耗时 %v",
 		successCount, failCount, elapsed)
+	state := "done"
+	if successCount == 0 && failCount > 0 {
+		state = "failed"
+	}
+	s.publishStatus(model.CollectorStatus{Mode: "watch", State: state, Date: today, Time: currentTime,
+		Success: successCount, Failed: failCount, ElapsedMs: elapsed.Milliseconds()})
+}
+
+// realtimeToPoint 将实时估值转换为日内数据点
+func (s *IntradayService) realtimeToPoint(realtime *model.RealtimeData, currentTime string) model.IntradayPoint {
+	// 解析估算净值和涨跌幅
+	value, _ := strconv.ParseFloat(realtime.Gsz, 64)
+	rate, _ := strconv.ParseFloat(realtime.GsZzl, 64)
+
+	source := realtime.Source
+	if source == "" {
+		source = s.provider.Name()
+	}
+
+	return model.IntradayPoint{
+		Time:      currentTime,
+		Value:     value,
+		Rate:      rate,
+		Source:    source,
+		Deviation: realtime.Deviation,
+		Divergent: realtime.Divergent,
+	}
+}
+
+// upsertPointLocked 写入内存中的日内数据点（调用方需持有 dataMutex 写锁）
+// 同一时间点的数据会被覆盖，跨日时清空前一天的数据（已在存储中归档）
+// 返回的写入事件由调用方在释放锁后通过 appendPoints 写入存储并触发回调
+func (s *IntradayService) upsertPointLocked(fundCode, fundName, today string, point model.IntradayPoint) PointEvent {
+	if _, exists := s.intradayData[fundCode]; !exists {
+		// 首次创建
+		s.intradayData[fundCode] = &model.FundIntradayData{
+			Code: fundCode,
+			Name: fundName,
+			Date: today,
+			Data: []model.IntradayPoint{},
+		}
+	}
+
+	// 更新或添加最新数据点
+	fundData := s.intradayData[fundCode]
+
+	// 检查日期是否需要清空（新的一天）
+	if fundData.Date != today {
+		fundData.Date = today
+		fundData.Data = []model.IntradayPoint{}
+	}
+
+	// 添加或更新当前时间点的数据
+	for i := range fundData.Data {
+		if fundData.Data[i].Time == point.Time {
+			fundData.Data[i] = point
+			return newPointEvent(fundData, point)
+		}
+	}
+	fundData.Data = append(fundData.Data, point)
+	return newPointEvent(fundData, point)
+}
+
+// appendPoints 将写入事件批量追加到存储（存储未打开时跳过），不持有数据锁，
+// 批量采集的一页数据只写一次预写日志或一个事务
+func (s *IntradayService) appendPoints(events ...PointEvent) {
+	s.dataMutex.RLock()
+	store := s.store
+	s.dataMutex.RUnlock()
+	if store == nil || len(events) == 0 {
+		return
+	}
+	records := make([]storage.Record, len(events))
+	for i, event := range events {
+		records[i] = storage.Record{Code: event.Code, Name: event.Name, Date: event.Date, Point: event.Point}
+	}
+	if err := store.Append(records...); err != nil {
+		log.Printf("❌ %d 个日内数据点写入存储失败: %v", len(records), err)
+	}
 }
 
 // isTradingTime 判断是否在交易时间内
@@ -709,52 +709,69 @@ func (s *IntradayService) Start() error {
 
 	s.isRunning = true
 
-	// 根据配置决定采集模式
-	if s.watchConfig != nil && len(s.watchConfig.WatchList) > 0 {
+	// 根据配置决定采集模式（运行期间可通过管理接口或修改配置文件切换）
+	if config := s.GetWatchConfig(); len(config.WatchList) > 0 {
 		log.Printf("🚀 日内实时数据服务已启动 (监控模式: %d 只基金, %d秒周期)",
-			len(s.watchConfig.WatchList), s.watchConfig.FetchInterval)
-
-		// 启动定时任务：按配置周期获取监控列表基金实时数据
-		go func() {
-			ticker := time.NewTicker(time.Duration(s.watchConfig.FetchInterval) * time.Second)
-			defer ticker.Stop()
+			len(config.WatchList), config.FetchInterval)
+	} else {
+		log.Println("🚀 日内实时数据服务已启动 (全量模式 - 使用批量接口)")
+	}
 
-			// 立即执行一次
-			s.fetchWatchListRealtime()
+	// 启动定时任务：每1分钟获取全量基金实时数据（使用批量接口），监控模式下跳过
+	go func() {
+		ticker := time.NewTicker(1 * time.Minute)
+		defer ticker.Stop()
 
-			for {
-				select {
-				case <-ticker.C:
-					s.fetchWatchListRealtime()
+		// 立即执行一次
+		if !s.watchMode() {
+			s.fetchAllFundsRealtimeBatch()
+		}
 
-				case <-s.stopChan:
-					log.Println("⏹️  停止实时数据采集服务")
-					return
+		for {
+			select {
+			case <-ticker.C:
+				if !s.watchMode() {
+					s.fetchAllFundsRealtimeBatch()
 				}
+
+			case <-s.stopChan:
+				log.Println("⏹️  停止实时数据采集服务")
+				return
 			}
-		}()
-	} else {
-		log.Println("🚀 日内实时数据服务已启动 (全量模式 - 使用批量接口)")
+		}
+	}()
 
-		// 启动定时任务：每1分钟获取全量基金实时数据（使用批量接口）
-		go func() {
-			ticker := time.NewTicker(1 * time.Minute)
-			defer ticker.Stop()
+	// 启动定时任务：按配置周期获取监控列表（含用户自选）基金实时数据，采集周期变化时重置定时器
+	go func() {
+		interval := s.fetchInterval()
+		ticker := time.NewTicker(time.Duration(interval) * time.Second)
+		defer ticker.Stop()
 
-			// 立即执行一次
-			s.fetchAllFundsRealtimeBatch()
+		// 立即执行一次
+		s.fetchWatchListRealtime()
 
-			for {
-				select {
-				case <-ticker.C:
-					s.fetchAllFundsRealtimeBatch()
+		for {
+			select {
+			case <-ticker.C:
+				s.fetchWatchListRealtime()
 
-				case <-s.stopChan:
-					log.Println("⏹️  停止实时数据采集服务")
-					return
+			case <-s.configChange:
+				if next := s.fetchInterval(); next != interval {
+					interval = next
+					ticker.Reset(time.Duration(interval) * time.Second)
+					log.Printf("⏱️  监控采集周期调整为 %d 秒", interval)
 				}
+
+			case <-s.stopChan:
+				log.Println("⏹️  停止监控列表采集")
+				return
 			}
-		}()
+		}
+	}()
+
+	// 监听配置文件，修改后无需重启即可生效
+	if err := s.watchConfigFile(); err != nil {
+		log.Printf("⚠️  %v, 配置文件修改需重启生效", err)
 	}
 
 	// 启动定时任务：每5分钟保存数据到硬盘
@@ -775,6 +792,11 @@ func (s *IntradayService) Start() error {
 				if err := s.SaveToDisk(); err != nil {
 					log.Printf("❌ 保存数据失败: %v", err)
 				}
+				if s.store != nil {
+					if err := s.store.Close(); err != nil {
+						log.Printf("❌ 关闭日内数据存储失败: %v", err)
+					}
+				}
 				return
 			}
 		}
@@ -788,6 +810,7 @@ func (s *IntradayService) Stop() {
 	if s.isRunning {
 		close(s.stopChan)
 		s.isRunning = false
+		s.stream.closeAll()
 	}
 }
 
@@ -821,6 +844,18 @@ func (s *IntradayService) GetIntradayData(fundCode string) (*model.FundIntradayD
 	return data, nil
 }
 
+// LatestPoint 获取指定基金最新的日内数据点及其交易日
+func (s *IntradayService) LatestPoint(fundCode string) (string, model.IntradayPoint, bool) {
+	s.dataMutex.RLock()
+	defer s.dataMutex.RUnlock()
+
+	data, exists := s.intradayData[fundCode]
+	if !exists || len(data.Data) == 0 {
+		return "", model.IntradayPoint{}, false
+	}
+	return data.Date, data.Data[len(data.Data)-1], true
+}
+
 // ClearTodayData 清理当天数据
 func (s *IntradayService) ClearTodayData() {
 	s.dataMutex.Lock()
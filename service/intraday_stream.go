@@ -0,0 +1,563 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"fund/model"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrInvalidSubscription 订阅参数无效
+	ErrInvalidSubscription = errors.New("订阅参数无效")
+	// ErrTooManySubscribers 订阅连接数已达上限
+	ErrTooManySubscribers = errors.New("订阅连接数已达上限")
+	// ErrSubscriptionClosed 订阅已结束（取消、消费过慢或服务停止）
+	ErrSubscriptionClosed = errors.New("订阅已结束")
+)
+
+// 推送事件类型
+const (
+	StreamEventPoint    = "point"    // 新写入的数据点，数据为 PointEvent
+	StreamEventSnapshot = "snapshot" // 基金当日全部数据，数据为 model.FundIntradayData
+	StreamEventStatus   = "status"   // 采集状态，数据为 model.CollectorStatus
+	StreamEventAlert    = "alert"    // 触发的提醒，数据为 model.Alert
+)
+
+// 订阅主题（基金代码之外）
+const (
+	TopicStatus = "status" // 每轮采集结束后的采集状态
+	TopicAlerts = "alerts" // 当前用户触发的提醒（需要登录）
+)
+
+const (
+	MaxStreamCodes       = 50               // 每个连接最多订阅的基金数量
+	maxStreamSubscribers = 1000             // 同时在线的订阅连接上限
+	streamHistorySize    = 1024             // 每只基金保留用于断线续传的最近事件数量
+	streamResumeWindow   = 10 * time.Minute // 基金没有订阅者后继续保留续传事件的时间
+	streamBufferSize     = 256              // 每个订阅者待推送事件的上限
+)
+
+// StreamEvent 推送给订阅者的事件
+type StreamEvent struct {
+	ID   string      // 事件ID（数据点、快照），客户端断线重连时提交最后收到的ID即可续传
+	Type string      // 事件类型: point/snapshot/status/alert
+	Data interface{} // 事件数据
+}
+
+// StreamSubscription 推送订阅
+// 待推送事件在 Ready 通知后通过 Drain 一次取出；Done 关闭表示订阅已结束（取消、消费过慢或服务停止）。
+// 逐条推送的订阅（SSE）积压超过上限时断开，由客户端携带事件ID重连续传；
+// 合并推送的订阅（WebSocket）对同一基金未取走的数据点只保留最新一条，慢速客户端不会拖慢采集也不会无限积压
+type StreamSubscription struct {
+	hub      *streamHub
+	service  *IntradayService
+	userID   int64           // 所属用户（0 表示未登录，不能订阅提醒）
+	conflate bool            // 是否合并同一基金未取走的数据点
+	codes    map[string]bool // 订阅的基金（由 hub.mutex 保护）
+	topics   map[string]bool // 订阅的主题（由 hub.mutex 保护）
+
+	mutex     sync.Mutex
+	pending   []StreamEvent  // 待推送的事件
+	latest    map[string]int // 可合并事件在 pending 中的位置 key: 事件类型:基金代码
+	capacity  int            // pending 上限
+	coalesced int            // 被合并丢弃的事件数量
+	closed    bool
+	ready     chan struct{} // 有新的待推送事件
+	done      chan struct{} // 订阅结束
+}
+
+// Ready 有新的待推送事件时收到通知
+func (sub *StreamSubscription) Ready() <-chan struct{} {
+	return sub.ready
+}
+
+// Done 订阅结束时关闭
+func (sub *StreamSubscription) Done() <-chan struct{} {
+	return sub.done
+}
+
+// Drain 取出全部待推送的事件
+func (sub *StreamSubscription) Drain() []StreamEvent {
+	sub.mutex.Lock()
+	defer sub.mutex.Unlock()
+	events := sub.pending
+	sub.pending = nil
+	sub.latest = make(map[string]int)
+	return events
+}
+
+// Coalesced 因消费过慢被合并丢弃的事件数量
+func (sub *StreamSubscription) Coalesced() int {
+	sub.mutex.Lock()
+	defer sub.mutex.Unlock()
+	return sub.coalesced
+}
+
+// Close 取消订阅
+func (sub *StreamSubscription) Close() {
+	sub.hub.mutex.Lock()
+	defer sub.hub.mutex.Unlock()
+	sub.hub.removeLocked(sub)
+}
+
+// Subscribe 增加订阅的基金和主题，新订阅的基金先推送当日已有数据，新订阅采集状态时推送最近一次状态
+func (sub *StreamSubscription) Subscribe(codes, topics []string) error {
+	for _, code := range codes {
+		if !fundCodePattern.MatchString(code) {
+			return fmt.Errorf("%w: 基金代码 %q 格式错误,应为6位数字", ErrInvalidSubscription, code)
+		}
+	}
+	for _, topic := range topics {
+		switch topic {
+		case TopicStatus:
+		case TopicAlerts:
+			if sub.userID == 0 {
+				return fmt.Errorf("%w: 订阅提醒需要登录", ErrInvalidSubscription)
+			}
+		default:
+			return fmt.Errorf("%w: 不支持的主题 %q", ErrInvalidSubscription, topic)
+		}
+	}
+
+	hub := sub.hub
+	hub.mutex.Lock()
+	defer hub.mutex.Unlock()
+
+	// 已被移除的订阅不能再加入基金索引，否则不会再被清理
+	sub.mutex.Lock()
+	closed := sub.closed
+	sub.mutex.Unlock()
+	if _, exists := hub.subscribers[sub]; closed || !exists {
+		return ErrSubscriptionClosed
+	}
+
+	var added []string
+	for _, code := range codes {
+		if !sub.codes[code] && !contains(added, code) {
+			added = append(added, code)
+		}
+	}
+	if len(sub.codes)+len(added) > MaxStreamCodes {
+		return fmt.Errorf("%w: 每个连接最多订阅 %d 只基金", ErrInvalidSubscription, MaxStreamCodes)
+	}
+	for _, code := range added {
+		sub.codes[code] = true
+		hub.attachLocked(sub, code)
+	}
+
+	events := sub.service.snapshotEvents(added, hub.eventID(hub.seq))
+	for _, topic := range topics {
+		if topic == TopicStatus && !sub.topics[topic] && hub.status != nil {
+			events = append(events, StreamEvent{Type: StreamEventStatus, Data: *hub.status})
+		}
+		sub.topics[topic] = true
+	}
+	for _, event := range events {
+		if !sub.enqueue(event) {
+			hub.removeLocked(sub)
+			return fmt.Errorf("%w: 待推送事件过多", ErrInvalidSubscription)
+		}
+	}
+	return nil
+}
+
+// Unsubscribe 取消订阅的基金和主题（未订阅的忽略）
+func (sub *StreamSubscription) Unsubscribe(codes, topics []string) {
+	sub.hub.mutex.Lock()
+	defer sub.hub.mutex.Unlock()
+	for _, code := range codes {
+		if sub.codes[code] {
+			delete(sub.codes, code)
+			sub.hub.detachLocked(sub, code)
+		}
+	}
+	for _, topic := range topics {
+		delete(sub.topics, topic)
+	}
+}
+
+// Subscriptions 当前订阅的基金和主题（按字母排序）
+func (sub *StreamSubscription) Subscriptions() (codes, topics []string) {
+	sub.hub.mutex.Lock()
+	defer sub.hub.mutex.Unlock()
+	codes, topics = []string{}, []string{}
+	for code := range sub.codes {
+		codes = append(codes, code)
+	}
+	for topic := range sub.topics {
+		topics = append(topics, topic)
+	}
+	sort.Strings(codes)
+	sort.Strings(topics)
+	return codes, topics
+}
+
+// enqueue 加入待推送事件，超出上限时返回 false（调用方持有 hub.mutex）
+func (sub *StreamSubscription) enqueue(event StreamEvent) bool {
+	sub.mutex.Lock()
+	defer sub.mutex.Unlock()
+	if sub.closed {
+		return true
+	}
+
+	key := ""
+	if sub.conflate {
+		switch event.Type {
+		case StreamEventPoint:
+			key = event.Type + ":" + event.Data.(PointEvent).Code
+		case StreamEventStatus:
+			key = event.Type
+		}
+	}
+	if i, exists := sub.latest[key]; key != "" && exists {
+		sub.pending[i] = event
+		sub.coalesced++
+		return true
+	}
+	if len(sub.pending) >= sub.capacity {
+		return false
+	}
+	if key != "" {
+		sub.latest[key] = len(sub.pending)
+	}
+	sub.pending = append(sub.pending, event)
+
+	select {
+	case sub.ready <- struct{}{}:
+	default:
+	}
+	return true
+}
+
+// sequencedPoint 带序号的写入事件
+type sequencedPoint struct {
+	seq   uint64
+	event PointEvent
+}
+
+// codeStream 单只基金的订阅者和续传事件
+// 只记录有订阅者或订阅者离开不超过 streamResumeWindow 的基金，全市场采集时未订阅的基金不占用内存
+type codeStream struct {
+	subscribers map[*StreamSubscription]struct{} // 订阅了该基金的订阅者
+	history     []sequencedPoint                 // 该基金最近的数据点事件
+	since       uint64                           // 该序号之后的事件完整保留在 history 中
+	idleSince   time.Time                        // 最后一个订阅者离开的时间
+}
+
+// streamHub 日内数据的推送分发
+// 事件ID为 "<运行标识>-<序号>"，服务重启后运行标识变化，旧的事件ID不再续传
+type streamHub struct {
+	mutex       sync.Mutex
+	now         func() time.Time                 // 时钟（测试时可替换）
+	epoch       string                           // 运行标识
+	seq         uint64                           // 最近一个数据点事件的序号
+	codes       map[string]*codeStream           // 按基金索引的订阅者和续传事件
+	prunedAt    time.Time                        // 最近一次清理空闲基金的时间
+	status      *model.CollectorStatus           // 最近一次采集状态
+	subscribers map[*StreamSubscription]struct{} // 在线订阅者
+}
+
+// newStreamHub 创建推送分发
+func newStreamHub() *streamHub {
+	return &streamHub{
+		now:         time.Now,
+		epoch:       strconv.FormatInt(time.Now().UnixNano(), 36),
+		codes:       make(map[string]*codeStream),
+		subscribers: make(map[*StreamSubscription]struct{}),
+	}
+}
+
+// SubscribePoints 订阅基金的日内数据点，逐条推送（SSE）
+// lastEventID 为空或无法续传（服务重启、断线过久）时先推送各基金当日已有的全部数据（snapshot 事件），
+// 否则补发断线期间写入的数据点；同一时间点的数据可能重复推送，客户端应按时间覆盖
+func (s *IntradayService) SubscribePoints(codes []string, lastEventID string) (*StreamSubscription, error) {
+	if len(codes) == 0 || len(codes) > MaxStreamCodes {
+		return nil, fmt.Errorf("%w: 请订阅 1-%d 只基金", ErrInvalidSubscription, MaxStreamCodes)
+	}
+	subscribed := make(map[string]bool, len(codes))
+	for _, code := range codes {
+		if !fundCodePattern.MatchString(code) {
+			return nil, fmt.Errorf("%w: 基金代码 %q 格式错误,应为6位数字", ErrInvalidSubscription, code)
+		}
+		subscribed[code] = true
+	}
+
+	hub := s.stream
+	hub.mutex.Lock()
+	defer hub.mutex.Unlock()
+
+	if len(hub.subscribers) >= maxStreamSubscribers {
+		return nil, fmt.Errorf("%w: 最多 %d 个连接", ErrTooManySubscribers, maxStreamSubscribers)
+	}
+
+	backlog, ok := hub.replayLocked(subscribed, lastEventID)
+	if !ok {
+		backlog = s.snapshotEvents(codes, hub.eventID(hub.seq))
+	}
+	sub := s.newSubscription(0, false, len(backlog)+streamBufferSize)
+	sub.codes = subscribed
+	for _, event := range backlog {
+		sub.enqueue(event)
+	}
+	hub.subscribers[sub] = struct{}{}
+	for code := range subscribed {
+		hub.attachLocked(sub, code)
+	}
+	return sub, nil
+}
+
+// SubscribeLive 创建合并推送的订阅（WebSocket），连接期间通过 Subscribe/Unsubscribe 调整订阅的基金和主题
+// userID 为 0 表示未登录，只能订阅行情和采集状态
+func (s *IntradayService) SubscribeLive(userID int64) (*StreamSubscription, error) {
+	hub := s.stream
+	hub.mutex.Lock()
+	defer hub.mutex.Unlock()
+
+	if len(hub.subscribers) >= maxStreamSubscribers {
+		return nil, fmt.Errorf("%w: 最多 %d 个连接", ErrTooManySubscribers, maxStreamSubscribers)
+	}
+	sub := s.newSubscription(userID, true, streamBufferSize)
+	hub.subscribers[sub] = struct{}{}
+	return sub, nil
+}
+
+// PublishAlert 将触发的提醒推送给该用户订阅了提醒的连接，作为 AlertService 的提醒触发回调使用
+func (s *IntradayService) PublishAlert(alert model.Alert) {
+	s.stream.publish(StreamEvent{Type: StreamEventAlert, Data: alert}, func(sub *StreamSubscription) bool {
+		return sub.topics[TopicAlerts] && sub.userID == alert.UserID
+	})
+}
+
+// CollectorStatus 最近一次采集状态，尚未采集时返回 nil
+func (s *IntradayService) CollectorStatus() *model.CollectorStatus {
+	s.stream.mutex.Lock()
+	defer s.stream.mutex.Unlock()
+	if s.stream.status == nil {
+		return nil
+	}
+	status := *s.stream.status
+	return &status
+}
+
+// StreamSubscribers 当前在线的订阅连接数
+func (s *IntradayService) StreamSubscribers() int {
+	s.stream.mutex.Lock()
+	defer s.stream.mutex.Unlock()
+	return len(s.stream.subscribers)
+}
+
+// publishStatus 记录一轮采集的状态并推送给订阅了采集状态的连接
+func (s *IntradayService) publishStatus(status model.CollectorStatus) {
+	status.Interval = s.fetchInterval()
+	status.UpdatedAt = s.now()
+
+	s.stream.mutex.Lock()
+	s.stream.status = &status
+	s.stream.mutex.Unlock()
+
+	s.stream.publish(StreamEvent{Type: StreamEventStatus, Data: status}, func(sub *StreamSubscription) bool {
+		return sub.topics[TopicStatus]
+	})
+}
+
+// newSubscription 创建订阅（调用方持有 stream.mutex）
+func (s *IntradayService) newSubscription(userID int64, conflate bool, capacity int) *StreamSubscription {
+	return &StreamSubscription{
+		hub:      s.stream,
+		service:  s,
+		userID:   userID,
+		conflate: conflate,
+		codes:    make(map[string]bool),
+		topics:   make(map[string]bool),
+		latest:   make(map[string]int),
+		capacity: capacity,
+		ready:    make(chan struct{}, 1),
+		done:     make(chan struct{}),
+	}
+}
+
+// snapshotEvents 各基金当日已有数据的快照事件（调用方持有 stream.mutex，不能持有 dataMutex）
+func (s *IntradayService) snapshotEvents(codes []string, id string) []StreamEvent {
+	s.dataMutex.RLock()
+	defer s.dataMutex.RUnlock()
+
+	events := []StreamEvent{}
+	for _, code := range codes {
+		data, exists := s.intradayData[code]
+		if !exists || len(data.Data) == 0 {
+			continue
+		}
+		snapshot := *data
+		snapshot.Data = append([]model.IntradayPoint(nil), data.Data...)
+		events = append(events, StreamEvent{ID: id, Type: StreamEventSnapshot, Data: &snapshot})
+	}
+	return events
+}
+
+// publishPoints 记录订阅中基金的写入事件并分发给订阅了该基金的订阅者，未订阅的基金只占用序号
+func (hub *streamHub) publishPoints(events ...PointEvent) {
+	if len(events) == 0 {
+		return
+	}
+	hub.mutex.Lock()
+	defer hub.mutex.Unlock()
+	hub.pruneLocked()
+
+	for _, event := range events {
+		hub.seq++
+		stream, exists := hub.codes[event.Code]
+		if !exists {
+			continue
+		}
+		stream.history = append(stream.history, sequencedPoint{seq: hub.seq, event: event})
+		if len(stream.history) >= 2*streamHistorySize {
+			dropped := len(stream.history) - streamHistorySize
+			stream.since = stream.history[dropped-1].seq
+			stream.history = append([]sequencedPoint(nil), stream.history[dropped:]...)
+		}
+
+		pointEvent := StreamEvent{ID: hub.eventID(hub.seq), Type: StreamEventPoint, Data: event}
+		for sub := range stream.subscribers {
+			if !sub.enqueue(pointEvent) {
+				log.Printf("⚠️  推送订阅者消费过慢(积压 %d 条), 断开连接", sub.capacity)
+				hub.removeLocked(sub)
+			}
+		}
+	}
+}
+
+// publish 将事件分发给满足条件的订阅者
+func (hub *streamHub) publish(event StreamEvent, match func(sub *StreamSubscription) bool) {
+	hub.mutex.Lock()
+	defer hub.mutex.Unlock()
+	hub.dispatchLocked(event, match)
+}
+
+// dispatchLocked 分发事件，待推送事件超出上限的订阅者断开（调用方需持有 mutex）
+func (hub *streamHub) dispatchLocked(event StreamEvent, match func(sub *StreamSubscription) bool) {
+	for sub := range hub.subscribers {
+		if !match(sub) || sub.enqueue(event) {
+			continue
+		}
+		log.Printf("⚠️  推送订阅者消费过慢(积压 %d 条), 断开连接", sub.capacity)
+		hub.removeLocked(sub)
+	}
+}
+
+// replayLocked 续传 lastEventID 之后的事件，事件ID无效或已超出保留范围时返回 false（调用方需持有 mutex）
+func (hub *streamHub) replayLocked(codes map[string]bool, lastEventID string) ([]StreamEvent, bool) {
+	epoch, seqText, ok := strings.Cut(lastEventID, "-")
+	if !ok || epoch != hub.epoch {
+		return nil, false
+	}
+	last, err := strconv.ParseUint(seqText, 10, 64)
+	if err != nil || last > hub.seq {
+		return nil, false
+	}
+	if last == hub.seq {
+		return []StreamEvent{}, true
+	}
+
+	// 每只基金需完整保留 last 之后的事件，断线期间没有记录的基金无法确认是否漏推
+	var items []sequencedPoint
+	for code := range codes {
+		stream, exists := hub.codes[code]
+		if !exists || last < stream.since {
+			return nil, false
+		}
+		for _, item := range stream.history {
+			if item.seq > last {
+				items = append(items, item)
+			}
+		}
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].seq < items[j].seq })
+
+	events := []StreamEvent{}
+	for _, item := range items {
+		events = append(events, StreamEvent{ID: hub.eventID(item.seq), Type: StreamEventPoint, Data: item.event})
+	}
+	return events, true
+}
+
+// attachLocked 将订阅者加入基金的订阅索引，首次订阅的基金从当前序号开始记录续传事件（调用方需持有 mutex）
+func (hub *streamHub) attachLocked(sub *StreamSubscription, code string) {
+	stream, exists := hub.codes[code]
+	if !exists {
+		hub.pruneLocked()
+		stream = &codeStream{subscribers: make(map[*StreamSubscription]struct{}), since: hub.seq}
+		hub.codes[code] = stream
+	}
+	stream.subscribers[sub] = struct{}{}
+}
+
+// detachLocked 将订阅者移出基金的订阅索引，最后一个订阅者离开后续传事件再保留 streamResumeWindow（调用方需持有 mutex）
+func (hub *streamHub) detachLocked(sub *StreamSubscription, code string) {
+	stream, exists := hub.codes[code]
+	if !exists {
+		return
+	}
+	delete(stream.subscribers, sub)
+	if len(stream.subscribers) == 0 {
+		stream.idleSince = hub.now()
+	}
+}
+
+// pruneLocked 清理没有订阅者超过 streamResumeWindow 的基金，每分钟最多扫描一次（调用方需持有 mutex）
+func (hub *streamHub) pruneLocked() {
+	now := hub.now()
+	if now.Sub(hub.prunedAt) < time.Minute {
+		return
+	}
+	hub.prunedAt = now
+	for code, stream := range hub.codes {
+		if len(stream.subscribers) == 0 && now.Sub(stream.idleSince) > streamResumeWindow {
+			delete(hub.codes, code)
+		}
+	}
+}
+
+// removeLocked 移除订阅者并结束订阅（调用方需持有 mutex）
+func (hub *streamHub) removeLocked(sub *StreamSubscription) {
+	if _, exists := hub.subscribers[sub]; !exists {
+		return
+	}
+	delete(hub.subscribers, sub)
+	for code := range sub.codes {
+		hub.detachLocked(sub, code)
+	}
+	sub.mutex.Lock()
+	sub.closed = true
+	sub.mutex.Unlock()
+	close(sub.done)
+}
+
+// closeAll 服务停止时结束全部订阅
+func (hub *streamHub) closeAll() {
+	hub.mutex.Lock()
+	defer hub.mutex.Unlock()
+	for sub := range hub.subscribers {
+		hub.removeLocked(sub)
+	}
+}
+
+// eventID 序号对应的事件ID
+func (hub *streamHub) eventID(seq uint64) string {
+	return hub.epoch + "-" + strconv.FormatUint(seq, 10)
+}
+
+// contains 字符串列表是否包含指定值
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
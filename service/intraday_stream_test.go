@@ -0,0 +1,274 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"fund/model"
+	"testing"
+	"time"
+)
+
+// streamPoint 测试用的数据点写入事件
+func streamPoint(code, time string) PointEvent {
+	return PointEvent{Code: code, Date: "2025-07-01", Point: model.IntradayPoint{Time: time, Value: 1.12}}
+}
+
+// TestSubscribePoints 测试逐条推送的快照、按基金过滤、断线续传和慢速订阅者断开
+func TestSubscribePoints(t *testing.T) {
+	_, provider := newFakeUpstream(t)
+	intradayService := newTestIntradayService(t, provider)
+	intradayService.SetWatchSource(func() []string { return []string{"000001"} })
+	if err := intradayService.LoadAllFunds(); err != nil {
+		t.Fatal(err)
+	}
+	intradayService.fetchWatchListRealtime()
+
+	tooMany := make([]string, MaxStreamCodes+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("%06d", i)
+	}
+	for _, codes := range [][]string{nil, {"abc"}, tooMany} {
+		if _, err := intradayService.SubscribePoints(codes, ""); !errors.Is(err, ErrInvalidSubscription) {
+			t.Errorf("❌ 订阅 %d 只基金应返回 ErrInvalidSubscription: %v", len(codes), err)
+		}
+	}
+
+	// 新连接先收到当日已有数据，没有数据的基金不推送快照
+	sub, err := intradayService.SubscribePoints([]string{"000001", "110022"}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-sub.Ready()
+	events := sub.Drain()
+	if len(events) != 1 {
+		t.Fatalf("❌ 快照事件数量异常: %+v", events)
+	}
+	snapshot, ok := events[0].Data.(*model.FundIntradayData)
+	if events[0].Type != StreamEventSnapshot || !ok || snapshot.Code != "000001" || len(snapshot.Data) != 1 || snapshot.Data[0].Value != 1.1166 {
+		t.Fatalf("❌ 快照事件异常: %+v", events[0])
+	}
+
+	// 只推送订阅的基金，逐条推送不合并
+	intradayService.notifyPoints(streamPoint("000001", "10:31"), streamPoint("161725", "10:31"),
+		streamPoint("110022", "10:31"), streamPoint("000001", "10:31"))
+	events = sub.Drain()
+	if len(events) != 3 {
+		t.Fatalf("❌ 数据点事件数量异常: %+v", events)
+	}
+	for i, code := range []string{"000001", "110022", "000001"} {
+		if data, ok := events[i].Data.(PointEvent); events[i].Type != StreamEventPoint || !ok || data.Code != code {
+			t.Errorf("❌ 数据点事件异常: %+v", events[i])
+		}
+	}
+	lastID := events[1].ID
+	sub.Close()
+	sub.Close()
+	if n := intradayService.StreamSubscribers(); n != 0 {
+		t.Errorf("❌ 取消订阅后在线连接数 %d", n)
+	}
+
+	// 断线期间的数据点在重连时补发
+	intradayService.notifyPoints(streamPoint("000001", "10:32"), streamPoint("110022", "10:32"), streamPoint("000001", "10:33"))
+	sub, err = intradayService.SubscribePoints([]string{"000001"}, lastID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	events = sub.Drain()
+	if len(events) != 3 {
+		t.Fatalf("❌ 续传事件数量异常: %+v", events)
+	}
+	for i, want := range []string{"10:31", "10:32", "10:33"} {
+		if data, _ := events[i].Data.(PointEvent); events[i].Type != StreamEventPoint || data.Point.Time != want {
+			t.Errorf("❌ 续传事件异常, 期望 %s: %+v", want, events[i])
+		}
+	}
+	sub.Close()
+
+	// 未订阅的基金不记录续传事件，全市场采集的大量数据点不影响续传
+	flood := make([]PointEvent, 4*streamHistorySize)
+	for i := range flood {
+		flood[i] = streamPoint(fmt.Sprintf("%06d", 200000+i), "10:34")
+	}
+	intradayService.notifyPoints(flood...)
+	sub, err = intradayService.SubscribePoints([]string{"000001"}, lastID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if events := sub.Drain(); len(events) != 3 || events[0].Type != StreamEventPoint {
+		t.Errorf("❌ 未订阅基金的数据点不应影响续传: %+v", events)
+	}
+	sub.Close()
+
+	// 超出该基金保留范围的事件ID重新推送快照
+	overflow := make([]PointEvent, 2*streamHistorySize)
+	for i := range overflow {
+		overflow[i] = streamPoint("000001", "10:35")
+	}
+	intradayService.notifyPoints(overflow...)
+	for _, id := range []string{"other-1", lastID, "garbage"} {
+		sub, err := intradayService.SubscribePoints([]string{"000001"}, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if events := sub.Drain(); len(events) != 1 || events[0].Type != StreamEventSnapshot {
+			t.Errorf("❌ 事件ID %q 无法续传时应推送快照: %+v", id, events)
+		}
+		sub.Close()
+	}
+
+	// 基金没有订阅者超过保留时间后不再记录续传事件
+	sub, err = intradayService.SubscribePoints([]string{"000001"}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lastID = sub.Drain()[0].ID
+	sub.Close()
+	now := tradingClock()
+	intradayService.stream.now = func() time.Time { return now }
+	now = now.Add(streamResumeWindow + 2*time.Minute)
+	intradayService.notifyPoints(streamPoint("000001", "10:36"))
+	if _, exists := intradayService.stream.codes["000001"]; exists {
+		t.Error("❌ 空闲超出保留时间的基金应被清理")
+	}
+	sub, err = intradayService.SubscribePoints([]string{"000001"}, lastID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if events := sub.Drain(); len(events) != 1 || events[0].Type != StreamEventSnapshot {
+		t.Errorf("❌ 清理后的基金应推送快照: %+v", events)
+	}
+	sub.Close()
+
+	// 消费过慢的订阅者在积压超出上限后断开
+	sub, err = intradayService.SubscribePoints([]string{"110022"}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	points := make([]PointEvent, streamBufferSize+1)
+	for i := range points {
+		points[i] = streamPoint("110022", "10:34")
+	}
+	intradayService.notifyPoints(points...)
+	<-sub.Done()
+	if received := len(sub.Drain()); received != streamBufferSize || intradayService.StreamSubscribers() != 0 {
+		t.Errorf("❌ 慢速订阅者应在积压 %d 条后断开: %d 条, 在线 %d", streamBufferSize, received, intradayService.StreamSubscribers())
+	}
+}
+
+// TestSubscribeLive 测试合并推送订阅的运行时订阅、主题过滤和慢速消费者的数据点合并
+func TestSubscribeLive(t *testing.T) {
+	_, provider := newFakeUpstream(t)
+	intradayService := newTestIntradayService(t, provider)
+	intradayService.SetWatchSource(func() []string { return []string{"000001"} })
+	if err := intradayService.LoadAllFunds(); err != nil {
+		t.Fatal(err)
+	}
+	intradayService.fetchWatchListRealtime()
+
+	anonymous, err := intradayService.SubscribeLive(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer anonymous.Close()
+	if err := anonymous.Subscribe(nil, []string{TopicAlerts}); !errors.Is(err, ErrInvalidSubscription) {
+		t.Errorf("❌ 未登录订阅提醒应返回 ErrInvalidSubscription: %v", err)
+	}
+	if err := anonymous.Subscribe([]string{"000001"}, []string{"trades"}); !errors.Is(err, ErrInvalidSubscription) {
+		t.Errorf("❌ 未知主题应返回 ErrInvalidSubscription: %v", err)
+	}
+
+	sub, err := intradayService.SubscribeLive(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sub.Close()
+	if events := sub.Drain(); len(events) != 0 {
+		t.Errorf("❌ 订阅前不应推送: %+v", events)
+	}
+
+	// 订阅时推送快照和最近一次采集状态
+	if err := sub.Subscribe([]string{"000001", "110022"}, []string{TopicStatus, TopicAlerts}); err != nil {
+		t.Fatal(err)
+	}
+	events := sub.Drain()
+	if len(events) != 2 || events[0].Type != StreamEventSnapshot || events[1].Type != StreamEventStatus {
+		t.Fatalf("❌ 订阅后的初始事件异常: %+v", events)
+	}
+	if status := events[1].Data.(model.CollectorStatus); status.Mode != "watch" || status.State != "done" || status.Success != 1 {
+		t.Errorf("❌ 采集状态异常: %+v", status)
+	}
+	if codes, topics := sub.Subscriptions(); len(codes) != 2 || len(topics) != 2 {
+		t.Errorf("❌ 订阅列表异常: %v %v", codes, topics)
+	}
+
+	// 未取走的同一基金数据点只保留最新一条，提醒不合并且只推送给所属用户
+	for _, time := range []string{"10:31", "10:32", "10:33"} {
+		intradayService.notifyPoints(streamPoint("000001", time), streamPoint("110022", time))
+	}
+	intradayService.PublishAlert(model.Alert{ID: 1, UserID: 1, Message: "a"})
+	intradayService.PublishAlert(model.Alert{ID: 2, UserID: 2, Message: "b"})
+	intradayService.PublishAlert(model.Alert{ID: 3, UserID: 1, Message: "c"})
+	events = sub.Drain()
+	if len(events) != 4 || sub.Coalesced() != 4 {
+		t.Fatalf("❌ 合并后的事件异常: %+v, 合并 %d", events, sub.Coalesced())
+	}
+	for i, code := range []string{"000001", "110022"} {
+		if data := events[i].Data.(PointEvent); data.Code != code || data.Point.Time != "10:33" {
+			t.Errorf("❌ 合并后应保留最新数据点: %+v", data)
+		}
+	}
+	if events[2].Type != StreamEventAlert || events[2].Data.(model.Alert).ID != 1 || events[3].Data.(model.Alert).ID != 3 {
+		t.Errorf("❌ 提醒事件异常: %+v", events[2:])
+	}
+
+	// 取消订阅后不再推送
+	sub.Unsubscribe([]string{"000001"}, []string{TopicAlerts})
+	intradayService.notifyPoints(streamPoint("000001", "10:34"), streamPoint("110022", "10:34"))
+	intradayService.PublishAlert(model.Alert{ID: 4, UserID: 1})
+	intradayService.fetchWatchListRealtime()
+	events = sub.Drain()
+	if len(events) != 2 || events[0].Data.(PointEvent).Code != "110022" || events[1].Type != StreamEventStatus {
+		t.Errorf("❌ 取消订阅后的事件异常: %+v", events)
+	}
+	if len(anonymous.Drain()) != 0 {
+		t.Errorf("❌ 未订阅任何内容的连接不应推送")
+	}
+
+	tooMany := make([]string, MaxStreamCodes)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("%06d", i+1)
+	}
+	if err := sub.Subscribe(tooMany, nil); !errors.Is(err, ErrInvalidSubscription) {
+		t.Errorf("❌ 超出订阅上限应返回 ErrInvalidSubscription: %v", err)
+	}
+	if intradayService.CollectorStatus() == nil || intradayService.StreamSubscribers() != 2 {
+		t.Errorf("❌ 服务状态异常: %+v %d", intradayService.CollectorStatus(), intradayService.StreamSubscribers())
+	}
+}
+
+// TestSubscribeAfterDisconnect 测试积压超出上限断开后的订阅不能再加入基金索引
+func TestSubscribeAfterDisconnect(t *testing.T) {
+	_, provider := newFakeUpstream(t)
+	intradayService := newTestIntradayService(t, provider)
+
+	sub, err := intradayService.SubscribeLive(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sub.Subscribe(nil, []string{TopicAlerts}); err != nil {
+		t.Fatal(err)
+	}
+	// 提醒不合并，积压超出上限后断开
+	for i := 0; i <= streamBufferSize; i++ {
+		intradayService.PublishAlert(model.Alert{ID: int64(i), UserID: 1})
+	}
+	<-sub.Done()
+
+	if err := sub.Subscribe([]string{"000001"}, nil); !errors.Is(err, ErrSubscriptionClosed) {
+		t.Errorf("❌ 已断开的订阅应返回 ErrSubscriptionClosed: %v", err)
+	}
+	sub.Close()
+	if n := len(intradayService.stream.codes); n != 0 {
+		t.Errorf("❌ 基金索引中不应残留已断开的订阅: %d 只基金", n)
+	}
+}
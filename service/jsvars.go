@@ -0,0 +1,449 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxJSDepth JS 字面量最大嵌套层数
+const maxJSDepth = 100
+
+// jsDocument 从JS文件中提取的全部变量声明
+// 变量值为 JSON 兼容的 Go 类型：map[string]interface{}、[]interface{}、string、float64、bool、nil
+type jsDocument struct {
+	parser string                 // 解析器（上游接口）名称，用于生成 *ParseError
+	vars   map[string]interface{} // 变量名 -> 值
+	errs   map[string]error       // 无法解析的变量名 -> 原因
+}
+
+// parseJSVars 读取JS文件中所有 `var X = <字面量>;` 声明
+// 只支持字面量（对象、数组、字符串、数字、布尔、null），
+// 对象键可以不加引号，字符串可以使用单引号，允许尾随逗号和注释；
+// 单个声明无法解析时记录错误并跳过，不影响其他变量
+func parseJSVars(parser, content string) *jsDocument {
+	doc := &jsDocument{
+		parser: parser,
+		vars:   make(map[string]interface{}),
+		errs:   make(map[string]error),
+	}
+
+	s := &jsScanner{src: content}
+	for {
+		s.skipSpace()
+		if s.eof() {
+			break
+		}
+
+		keyword := s.peekIdent()
+		if keyword != "var" && keyword != "let" && keyword != "const" {
+			// 非变量声明语句，跳过
+			s.skipStatement()
+			continue
+		}
+		s.pos += len(keyword)
+
+		// 解析声明列表: var a = 1, b = 2;
+		for {
+			s.skipSpace()
+			name := s.peekIdent()
+			if name == "" {
+				s.skipStatement()
+				break
+			}
+			s.pos += len(name)
+
+			s.skipSpace()
+			if !s.consume('=') {
+				// 只声明未赋值
+				doc.vars[name] = nil
+			} else {
+				value, err := s.parseValue(0)
+				if err != nil {
+					doc.errs[name] = err
+					delete(doc.vars, name)
+					s.skipStatement()
+					break
+				}
+				doc.vars[name] = value
+				delete(doc.errs, name)
+			}
+
+			s.skipSpace()
+			if s.consume(',') {
+				continue
+			}
+			s.consume(';')
+			break
+		}
+	}
+
+	return doc
+}
+
+// value 获取变量值，不存在或无法解析时返回 *ParseError
+func (d *jsDocument) value(name string) (interface{}, error) {
+	if err, exists := d.errs[name]; exists {
+		return nil, invalidField(d.parser, name, err)
+	}
+	value, exists := d.vars[name]
+	if !exists {
+		return nil, newParseError(d.parser, name, ErrFieldMissing)
+	}
+	return value, nil
+}
+
+// str 获取字符串变量（数字会转换为字符串）
+func (d *jsDocument) str(name string) (string, error) {
+	value, err := d.value(name)
+	if err != nil {
+		return "", err
+	}
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case nil:
+		return "", newParseError(d.parser, name, ErrFieldMissing)
+	}
+	return "", invalidField(d.parser, name, fmt.Errorf("期望字符串, 实际为 %T", value))
+}
+
+// decode 将变量解码到 Go 类型（借助 JSON 编解码）
+func (d *jsDocument) decode(name string, out interface{}) error {
+	value, err := d.value(name)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return invalidField(d.parser, name, err)
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return invalidField(d.parser, name, err)
+	}
+	return nil
+}
+
+// jsScanner JS 源码扫描器
+type jsScanner struct {
+	src string
+	pos int
+}
+
+// eof 是否已到结尾
+func (s *jsScanner) eof() bool {
+	return s.pos >= len(s.src)
+}
+
+// consume 如果下一个字符是 c 则跳过它
+func (s *jsScanner) consume(c byte) bool {
+	if !s.eof() && s.src[s.pos] == c {
+		s.pos++
+		return true
+	}
+	return false
+}
+
+// skipSpace 跳过空白、BOM 和注释
+func (s *jsScanner) skipSpace() {
+	for !s.eof() {
+		switch {
+		case s.src[s.pos] == ' ' || s.src[s.pos] == '\t' || s.src[s.pos] == '\n' || s.src[s.pos] == '\r':
+			s.pos++
+		case strings.HasPrefix(s.src[s.pos:], "\uFEFF"):
+			s.pos += len("\uFEFF")
+		case strings.HasPrefix(s.src[s.pos:], "//"):
+			end := strings.IndexByte(s.src[s.pos:], '\n')
+			if end < 0 {
+				s.pos = len(s.src)
+			} else {
+				s.pos += end + 1
+			}
+		case strings.HasPrefix(s.src[s.pos:], "/*"):
+			end := strings.Index(s.src[s.pos+2:], "*/")
+			if end < 0 {
+				s.pos = len(s.src)
+			} else {
+				s.pos += end + 4
+			}
+		default:
+			return
+		}
+	}
+}
+
+// peekIdent 读取当前位置的标识符（不移动位置）
+func (s *jsScanner) peekIdent() string {
+	end := s.pos
+	for end < len(s.src) {
+		c := s.src[end]
+		if c == '_' || c == '$' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (end > s.pos && c >= '0' && c <= '9') {
+			end++
+			continue
+		}
+		break
+	}
+	return s.src[s.pos:end]
+}
+
+// skipStatement 跳过当前语句（直到顶层的分号或换行），字符串中的分号不计
+func (s *jsScanner) skipStatement() {
+	depth := 0
+	start := s.pos
+	for !s.eof() {
+		c := s.src[s.pos]
+		switch {
+		case c == '"' || c == '\'':
+			if _, err := s.parseString(); err != nil {
+				return
+			}
+			continue
+		case c == '{' || c == '[' || c == '(':
+			depth++
+		case c == '}' || c == ']' || c == ')':
+			depth--
+		case (c == ';' || c == '\n') && depth <= 0:
+			s.pos++
+			return
+		}
+		s.pos++
+	}
+	if s.pos == start {
+		s.pos++
+	}
+}
+
+// parseValue 解析一个字面量
+func (s *jsScanner) parseValue(depth int) (interface{}, error) {
+	if depth > maxJSDepth {
+		return nil, fmt.Errorf("嵌套层数超过 %d", maxJSDepth)
+	}
+
+	s.skipSpace()
+	if s.eof() {
+		return nil, errors.New("意外的文件结尾")
+	}
+
+	c := s.src[s.pos]
+	switch {
+	case c == '{':
+		return s.parseObject(depth)
+	case c == '[':
+		return s.parseArray(depth)
+	case c == '"' || c == '\'':
+		return s.parseString()
+	case c == '-' || c == '+' || c == '.' || (c >= '0' && c <= '9'):
+		return s.parseNumber()
+	}
+
+	ident := s.peekIdent()
+	switch ident {
+	case "true":
+		s.pos += len(ident)
+		return true, nil
+	case "false":
+		s.pos += len(ident)
+		return false, nil
+	case "null", "undefined", "NaN", "Infinity":
+		s.pos += len(ident)
+		return nil, nil
+	}
+
+	return nil, fmt.Errorf("位置 %d 不支持的表达式 %q", s.pos, s.snippet())
+}
+
+// parseObject 解析对象字面量
+func (s *jsScanner) parseObject(depth int) (interface{}, error) {
+	s.pos++ // {
+	result := make(map[string]interface{})
+	for {
+		s.skipSpace()
+		if s.consume('}') {
+			return result, nil
+		}
+
+		// 键：字符串、标识符或数字
+		var key string
+		if s.eof() {
+			return nil, errors.New("对象未闭合")
+		}
+		switch c := s.src[s.pos]; {
+		case c == '"' || c == '\'':
+			k, err := s.parseString()
+			if err != nil {
+				return nil, err
+			}
+			key = k
+		case c >= '0' && c <= '9':
+			n, err := s.parseNumber()
+			if err != nil {
+				return nil, err
+			}
+			key = strconv.FormatFloat(n.(float64), 'f', -1, 64)
+		default:
+			key = s.peekIdent()
+			if key == "" {
+				return nil, fmt.Errorf("位置 %d 期望对象键, 实际为 %q", s.pos, s.snippet())
+			}
+			s.pos += len(key)
+		}
+
+		s.skipSpace()
+		if !s.consume(':') {
+			return nil, fmt.Errorf("位置 %d 期望 ':'", s.pos)
+		}
+
+		value, err := s.parseValue(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		result[key] = value
+
+		s.skipSpace()
+		if s.consume(',') {
+			continue
+		}
+		if s.consume('}') {
+			return result, nil
+		}
+		return nil, fmt.Errorf("位置 %d 期望 ',' 或 '}'", s.pos)
+	}
+}
+
+// parseArray 解析数组字面量
+func (s *jsScanner) parseArray(depth int) (interface{}, error) {
+	s.pos++ // [
+	result := make([]interface{}, 0)
+	for {
+		s.skipSpace()
+		if s.consume(']') {
+			return result, nil
+		}
+
+		value, err := s.parseValue(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, value)
+
+		s.skipSpace()
+		if s.consume(',') {
+			continue
+		}
+		if s.consume(']') {
+			return result, nil
+		}
+		return nil, fmt.Errorf("位置 %d 期望 ',' 或 ']'", s.pos)
+	}
+}
+
+// parseString 解析单引号或双引号字符串
+func (s *jsScanner) parseString() (string, error) {
+	quote := s.src[s.pos]
+	s.pos++
+
+	var sb strings.Builder
+	for !s.eof() {
+		c := s.src[s.pos]
+		switch {
+		case c == quote:
+			s.pos++
+			return sb.String(), nil
+		case c == '\n':
+			return "", errors.New("字符串未闭合")
+		case c != '\\':
+			sb.WriteByte(c)
+			s.pos++
+			continue
+		}
+
+		// 转义字符
+		s.pos++
+		if s.eof() {
+			break
+		}
+		e := s.src[s.pos]
+		s.pos++
+		switch e {
+		case 'n':
+			sb.WriteByte('\n')
+		case 't':
+			sb.WriteByte('\t')
+		case 'r':
+			sb.WriteByte('\r')
+		case 'b':
+			sb.WriteByte('\b')
+		case 'f':
+			sb.WriteByte('\f')
+		case 'v':
+			sb.WriteByte('\v')
+		case '0':
+			sb.WriteByte(0)
+		case '\n':
+			// 续行
+		case 'u', 'x':
+			size := 4
+			if e == 'x' {
+				size = 2
+			}
+			if s.pos+size > len(s.src) {
+				return "", errors.New("转义序列不完整")
+			}
+			code, err := strconv.ParseUint(s.src[s.pos:s.pos+size], 16, 32)
+			if err != nil {
+				return "", fmt.Errorf("非法转义序列 \\%c%s", e, s.src[s.pos:s.pos+size])
+			}
+			s.pos += size
+			r := rune(code)
+			if !utf8.ValidRune(r) {
+				r = utf8.RuneError
+			}
+			sb.WriteRune(r)
+		default:
+			sb.WriteByte(e)
+		}
+	}
+	return "", errors.New("字符串未闭合")
+}
+
+// parseNumber 解析数字
+func (s *jsScanner) parseNumber() (interface{}, error) {
+	start := s.pos
+	if s.src[s.pos] == '-' || s.src[s.pos] == '+' {
+		s.pos++
+	}
+	if ident := s.peekIdent(); ident == "Infinity" {
+		s.pos += len(ident)
+		return nil, nil
+	}
+	for !s.eof() {
+		c := s.src[s.pos]
+		if (c >= '0' && c <= '9') || c == '.' || c == 'e' || c == 'E' ||
+			((c == '-' || c == '+') && (s.src[s.pos-1] == 'e' || s.src[s.pos-1] == 'E')) {
+			s.pos++
+			continue
+		}
+		break
+	}
+
+	value, err := strconv.ParseFloat(s.src[start:s.pos], 64)
+	if err != nil || math.IsInf(value, 0) || math.IsNaN(value) {
+		return nil, fmt.Errorf("非法数字 %q", s.src[start:s.pos])
+	}
+	return value, nil
+}
+
+// snippet 当前位置附近的片段（用于错误信息）
+func (s *jsScanner) snippet() string {
+	end := s.pos + 20
+	if end > len(s.src) {
+		end = len(s.src)
+	}
+	return s.src[s.pos:end]
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// TestParseJSVars 校验JS变量提取器对各种字面量写法的容错
+func TestParseJSVars(t *testing.T) {
+	content := "\uFEFF/*基金数据*/var fS_name = \"易方达\\\"蓝筹\\\"精选\";var fS_code = '005827'; // 代码\n" +
+		"var a = 1, b = [[1, [2, 3]], {x: 1.5e2, 'y': \"]\", \"z\": null,},];\n" +
+		"var Data_grandTotal = [{name:'本基金',data:[[1609459200000,-0.5]]}];\n" +
+		"function foo() { var inner = 1; return \";\"; }\n" +
+		"var broken = new Date();var after = true;\n" +
+		"var empty;"
+
+	doc := parseJSVars("pingzhongdata", content)
+
+	if name, err := doc.str("fS_name"); err != nil || name != `易方达"蓝筹"精选` {
+		t.Errorf("fS_name = %q, %v", name, err)
+	}
+	if code, err := doc.str("fS_code"); err != nil || code != "005827" {
+		t.Errorf("fS_code = %q, %v", code, err)
+	}
+	if a, err := doc.str("a"); err != nil || a != "1" {
+		t.Errorf("a = %q, %v", a, err)
+	}
+
+	wantB := []interface{}{
+		[]interface{}{1.0, []interface{}{2.0, 3.0}},
+		map[string]interface{}{"x": 150.0, "y": "]", "z": nil},
+	}
+	if b, err := doc.value("b"); err != nil || !reflect.DeepEqual(b, wantB) {
+		t.Errorf("b = %#v, %v", b, err)
+	}
+
+	var grandTotal []struct {
+		Name string      `json:"name"`
+		Data [][]float64 `json:"data"`
+	}
+	if err := doc.decode("Data_grandTotal", &grandTotal); err != nil || len(grandTotal) != 1 ||
+		grandTotal[0].Name != "本基金" || grandTotal[0].Data[0][1] != -0.5 {
+		t.Errorf("Data_grandTotal = %+v, %v", grandTotal, err)
+	}
+
+	// 函数体内的声明不应泄漏到顶层
+	if _, err := doc.value("inner"); !errors.Is(err, ErrFieldMissing) {
+		t.Errorf("inner 应不存在, err = %v", err)
+	}
+	// 无法解析的声明不影响后续变量
+	if _, err := doc.value("broken"); !errors.Is(err, ErrFieldInvalid) {
+		t.Errorf("broken 应为格式错误, err = %v", err)
+	}
+	if after, err := doc.value("after"); err != nil || after != true {
+		t.Errorf("after = %v, %v", after, err)
+	}
+	if _, err := doc.str("empty"); !errors.Is(err, ErrFieldMissing) {
+		t.Errorf("empty 应为缺失, err = %v", err)
+	}
+}
@@ -0,0 +1,239 @@
+package service
+
+import (
+	"fmt"
+	"fund/model"
+	"log"
+	"sort"
+	"time"
+)
+
+const (
+	navPublishHour     = 20               // 基金净值公布时间（北京时间），之后刷新当天净值
+	navRetryInterval   = 10 * time.Minute // 刷新失败后的重试间隔，期间使用缓存数据
+	navIncrementalDays = 30               // 缓存最新净值距今不超过该天数时增量刷新，否则全量刷新
+	// defaultNAVCacheSize 内存中缓存历史净值的基金数量上限，超出时淘汰最久未使用的基金（存储中的数据保留）
+	defaultNAVCacheSize = 200
+)
+
+// navCacheEntry 单只基金的历史净值缓存
+// trend 刷新时整体替换，不在原切片上修改，调用方可以安全地持有旧数据
+type navCacheEntry struct {
+	trend       *model.FundTrend
+	refreshedAt time.Time // 最近一次成功刷新时间
+	attemptedAt time.Time // 最近一次尝试刷新时间
+	lastUsed    uint64    // 最近一次访问的序号
+}
+
+// navCall 进行中的历史净值刷新，同一基金的并发请求等待并共享同一次结果
+type navCall struct {
+	done  chan struct{}
+	trend *model.FundTrend
+	err   error
+}
+
+// lastNAVPublication 计算 now 之前最近一次净值公布时间（工作日 20:00）
+func lastNAVPublication(now time.Time) time.Time {
+	t := now.In(chinaZone)
+	publication := time.Date(t.Year(), t.Month(), t.Day(), navPublishHour, 0, 0, 0, chinaZone)
+	if t.Before(publication) {
+		publication = publication.AddDate(0, 0, -1)
+	}
+	for publication.Weekday() == time.Saturday || publication.Weekday() == time.Sunday {
+		publication = publication.AddDate(0, 0, -1)
+	}
+	return publication
+}
+
+// navComplete 历史净值是否已包含 at 之前最近一次公布的净值
+// 部分基金 21:00-23:00 才公布净值，上游尚未更新时不能视为已刷新
+func navComplete(trend *model.FundTrend, at time.Time) bool {
+	if trend == nil || len(trend.Data) == 0 {
+		return false
+	}
+	return trend.Data[len(trend.Data)-1].Date >= lastNAVPublication(at).Format("2006-01-02")
+}
+
+// cachedNAV 获取内存缓存，不存在时从存储加载
+func (s *FundService) cachedNAV(fundCode string) *navCacheEntry {
+	s.navMutex.Lock()
+	entry, exists := s.navCache[fundCode]
+	if exists {
+		s.navUses++
+		entry.lastUsed = s.navUses
+	}
+	s.navMutex.Unlock()
+	if exists || s.fundStore == nil {
+		return entry
+	}
+
+	stored, updatedAt, err := s.fundStore.LoadNAVHistory(fundCode)
+	if err != nil {
+		log.Printf("⚠️  读取基金 %s 已保存的历史净值失败: %v", fundCode, err)
+		return nil
+	}
+	if stored == nil || len(stored.Data) == 0 {
+		return nil
+	}
+	if stored.Data[len(stored.Data)-1].AccValue == 0 {
+		// 旧版本保存的数据没有累计净值和分红信息，重新全量下载
+		return nil
+	}
+
+	entry = &navCacheEntry{trend: stored}
+	if navComplete(stored, updatedAt) {
+		entry.refreshedAt = updatedAt
+	}
+	s.navMutex.Lock()
+	s.putNAVLocked(fundCode, entry)
+	s.navMutex.Unlock()
+	return entry
+}
+
+// putNAVLocked 写入缓存（调用方需持有 navMutex），超出上限时淘汰最久未使用的基金
+func (s *FundService) putNAVLocked(fundCode string, entry *navCacheEntry) {
+	s.navUses++
+	entry.lastUsed = s.navUses
+	s.navCache[fundCode] = entry
+
+	for len(s.navCache) > s.navCacheSize && s.navCacheSize > 0 {
+		oldestCode := ""
+		var oldest uint64
+		for code, cached := range s.navCache {
+			if oldestCode == "" || cached.lastUsed < oldest {
+				oldestCode, oldest = code, cached.lastUsed
+			}
+		}
+		delete(s.navCache, oldestCode)
+	}
+}
+
+// fetchNAVHistory 获取全部历史净值（带缓存）
+// 每个交易日净值公布后刷新一次：已有缓存时只下载新增净值，上游失败时返回缓存数据
+func (s *FundService) fetchNAVHistory(fundCode string) (*model.FundTrend, error) {
+	now := s.now()
+	entry := s.cachedNAV(fundCode)
+
+	if entry != nil {
+		s.navMutex.Lock()
+		trend := entry.trend
+		fresh := !entry.refreshedAt.Before(lastNAVPublication(now))
+		retrying := now.Sub(entry.attemptedAt) < navRetryInterval
+		if !fresh && !retrying {
+			entry.attemptedAt = now
+		}
+		s.navMutex.Unlock()
+
+		if fresh || retrying {
+			return trend, nil
+		}
+	}
+
+	trend, err := s.refreshNAVOnce(fundCode, entry, now)
+	if err != nil {
+		if entry == nil {
+			return nil, err
+		}
+		log.Printf("⚠️  刷新基金 %s 历史净值失败: %v, 使用缓存数据", fundCode, err)
+		s.navMutex.Lock()
+		defer s.navMutex.Unlock()
+		return entry.trend, nil
+	}
+	return trend, nil
+}
+
+// previousNAV 获取指定日期之前最近一个交易日的官方净值，没有时返回 0
+func (s *FundService) previousNAV(fundCode, date string) (float64, error) {
+	history, err := s.fetchNAVHistory(fundCode)
+	if err != nil {
+		return 0, fmt.Errorf("获取基金 %s 净值失败: %v", fundCode, err)
+	}
+	i := sort.Search(len(history.Data), func(i int) bool { return history.Data[i].Date >= date })
+	if i == 0 {
+		return 0, nil
+	}
+	return history.Data[i-1].Value, nil
+}
+
+// refreshNAVOnce 同一基金同时只向上游刷新一次，并发的请求等待并共享结果
+func (s *FundService) refreshNAVOnce(fundCode string, entry *navCacheEntry, now time.Time) (*model.FundTrend, error) {
+	s.navMutex.Lock()
+	if call, exists := s.navLoading[fundCode]; exists {
+		s.navMutex.Unlock()
+		<-call.done
+		return call.trend, call.err
+	}
+	call := &navCall{done: make(chan struct{})}
+	s.navLoading[fundCode] = call
+	s.navMutex.Unlock()
+
+	call.trend, call.err = s.refreshNAV(fundCode, entry, now)
+
+	s.navMutex.Lock()
+	delete(s.navLoading, fundCode)
+	s.navMutex.Unlock()
+	close(call.done)
+	return call.trend, call.err
+}
+
+// refreshNAV 从上游刷新历史净值并更新缓存和存储
+func (s *FundService) refreshNAV(fundCode string, entry *navCacheEntry, now time.Time) (*model.FundTrend, error) {
+	var cached *model.FundTrend
+	if entry != nil {
+		s.navMutex.Lock()
+		cached = entry.trend
+		s.navMutex.Unlock()
+	}
+
+	var trend, changed *model.FundTrend
+	if since, ok := incrementalSince(cached, now); ok {
+		points, err := s.provider.FetchNAVSince(fundCode, since)
+		if err != nil {
+			return nil, err
+		}
+		data := make([]model.TrendPoint, 0, len(cached.Data)+len(points))
+		data = append(append(data, cached.Data...), points...)
+		trend = &model.FundTrend{Code: fundCode, Name: cached.Name, Period: "all", Data: data}
+		changed = &model.FundTrend{Code: fundCode, Name: cached.Name, Period: "all", Data: points}
+		log.Printf("🔄 基金 %s 历史净值增量刷新: 新增 %d 条", fundCode, len(points))
+	} else {
+		history, err := s.provider.FetchNAVHistory(fundCode)
+		if err != nil {
+			return nil, err
+		}
+		trend, changed = history, history
+	}
+
+	if s.fundStore != nil {
+		if err := s.fundStore.SaveNAVHistory(changed, now); err != nil {
+			log.Printf("⚠️  保存基金 %s 历史净值失败: %v", fundCode, err)
+		}
+	}
+
+	// 上游还没有最近一次公布的净值时保留原刷新时间，重试间隔后再刷新
+	refreshed := &navCacheEntry{trend: trend, attemptedAt: now}
+	if navComplete(trend, now) {
+		refreshed.refreshedAt = now
+	} else if entry != nil {
+		s.navMutex.Lock()
+		refreshed.refreshedAt = entry.refreshedAt
+		s.navMutex.Unlock()
+	}
+	s.navMutex.Lock()
+	s.putNAVLocked(fundCode, refreshed)
+	s.navMutex.Unlock()
+	return trend, nil
+}
+
+// incrementalSince 判断能否增量刷新，返回缓存中最新的净值日期
+func incrementalSince(cached *model.FundTrend, now time.Time) (string, bool) {
+	if cached == nil || len(cached.Data) == 0 {
+		return "", false
+	}
+	lastDate := cached.Data[len(cached.Data)-1].Date
+	last, err := time.ParseInLocation("2006-01-02", lastDate, chinaZone)
+	if err != nil || now.Sub(last) > navIncrementalDays*24*time.Hour {
+		return "", false
+	}
+	return lastDate, true
+}
@@ -0,0 +1,312 @@
+package service
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"fund/model"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/smtp"
+	"net/url"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// 通知渠道类型
+const (
+	ChannelWebhook  = "webhook"  // 通用 Webhook
+	ChannelEmail    = "email"    // 邮件
+	ChannelWeCom    = "wecom"    // 企业微信群机器人
+	ChannelDingTalk = "dingtalk" // 钉钉群机器人
+	ChannelFeishu   = "feishu"   // 飞书群机器人
+)
+
+// Notifier 通知发送器，每种渠道类型一个实现
+type Notifier interface {
+	// Send 将提醒发送到用户配置的渠道，失败时返回错误（由调用方决定是否重试）
+	Send(channel model.NotifyChannel, alert model.Alert) error
+}
+
+// notifyTimeout 单次发送超时
+const notifyTimeout = 10 * time.Second
+
+// ErrPrivateAddress 通知地址解析到内网、环回或链路本地地址
+var ErrPrivateAddress = errors.New("不允许向内网地址发送通知")
+
+// notifyHTTPClient 发送通知的 HTTP 客户端
+// 通知地址由用户填写，为避免被用来探测服务端所在内网：不跟随重定向、不使用环境代理，
+// allowPrivate 为 false 时按 DNS 解析后实际连接的地址拒绝内网、环回和链路本地地址
+func notifyHTTPClient(allowPrivate bool) *http.Client {
+	dialer := &net.Dialer{Timeout: notifyTimeout}
+	if !allowPrivate {
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !isPublicIP(ip) {
+				return fmt.Errorf("%w: %s", ErrPrivateAddress, host)
+			}
+			return nil
+		}
+	}
+	return &http.Client{
+		Timeout: notifyTimeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: notifyTimeout,
+			MaxIdleConns:        10,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// carrierGradeNAT 运营商级 NAT 地址段 100.64.0.0/10
+var carrierGradeNAT = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// isPublicIP 是否为公网单播地址
+func isPublicIP(ip net.IP) bool {
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+		if ip4[0] == 0 || carrierGradeNAT.Contains(ip4) {
+			return false
+		}
+	}
+	return ip.IsGlobalUnicast() && !ip.IsPrivate() && !ip.IsLoopback() && !ip.IsLinkLocalUnicast()
+}
+
+// alertText 通知正文
+func alertText(alert model.Alert) string {
+	return "【基金提醒】" + alert.Message
+}
+
+// privateNetworkSetter 可设置是否允许内网地址的发送器
+type privateNetworkSetter interface {
+	SetAllowPrivateNetwork(allow bool)
+}
+
+// WebhookNotifier 通用 Webhook：POST JSON {"event": "alert", "alert": {...}, "timestamp": <秒>}
+// 渠道配置了密钥时附带签名头 X-Fund-Signature: sha256=<hex>，
+// 签名内容为 "<X-Fund-Timestamp>.<请求体>" 的 HMAC-SHA256，接收方可据此校验来源并拒绝重放
+type WebhookNotifier struct {
+	client *http.Client
+}
+
+// NewWebhookNotifier 创建 Webhook 发送器（默认拒绝内网地址）
+func NewWebhookNotifier() *WebhookNotifier {
+	return &WebhookNotifier{client: notifyHTTPClient(false)}
+}
+
+// SetAllowPrivateNetwork 设置是否允许发送到内网地址（如局域网内自建的接收服务）
+func (n *WebhookNotifier) SetAllowPrivateNetwork(allow bool) {
+	n.client = notifyHTTPClient(allow)
+}
+
+// Send 发送 Webhook
+func (n *WebhookNotifier) Send(channel model.NotifyChannel, alert model.Alert) error {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	body, err := json.Marshal(map[string]interface{}{
+		"event":     "alert",
+		"alert":     alert,
+		"timestamp": timestamp,
+	})
+	if err != nil {
+		return fmt.Errorf("序列化通知失败: %v", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, channel.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("创建请求失败: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("X-Fund-Event", "alert")
+	req.Header.Set("X-Fund-Timestamp", timestamp)
+	if channel.Secret != "" {
+		req.Header.Set("X-Fund-Signature", "sha256="+WebhookSignature(channel.Secret, timestamp, body))
+	}
+
+	_, err = doNotifyRequest(n.client, req)
+	return err
+}
+
+// WebhookSignature 计算 Webhook 签名（十六进制 HMAC-SHA256）
+func WebhookSignature(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// BotNotifier 企业微信、钉钉、飞书群机器人（文本消息）
+// 钉钉、飞书的机器人开启“加签”时需在渠道中配置对应的密钥
+type BotNotifier struct {
+	kind   string
+	client *http.Client
+}
+
+// NewBotNotifier 创建群机器人发送器，kind 为 wecom/dingtalk/feishu（默认拒绝内网地址）
+func NewBotNotifier(kind string) *BotNotifier {
+	return &BotNotifier{kind: kind, client: notifyHTTPClient(false)}
+}
+
+// SetAllowPrivateNetwork 设置是否允许发送到内网地址
+func (n *BotNotifier) SetAllowPrivateNetwork(allow bool) {
+	n.client = notifyHTTPClient(allow)
+}
+
+// Send 发送群机器人消息
+func (n *BotNotifier) Send(channel model.NotifyChannel, alert model.Alert) error {
+	target := channel.URL
+	var payload map[string]interface{}
+	switch n.kind {
+	case ChannelWeCom:
+		payload = map[string]interface{}{"msgtype": "text", "text": map[string]string{"content": alertText(alert)}}
+	case ChannelDingTalk:
+		payload = map[string]interface{}{"msgtype": "text", "text": map[string]string{"content": alertText(alert)}}
+		if channel.Secret != "" {
+			// 加签: timestamp 毫秒 + "\n" + secret 的 HMAC-SHA256（以 secret 为密钥），Base64 后 URL 编码
+			timestamp := strconv.FormatInt(time.Now().UnixMilli(), 10)
+			mac := hmac.New(sha256.New, []byte(channel.Secret))
+			mac.Write([]byte(timestamp + "\n" + channel.Secret))
+			sign := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+			separator := "?"
+			if strings.Contains(target, "?") {
+				separator = "&"
+			}
+			target += separator + "timestamp=" + timestamp + "&sign=" + url.QueryEscape(sign)
+		}
+	case ChannelFeishu:
+		payload = map[string]interface{}{"msg_type": "text", "content": map[string]string{"text": alertText(alert)}}
+		if channel.Secret != "" {
+			// 加签: 以 timestamp 秒 + "\n" + secret 为密钥对空串做 HMAC-SHA256，Base64 编码
+			timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+			mac := hmac.New(sha256.New, []byte(timestamp+"\n"+channel.Secret))
+			payload["timestamp"] = timestamp
+			payload["sign"] = base64.StdEncoding.EncodeToString(mac.Sum(nil))
+		}
+	default:
+		return fmt.Errorf("不支持的机器人类型: %s", n.kind)
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("序列化通知失败: %v", err)
+	}
+	req, err := http.NewRequest(http.MethodPost, target, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("创建请求失败: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	respBody, err := doNotifyRequest(n.client, req)
+	if err != nil {
+		return err
+	}
+
+	// 机器人接口在 HTTP 200 中返回业务错误码: 企业微信/钉钉 errcode，飞书 code
+	// 只记录错误码，响应内容不写入投递记录
+	var result struct {
+		ErrCode *int `json:"errcode"`
+		Code    *int `json:"code"`
+	}
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return errors.New("机器人响应格式错误")
+	}
+	if result.ErrCode != nil && *result.ErrCode != 0 {
+		return fmt.Errorf("机器人返回错误码 %d", *result.ErrCode)
+	}
+	if result.Code != nil && *result.Code != 0 {
+		return fmt.Errorf("机器人返回错误码 %d", *result.Code)
+	}
+	return nil
+}
+
+// doNotifyRequest 发送请求，非 2xx 响应（包括重定向）返回错误
+// 错误中只包含状态码，响应内容不会出现在投递记录和接口返回中
+func doNotifyRequest(client *http.Client, req *http.Request) ([]byte, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		if errors.Is(err, ErrPrivateAddress) {
+			return nil, ErrPrivateAddress
+		}
+		return nil, fmt.Errorf("请求失败: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("HTTP %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if err != nil {
+		return nil, fmt.Errorf("读取响应失败: %v", err)
+	}
+	return body, nil
+}
+
+// SMTPConfig 发送提醒邮件的 SMTP 服务器
+type SMTPConfig struct {
+	Addr     string // 服务器地址 host:port
+	Username string // 登录用户名（为空时不认证）
+	Password string // 登录密码
+	From     string // 发件人
+}
+
+// EmailNotifier 邮件发送器，所有用户共用服务端配置的 SMTP 服务器，收件人由渠道配置
+type EmailNotifier struct {
+	config SMTPConfig
+}
+
+// NewEmailNotifier 创建邮件发送器
+func NewEmailNotifier(config SMTPConfig) *EmailNotifier {
+	return &EmailNotifier{config: config}
+}
+
+// Send 发送提醒邮件
+func (n *EmailNotifier) Send(channel model.NotifyChannel, alert model.Alert) error {
+	var auth smtp.Auth
+	if n.config.Username != "" {
+		host, _, err := net.SplitHostPort(n.config.Addr)
+		if err != nil {
+			host = n.config.Addr
+		}
+		auth = smtp.PlainAuth("", n.config.Username, n.config.Password, host)
+	}
+
+	subject := "基金提醒"
+	if alert.Name != "" {
+		subject += ": " + alert.Name
+	}
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "From: %s\r\n", n.config.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", channel.Email)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.BEncoding.Encode("UTF-8", subject))
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	msg.WriteString("Content-Transfer-Encoding: base64\r\n\r\n")
+	// Base64 正文每行不超过 76 个字符
+	encoded := base64.StdEncoding.EncodeToString([]byte(alertText(alert)))
+	for len(encoded) > 76 {
+		msg.WriteString(encoded[:76] + "\r\n")
+		encoded = encoded[76:]
+	}
+	msg.WriteString(encoded + "\r\n")
+
+	if err := smtp.SendMail(n.config.Addr, auth, n.config.From, []string{channel.Email}, []byte(msg.String())); err != nil {
+		return fmt.Errorf("发送邮件失败: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,372 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"fund/model"
+	"fund/storage"
+	"log"
+	"net"
+	"net/mail"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	// ErrNotifyChannelNotFound 通知渠道不存在
+	ErrNotifyChannelNotFound = errors.New("通知渠道不存在")
+	// ErrInvalidNotifyChannel 通知渠道参数无效
+	ErrInvalidNotifyChannel = errors.New("通知渠道参数无效")
+)
+
+// 投递结果
+const (
+	DeliverySent   = "sent"   // 发送成功
+	DeliveryFailed = "failed" // 重试后仍失败
+)
+
+const (
+	maxNotifyChannelsPerUser = 10              // 每个用户的通知渠道数量上限
+	maxChannelName           = 32              // 渠道名称长度上限（字符）
+	maxChannelSecret         = 256             // 签名密钥长度上限
+	defaultNotifyAttempts    = 3               // 默认最多尝试次数
+	defaultNotifyRetryDelay  = 2 * time.Second // 默认首次重试间隔，之后每次翻倍
+	defaultDeliveryLimit     = 100             // 投递记录默认返回数量
+)
+
+// ChannelInput 新建、修改通知渠道的参数
+// 修改时 secret 省略表示保留原密钥、空串表示清除；enabled 省略时为启用
+type ChannelInput struct {
+	Name    string  `json:"name"`
+	Type    string  `json:"type"`
+	URL     string  `json:"url"`
+	Email   string  `json:"email"`
+	Secret  *string `json:"secret"`
+	Enabled *bool   `json:"enabled"`
+}
+
+// NotifyService 提醒通知服务：管理用户的通知渠道，提醒触发时发送到用户已启用的全部渠道并记录投递结果
+type NotifyService struct {
+	store      storage.NotifyStore // 通知存储
+	notifiers  map[string]Notifier // 各渠道类型的发送器
+	mutex      sync.Mutex          // 修改锁（读取-修改-保存需串行）
+	attempts   int                 // 最多尝试次数
+	retryDelay time.Duration       // 首次重试间隔
+	now        func() time.Time    // 时钟（可替换，便于测试）
+	wg         sync.WaitGroup      // 进行中的异步投递
+
+	allowPrivate bool // 是否允许内网通知地址
+}
+
+// NewNotifyService 创建通知服务，默认支持 Webhook 和群机器人，邮件需通过 SetNotifier 配置 SMTP 后启用
+func NewNotifyService(store storage.NotifyStore) *NotifyService {
+	return &NotifyService{
+		store: store,
+		notifiers: map[string]Notifier{
+			ChannelWebhook:  NewWebhookNotifier(),
+			ChannelWeCom:    NewBotNotifier(ChannelWeCom),
+			ChannelDingTalk: NewBotNotifier(ChannelDingTalk),
+			ChannelFeishu:   NewBotNotifier(ChannelFeishu),
+		},
+		attempts:   defaultNotifyAttempts,
+		retryDelay: defaultNotifyRetryDelay,
+		now:        time.Now,
+	}
+}
+
+// SetNotifier 设置渠道类型的发送器（如配置 SMTP 后启用邮件）
+func (s *NotifyService) SetNotifier(channelType string, notifier Notifier) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.notifiers[channelType] = notifier
+}
+
+// SetAllowPrivateNetwork 设置是否允许通知地址为内网地址（默认拒绝，只在服务仅供可信用户使用时开启）
+func (s *NotifyService) SetAllowPrivateNetwork(allow bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.allowPrivate = allow
+	for _, notifier := range s.notifiers {
+		if setter, ok := notifier.(privateNetworkSetter); ok {
+			setter.SetAllowPrivateNetwork(allow)
+		}
+	}
+}
+
+// SetRetry 设置最多尝试次数和首次重试间隔
+func (s *NotifyService) SetRetry(attempts int, delay time.Duration) {
+	if attempts < 1 {
+		attempts = 1
+	}
+	s.attempts = attempts
+	s.retryDelay = delay
+}
+
+// SetClock 设置时钟，用于测试
+func (s *NotifyService) SetClock(now func() time.Time) {
+	s.now = now
+}
+
+// ListChannels 获取用户的全部通知渠道
+func (s *NotifyService) ListChannels(userID int64) ([]model.NotifyChannel, error) {
+	channels, err := s.store.ListChannels(userID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range channels {
+		channels[i].HasSecret = channels[i].Secret != ""
+	}
+	return channels, nil
+}
+
+// GetChannel 获取用户的通知渠道
+func (s *NotifyService) GetChannel(userID, id int64) (*model.NotifyChannel, error) {
+	channel, err := s.store.GetChannel(userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if channel == nil {
+		return nil, ErrNotifyChannelNotFound
+	}
+	channel.HasSecret = channel.Secret != ""
+	return channel, nil
+}
+
+// CreateChannel 新建通知渠道
+func (s *NotifyService) CreateChannel(userID int64, input ChannelInput) (*model.NotifyChannel, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	channel := model.NotifyChannel{}
+	if err := s.applyChannelInput(&channel, input); err != nil {
+		return nil, err
+	}
+	existing, err := s.store.ListChannels(userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= maxNotifyChannelsPerUser {
+		return nil, fmt.Errorf("%w: 每个用户最多 %d 个通知渠道", ErrInvalidNotifyChannel, maxNotifyChannelsPerUser)
+	}
+
+	now := s.now()
+	channel.UserID = userID
+	channel.CreatedAt = now
+	channel.UpdatedAt = now
+	saved, err := s.store.SaveChannel(channel)
+	if err != nil {
+		return nil, err
+	}
+	saved.HasSecret = saved.Secret != ""
+	return &saved, nil
+}
+
+// UpdateChannel 修改通知渠道
+func (s *NotifyService) UpdateChannel(userID, id int64, input ChannelInput) (*model.NotifyChannel, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	channel, err := s.GetChannel(userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.applyChannelInput(channel, input); err != nil {
+		return nil, err
+	}
+	channel.UpdatedAt = s.now()
+	saved, err := s.store.SaveChannel(*channel)
+	if err != nil {
+		return nil, err
+	}
+	saved.HasSecret = saved.Secret != ""
+	return &saved, nil
+}
+
+// DeleteChannel 删除通知渠道，投递记录保留
+func (s *NotifyService) DeleteChannel(userID, id int64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	deleted, err := s.store.DeleteChannel(userID, id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrNotifyChannelNotFound
+	}
+	return nil
+}
+
+// TestChannel 向渠道同步发送一条测试提醒（不论是否启用），返回投递结果
+func (s *NotifyService) TestChannel(userID, id int64) (*model.NotifyDelivery, error) {
+	channel, err := s.GetChannel(userID, id)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	alert := model.Alert{
+		UserID:    userID,
+		Date:      now.Format("2006-01-02"),
+		Time:      now.Format("15:04"),
+		Message:   fmt.Sprintf("这是一条来自“%s”的测试通知", channel.Name),
+		CreatedAt: now,
+	}
+	delivery := s.deliver(*channel, alert)
+	return &delivery, nil
+}
+
+// ListDeliveries 获取用户的投递记录（最新的在前），limit <= 0 时使用默认数量
+func (s *NotifyService) ListDeliveries(userID int64, limit int) ([]model.NotifyDelivery, error) {
+	if limit <= 0 {
+		limit = defaultDeliveryLimit
+	}
+	return s.store.ListDeliveries(userID, limit)
+}
+
+// Notify 异步发送提醒，作为 AlertService 的提醒触发回调使用，不阻塞数据采集
+func (s *NotifyService) Notify(alert model.Alert) {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.Deliver(alert)
+	}()
+}
+
+// Deliver 将提醒发送到用户已启用的全部渠道，返回各渠道的投递结果
+func (s *NotifyService) Deliver(alert model.Alert) []model.NotifyDelivery {
+	channels, err := s.store.ListChannels(alert.UserID)
+	if err != nil {
+		log.Printf("❌ 加载通知渠道失败: %v", err)
+		return nil
+	}
+	deliveries := []model.NotifyDelivery{}
+	for _, channel := range channels {
+		if channel.Enabled {
+			deliveries = append(deliveries, s.deliver(channel, alert))
+		}
+	}
+	return deliveries
+}
+
+// Wait 等待进行中的异步投递完成
+func (s *NotifyService) Wait() {
+	s.wg.Wait()
+}
+
+// deliver 发送到单个渠道，失败时按指数退避重试，并保存投递记录
+func (s *NotifyService) deliver(channel model.NotifyChannel, alert model.Alert) model.NotifyDelivery {
+	delivery := model.NotifyDelivery{
+		UserID:      channel.UserID,
+		ChannelID:   channel.ID,
+		ChannelType: channel.Type,
+		AlertID:     alert.ID,
+		Status:      DeliveryFailed,
+	}
+
+	notifier := s.notifier(channel.Type)
+	if notifier == nil {
+		delivery.Error = fmt.Sprintf("未配置 %s 类型的发送器", channel.Type)
+	} else {
+		delay := s.retryDelay
+		for attempt := 1; attempt <= s.attempts; attempt++ {
+			delivery.Attempts = attempt
+			err := notifier.Send(channel, alert)
+			if err == nil {
+				delivery.Status = DeliverySent
+				delivery.Error = ""
+				break
+			}
+			delivery.Error = err.Error()
+			if attempt < s.attempts {
+				time.Sleep(delay)
+				delay *= 2
+			}
+		}
+	}
+
+	if delivery.Status == DeliverySent {
+		log.Printf("📨 [用户 %d] 通知已发送到 %s(%s)", channel.UserID, channel.Name, channel.Type)
+	} else {
+		log.Printf("❌ [用户 %d] 通知发送到 %s(%s) 失败 (尝试 %d 次): %s",
+			channel.UserID, channel.Name, channel.Type, delivery.Attempts, delivery.Error)
+	}
+
+	delivery.CreatedAt = s.now()
+	saved, err := s.store.RecordDelivery(delivery)
+	if err != nil {
+		log.Printf("❌ 保存投递记录失败: %v", err)
+		return delivery
+	}
+	return saved
+}
+
+// notifier 渠道类型的发送器
+func (s *NotifyService) notifier(channelType string) Notifier {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.notifiers[channelType]
+}
+
+// isPrivateHost 地址是否为 localhost 或非公网 IP
+func isPrivateHost(host string) bool {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && !isPublicIP(ip)
+}
+
+// applyChannelInput 校验参数并写入渠道（调用方需持有 mutex）
+func (s *NotifyService) applyChannelInput(channel *model.NotifyChannel, input ChannelInput) error {
+	input.Type = strings.TrimSpace(input.Type)
+	if _, ok := s.notifiers[input.Type]; !ok {
+		if input.Type == ChannelEmail {
+			return fmt.Errorf("%w: 服务端未配置 SMTP, 不支持邮件通知", ErrInvalidNotifyChannel)
+		}
+		return fmt.Errorf("%w: 不支持的渠道类型 %q", ErrInvalidNotifyChannel, input.Type)
+	}
+
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		name = input.Type
+	}
+	if utf8.RuneCountInString(name) > maxChannelName {
+		return fmt.Errorf("%w: 渠道名称最多 %d 个字符", ErrInvalidNotifyChannel, maxChannelName)
+	}
+
+	channel.URL = ""
+	channel.Email = ""
+	if input.Type == ChannelEmail {
+		address, err := mail.ParseAddress(strings.TrimSpace(input.Email))
+		if err != nil {
+			return fmt.Errorf("%w: 邮箱地址格式错误", ErrInvalidNotifyChannel)
+		}
+		channel.Email = address.Address
+	} else {
+		target, err := url.Parse(strings.TrimSpace(input.URL))
+		if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+			return fmt.Errorf("%w: 地址应为 http(s) URL", ErrInvalidNotifyChannel)
+		}
+		// 域名在发送时按解析结果再次检查
+		if !s.allowPrivate && isPrivateHost(target.Hostname()) {
+			return fmt.Errorf("%w: 不允许使用内网地址", ErrInvalidNotifyChannel)
+		}
+		channel.URL = target.String()
+	}
+
+	if input.Secret != nil {
+		secret := strings.TrimSpace(*input.Secret)
+		if len(secret) > maxChannelSecret {
+			return fmt.Errorf("%w: 签名密钥最多 %d 个字符", ErrInvalidNotifyChannel, maxChannelSecret)
+		}
+		channel.Secret = secret
+	}
+	channel.Name = name
+	channel.Type = input.Type
+	channel.Enabled = input.Enabled == nil || *input.Enabled
+	return nil
+}
@@ -0,0 +1,309 @@
+package service
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fund/model"
+	"fund/storage"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestNotifyService 测试通知渠道的校验、Webhook 签名、失败重试和投递记录
+func TestNotifyService(t *testing.T) {
+	var mu sync.Mutex
+	var received []map[string]interface{}
+	failures := 2 // 前两次请求返回 500
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if failures > 0 {
+			failures--
+			http.Error(w, "busy", http.StatusInternalServerError)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		signature := "sha256=" + WebhookSignature("s3cret", r.Header.Get("X-Fund-Timestamp"), body)
+		if r.Header.Get("X-Fund-Signature") != signature {
+			http.Error(w, "bad signature", http.StatusUnauthorized)
+			return
+		}
+		var payload map[string]interface{}
+		json.Unmarshal(body, &payload)
+		received = append(received, payload)
+	}))
+	defer webhook.Close()
+
+	notifyService := NewNotifyService(storage.NewFileNotifyStore(t.TempDir()))
+	notifyService.SetClock(tradingClock)
+	notifyService.SetRetry(3, time.Millisecond)
+	notifyService.SetAllowPrivateNetwork(true) // 测试服务监听在环回地址
+
+	secret := "s3cret"
+	for _, input := range []ChannelInput{
+		{Type: "sms", URL: webhook.URL},
+		{Type: ChannelEmail, Email: "alice@example.com"}, // 未配置 SMTP
+		{Type: ChannelWebhook, URL: "ftp://example.com/hook"},
+		{Type: ChannelWebhook, URL: "http:///hook"},
+		{Type: ChannelWebhook, URL: webhook.URL, Name: strings.Repeat("名", maxChannelName+1)},
+	} {
+		if _, err := notifyService.CreateChannel(1, input); !errors.Is(err, ErrInvalidNotifyChannel) {
+			t.Errorf("❌ %+v 应返回 ErrInvalidNotifyChannel: %v", input, err)
+		}
+	}
+
+	channel, err := notifyService.CreateChannel(1, ChannelInput{Type: ChannelWebhook, URL: webhook.URL, Secret: &secret})
+	if err != nil || channel.Name != ChannelWebhook || !channel.Enabled || !channel.HasSecret {
+		t.Fatalf("❌ 新建 Webhook 渠道异常: %+v %v", channel, err)
+	}
+	disabled := false
+	if _, err := notifyService.CreateChannel(1, ChannelInput{Type: ChannelWeCom, URL: webhook.URL, Enabled: &disabled}); err != nil {
+		t.Fatal(err)
+	}
+
+	// 前两次失败，第三次成功；停用的渠道不发送
+	alert := model.Alert{ID: 7, UserID: 1, Code: "000001", Date: "2025-07-01", Time: "10:30", Message: "000001 10:30 估算涨跌幅 0.49% 高于 0.40%"}
+	notifyService.Notify(alert)
+	notifyService.Wait()
+	deliveries, err := notifyService.ListDeliveries(1, 0)
+	if err != nil || len(deliveries) != 1 || deliveries[0].Status != DeliverySent || deliveries[0].Attempts != 3 ||
+		deliveries[0].AlertID != 7 || deliveries[0].ChannelID != channel.ID {
+		t.Fatalf("❌ 重试后投递记录异常: %+v %v", deliveries, err)
+	}
+	mu.Lock()
+	if len(received) != 1 || received[0]["event"] != "alert" {
+		t.Fatalf("❌ Webhook 收到的内容异常: %+v", received)
+	}
+	if payload := received[0]["alert"].(map[string]interface{}); payload["message"] != alert.Message {
+		t.Errorf("❌ Webhook 提醒内容异常: %+v", payload)
+	}
+	mu.Unlock()
+
+	// 修改时省略密钥保留原值，签名仍然有效；清除密钥后签名校验失败，重试用尽后记录失败
+	channel, err = notifyService.UpdateChannel(1, channel.ID, ChannelInput{Type: ChannelWebhook, URL: webhook.URL, Name: "行情推送"})
+	if err != nil || !channel.HasSecret || channel.Name != "行情推送" {
+		t.Fatalf("❌ 修改渠道异常: %+v %v", channel, err)
+	}
+	empty := ""
+	if _, err := notifyService.UpdateChannel(1, channel.ID, ChannelInput{Type: ChannelWebhook, URL: webhook.URL, Secret: &empty}); err != nil {
+		t.Fatal(err)
+	}
+	delivery, err := notifyService.TestChannel(1, channel.ID)
+	if err != nil || delivery.Status != DeliveryFailed || delivery.Attempts != 3 || !strings.Contains(delivery.Error, "401") {
+		t.Errorf("❌ 签名错误应记录失败: %+v %v", delivery, err)
+	}
+
+	// 用户隔离
+	if _, err := notifyService.GetChannel(2, channel.ID); !errors.Is(err, ErrNotifyChannelNotFound) {
+		t.Errorf("❌ 读取其他用户的渠道应返回 ErrNotifyChannelNotFound: %v", err)
+	}
+	if _, err := notifyService.TestChannel(2, channel.ID); !errors.Is(err, ErrNotifyChannelNotFound) {
+		t.Errorf("❌ 测试其他用户的渠道应返回 ErrNotifyChannelNotFound: %v", err)
+	}
+	if err := notifyService.DeleteChannel(2, channel.ID); !errors.Is(err, ErrNotifyChannelNotFound) {
+		t.Errorf("❌ 删除其他用户的渠道应返回 ErrNotifyChannelNotFound: %v", err)
+	}
+	if deliveries := notifyService.Deliver(model.Alert{UserID: 2, Message: "x"}); len(deliveries) != 0 {
+		t.Errorf("❌ 没有渠道的用户不应投递: %+v", deliveries)
+	}
+}
+
+// TestNotifyPrivateNetwork 测试默认拒绝内网通知地址、不跟随重定向且不回显响应内容
+func TestNotifyPrivateNetwork(t *testing.T) {
+	var redirected bool
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		redirected = true
+	}))
+	defer target.Close()
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/redirect" {
+			http.Redirect(w, r, target.URL, http.StatusFound)
+			return
+		}
+		http.Error(w, "internal-secret-token", http.StatusInternalServerError)
+	}))
+	defer upstream.Close()
+
+	notifyService := NewNotifyService(storage.NewFileNotifyStore(t.TempDir()))
+	notifyService.SetRetry(1, 0)
+	port := upstream.URL[strings.LastIndex(upstream.URL, ":"):]
+	for _, address := range []string{upstream.URL, "http://localhost" + port, "http://169.254.169.254/latest/meta-data",
+		"http://10.0.0.1/hook", "http://[::1]" + port, "http://[::ffff:127.0.0.1]" + port} {
+		if _, err := notifyService.CreateChannel(1, ChannelInput{Type: ChannelWebhook, URL: address}); !errors.Is(err, ErrInvalidNotifyChannel) {
+			t.Errorf("❌ 内网地址 %s 应返回 ErrInvalidNotifyChannel: %v", address, err)
+		}
+	}
+
+	// 域名解析到内网地址时在连接前拒绝
+	alert := model.Alert{Message: "x"}
+	for _, notifier := range []Notifier{NewWebhookNotifier(), NewBotNotifier(ChannelWeCom)} {
+		if err := notifier.Send(model.NotifyChannel{URL: upstream.URL}, alert); !errors.Is(err, ErrPrivateAddress) {
+			t.Errorf("❌ 连接环回地址应返回 ErrPrivateAddress: %v", err)
+		}
+	}
+
+	// 允许内网地址时也不跟随重定向，错误中不包含响应内容
+	notifier := NewWebhookNotifier()
+	notifier.SetAllowPrivateNetwork(true)
+	if err := notifier.Send(model.NotifyChannel{URL: upstream.URL + "/redirect"}, alert); err == nil || err.Error() != "HTTP 302" || redirected {
+		t.Errorf("❌ 不应跟随重定向: %v", err)
+	}
+	if err := notifier.Send(model.NotifyChannel{URL: upstream.URL}, alert); err == nil || strings.Contains(err.Error(), "internal-secret-token") {
+		t.Errorf("❌ 错误中不应包含响应内容: %v", err)
+	}
+
+	for ip, public := range map[string]bool{"8.8.8.8": true, "2001:4860:4860::8888": true, "127.0.0.1": false, "0.0.0.0": false,
+		"192.168.1.1": false, "100.64.0.1": false, "fe80::1": false, "fd00::1": false, "::ffff:10.0.0.1": false} {
+		if isPublicIP(net.ParseIP(ip)) != public {
+			t.Errorf("❌ %s 公网判断错误, 期望 %v", ip, public)
+		}
+	}
+}
+
+// TestBotNotifier 测试企业微信、钉钉、飞书机器人的消息格式、加签和业务错误码
+func TestBotNotifier(t *testing.T) {
+	type request struct {
+		query string
+		body  map[string]interface{}
+	}
+	var last request
+	reply := `{"errcode":0,"errmsg":"ok"}`
+	bot := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		last = request{query: r.URL.RawQuery}
+		json.NewDecoder(r.Body).Decode(&last.body)
+		io.WriteString(w, reply)
+	}))
+	defer bot.Close()
+
+	alert := model.Alert{Message: "000001 10:30 估算涨跌幅 0.49% 高于 0.40%"}
+	channel := model.NotifyChannel{URL: bot.URL + "/robot/send?access_token=abc"}
+	newBot := func(kind string) *BotNotifier {
+		notifier := NewBotNotifier(kind)
+		notifier.SetAllowPrivateNetwork(true)
+		return notifier
+	}
+
+	if err := newBot(ChannelWeCom).Send(channel, alert); err != nil {
+		t.Fatal(err)
+	}
+	if text := last.body["text"].(map[string]interface{}); last.body["msgtype"] != "text" || text["content"] != "【基金提醒】"+alert.Message {
+		t.Errorf("❌ 企业微信消息格式异常: %+v", last.body)
+	}
+
+	channel.Secret = "SEC123"
+	if err := newBot(ChannelDingTalk).Send(channel, alert); err != nil {
+		t.Fatal(err)
+	}
+	if query := last.query; !strings.HasPrefix(query, "access_token=abc&timestamp=") || !strings.Contains(query, "&sign=") {
+		t.Errorf("❌ 钉钉加签参数异常: %s", query)
+	}
+
+	reply = `{"code":0,"msg":"success"}`
+	if err := newBot(ChannelFeishu).Send(channel, alert); err != nil {
+		t.Fatal(err)
+	}
+	if last.body["msg_type"] != "text" || last.body["sign"] == nil || last.body["timestamp"] == nil {
+		t.Errorf("❌ 飞书消息格式异常: %+v", last.body)
+	}
+
+	reply = `{"errcode":93000,"errmsg":"invalid webhook url"}`
+	if err := newBot(ChannelWeCom).Send(channel, alert); err == nil || !strings.Contains(err.Error(), "93000") || strings.Contains(err.Error(), "invalid") {
+		t.Errorf("❌ 机器人业务错误应返回错误: %v", err)
+	}
+}
+
+// TestEmailNotifier 测试通过本地 SMTP 服务发送提醒邮件
+func TestEmailNotifier(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+
+	type message struct {
+		from, to, data string
+	}
+	messages := make(chan message, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		reader := bufio.NewReader(conn)
+		reply := func(line string) { io.WriteString(conn, line+"\r\n") }
+
+		var msg message
+		reply("220 localhost ESMTP")
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			line = strings.TrimRight(line, "\r\n")
+			command := strings.ToUpper(line)
+			switch {
+			case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+				reply("250 localhost")
+			case strings.HasPrefix(command, "MAIL FROM:"):
+				msg.from = line[len("MAIL FROM:"):]
+				reply("250 OK")
+			case strings.HasPrefix(command, "RCPT TO:"):
+				msg.to = line[len("RCPT TO:"):]
+				reply("250 OK")
+			case command == "DATA":
+				reply("354 End data with <CR><LF>.<CR><LF>")
+				var data strings.Builder
+				for {
+					dataLine, err := reader.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if dataLine == ".\r\n" {
+						break
+					}
+					data.WriteString(dataLine)
+				}
+				msg.data = data.String()
+				reply("250 OK")
+			case command == "QUIT":
+				reply("221 Bye")
+				messages <- msg
+				return
+			default:
+				reply("250 OK")
+			}
+		}
+	}()
+
+	notifier := NewEmailNotifier(SMTPConfig{Addr: listener.Addr().String(), From: "fund@example.com"})
+	alert := model.Alert{Name: "华夏成长混合", Message: "华夏成长混合(000001) 10:30 估算涨跌幅 0.49% 高于 0.40%"}
+	if err := notifier.Send(model.NotifyChannel{Type: ChannelEmail, Email: "alice@example.com"}, alert); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case msg := <-messages:
+		if msg.from != "<fund@example.com>" || msg.to != "<alice@example.com>" {
+			t.Errorf("❌ 发件人/收件人异常: %s %s", msg.from, msg.to)
+		}
+		header, body, _ := strings.Cut(msg.data, "\r\n\r\n")
+		if !strings.Contains(header, "To: alice@example.com") || !strings.Contains(header, "Subject: =?UTF-8?b?") {
+			t.Errorf("❌ 邮件头异常: %s", header)
+		}
+		decoded, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(body, "\r\n", ""))
+		if err != nil || string(decoded) != "【基金提醒】"+alert.Message {
+			t.Errorf("❌ 邮件正文异常: %q %v", decoded, err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("❌ SMTP 服务未收到邮件")
+	}
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrFieldMissing 上游数据中缺少字段
+	ErrFieldMissing = errors.New("字段缺失")
+	// ErrFieldInvalid 上游数据字段格式不符合预期
+	ErrFieldInvalid = errors.New("字段格式错误")
+)
+
+// ParseError 上游数据解析错误，记录出错的解析器和字段
+// 可通过 errors.As 取出，通过 errors.Is 判断是缺失还是格式错误
+type ParseError struct {
+	Parser string // 解析器（上游接口）名称，如 pingzhongdata、fundgz
+	Field  string // 出错的字段，如 Data_netWorthTrend[3].y
+	Err    error  // 错误原因
+}
+
+// newParseError 创建解析错误
+func newParseError(parser, field string, err error) *ParseError {
+	return &ParseError{Parser: parser, Field: field, Err: err}
+}
+
+// Error 实现 error 接口
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("解析 %s 字段 %s 失败: %v", e.Parser, e.Field, e.Err)
+}
+
+// Unwrap 返回错误原因
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// invalidField 创建字段格式错误，附带具体原因
+func invalidField(parser, field string, cause error) *ParseError {
+	if cause == nil {
+		return newParseError(parser, field, ErrFieldInvalid)
+	}
+	return newParseError(parser, field, fmt.Errorf("%w: %v", ErrFieldInvalid, cause))
+}
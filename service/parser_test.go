@@ -0,0 +1,244 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fund/internal/fakeupstream"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+var updateGolden = flag.Bool("update", false, "重新生成 testdata/golden 下的 golden 文件")
+
+// parserFixture 解析器测试样本
+type parserFixture struct {
+	name    string // golden 文件名
+	parser  string // 解析器: fundlist/detail/realtime/trend/profile/returns/batch/lsjz
+	payload string // fakeupstream 录制数据或 testdata/parsers 下的文件
+}
+
+var parserFixtures = []parserFixture{
+	// 录制的真实上游数据
+	{"fundlist", "fundlist", "upstream:fundcode_search.js"},
+	{"detail_000001", "detail", "upstream:pingzhongdata/000001.js"},
+	{"detail_110022", "detail", "upstream:pingzhongdata/110022.js"},
+	{"trend_110022", "trend", "upstream:pingzhongdata/110022.js"},
+	{"profile_000001", "profile", "upstream:pingzhongdata/000001.js"},
+	{"returns_110022", "returns", "upstream:pingzhongdata/110022.js"},
+	{"realtime_000001", "realtime", "upstream:fundgz/000001.js"},
+	{"realtime_110022", "realtime", "upstream:fundgz/110022.js"},
+	{"batch_page1", "batch", "upstream:Fund_JJJZ_Data.aspx"},
+
+	// 上游格式漂移
+	{"detail_drift", "detail", "pingzhongdata_drift.js"},
+	{"detail_noname", "detail", "pingzhongdata_noname.js"},
+	{"trend_drift", "trend", "pingzhongdata_drift.js"},
+	{"profile_drift", "profile", "pingzhongdata_drift.js"},
+	{"returns_drift", "returns", "pingzhongdata_drift.js"},
+	{"returns_noname", "returns", "pingzhongdata_noname.js"},
+	{"realtime_empty", "realtime", "fundgz_empty.js"},
+	{"realtime_bad_gsz", "realtime", "fundgz_bad_gsz.js"},
+	{"batch_drift", "batch", "batch_drift.aspx"},
+	{"batch_nodatas", "batch", "batch_nodatas.aspx"},
+	{"lsjz_000001", "lsjz", "lsjz_000001.json"},
+	{"lsjz_drift", "lsjz", "lsjz_drift.json"},
+}
+
+// loadParserFixture 读取解析器测试样本
+func loadParserFixture(t testing.TB, payload string) string {
+	t.Helper()
+	var data []byte
+	var err error
+	if name, ok := strings.CutPrefix(payload, "upstream:"); ok {
+		data, err = fakeupstream.Payload(name)
+	} else {
+		data, err = os.ReadFile(filepath.Join("testdata", "parsers", payload))
+	}
+	if err != nil {
+		t.Fatalf("读取样本 %s 失败: %v", payload, err)
+	}
+	return string(data)
+}
+
+// runParser 运行指定解析器
+func runParser(p *EastmoneyProvider, parser, content string) (interface{}, error) {
+	switch parser {
+	case "fundlist":
+		return p.parseFundListJS(content)
+	case "detail":
+		return p.parseFundDetailJS(content)
+	case "trend":
+		return p.extractNetWorthTrend(content)
+	case "profile":
+		return p.parseFundProfileJS(content)
+	case "returns":
+		return p.parseReturnSeriesJS(content)
+	case "realtime":
+		return p.parseRealtimeJS(content)
+	case "batch":
+		return p.parseBatchFundsForRealtime(content)
+	case "lsjz":
+		points, total, err := p.parseNAVListJSON(content)
+		if points == nil {
+			return nil, err
+		}
+		return map[string]interface{}{"points": points, "total": total}, err
+	}
+	panic("未知的解析器: " + parser)
+}
+
+// collectParseErrors 展开（可能由 errors.Join 合并的）解析错误
+func collectParseErrors(err error) []*ParseError {
+	if err == nil {
+		return nil
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		var result []*ParseError
+		for _, e := range joined.Unwrap() {
+			result = append(result, collectParseErrors(e)...)
+		}
+		return result
+	}
+	var parseErr *ParseError
+	if errors.As(err, &parseErr) {
+		return []*ParseError{parseErr}
+	}
+	return nil
+}
+
+// TestParserGolden 使用录制数据和漂移样本校验解析结果与 golden 文件一致
+func TestParserGolden(t *testing.T) {
+	provider := NewEastmoneyProvider()
+
+	for _, fixture := range parserFixtures {
+		t.Run(fixture.name, func(t *testing.T) {
+			result, err := runParser(provider, fixture.parser, loadParserFixture(t, fixture.payload))
+
+			// 所有错误都必须是带字段信息的 *ParseError
+			parseErrors := collectParseErrors(err)
+			if err != nil && len(parseErrors) == 0 {
+				t.Fatalf("❌ 错误不是 *ParseError: %v", err)
+			}
+
+			type goldenError struct {
+				Parser  string `json:"parser"`
+				Field   string `json:"field"`
+				Missing bool   `json:"missing"`
+			}
+			golden := struct {
+				Result interface{}   `json:"result"`
+				Errors []goldenError `json:"errors,omitempty"`
+			}{Result: result}
+			for _, e := range parseErrors {
+				golden.Errors = append(golden.Errors, goldenError{
+					Parser:  e.Parser,
+					Field:   e.Field,
+					Missing: errors.Is(e, ErrFieldMissing),
+				})
+			}
+
+			got, err := json.MarshalIndent(golden, "", "  ")
+			if err != nil {
+				t.Fatal(err)
+			}
+			got = append(got, '\n')
+
+			goldenFile := filepath.Join("testdata", "golden", fixture.name+".golden.json")
+			if *updateGolden {
+				if err := os.WriteFile(goldenFile, got, 0644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+
+			want, err := os.ReadFile(goldenFile)
+			if err != nil {
+				t.Fatalf("读取 golden 文件失败（可使用 -update 生成）: %v", err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("❌ 解析结果与 %s 不一致:\n%s", goldenFile, got)
+			}
+		})
+	}
+}
+
+// addParserSeeds 将样本加入模糊测试语料
+func addParserSeeds(f *testing.F, parser string) {
+	for _, fixture := range parserFixtures {
+		if fixture.parser == parser {
+			f.Add(loadParserFixture(f, fixture.payload))
+		}
+	}
+}
+
+// checkFuzzError 校验模糊测试中返回的错误均为 *ParseError
+func checkFuzzError(t *testing.T, err error) {
+	if err != nil && len(collectParseErrors(err)) == 0 {
+		t.Fatalf("错误不是 *ParseError: %v", err)
+	}
+}
+
+// FuzzParseFundDetailJS 模糊测试基金详情解析
+func FuzzParseFundDetailJS(f *testing.F) {
+	addParserSeeds(f, "detail")
+	provider := NewEastmoneyProvider()
+
+	f.Fuzz(func(t *testing.T, content string) {
+		result, err := provider.parseFundDetailJS(content)
+		checkFuzzError(t, err)
+		if result != nil && (result["name"] == "" || result["code"] == "") {
+			t.Fatalf("返回结果缺少名称或代码: %v", result)
+		}
+	})
+}
+
+// FuzzParseRealtimeJS 模糊测试实时估值解析
+func FuzzParseRealtimeJS(f *testing.F) {
+	addParserSeeds(f, "realtime")
+	provider := NewEastmoneyProvider()
+
+	f.Fuzz(func(t *testing.T, content string) {
+		result, err := provider.parseRealtimeJS(content)
+		checkFuzzError(t, err)
+		if err == nil && (result.FundCode == "" || result.Gsz == "" || result.GsZzl == "") {
+			t.Fatalf("返回结果缺少关键字段: %+v", result)
+		}
+	})
+}
+
+// FuzzExtractNetWorthTrend 模糊测试净值走势解析
+func FuzzExtractNetWorthTrend(f *testing.F) {
+	addParserSeeds(f, "trend")
+	provider := NewEastmoneyProvider()
+
+	f.Fuzz(func(t *testing.T, content string) {
+		result, err := provider.extractNetWorthTrend(content)
+		checkFuzzError(t, err)
+		for _, point := range result {
+			if _, err := time.Parse("2006-01-02", point.Date); err != nil {
+				t.Fatalf("日期格式错误: %+v", point)
+			}
+		}
+	})
+}
+
+// FuzzParseBatchFundsForRealtime 模糊测试批量行情解析
+func FuzzParseBatchFundsForRealtime(f *testing.F) {
+	addParserSeeds(f, "batch")
+	provider := NewEastmoneyProvider()
+
+	f.Fuzz(func(t *testing.T, content string) {
+		result, err := provider.parseBatchFundsForRealtime(content)
+		checkFuzzError(t, err)
+		for code, data := range result {
+			if code == "" {
+				t.Fatalf("返回了空基金代码: %v", data)
+			}
+		}
+	})
+}
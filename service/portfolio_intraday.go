@@ -0,0 +1,113 @@
+package service
+
+import (
+	"fmt"
+	"fund/model"
+	"sort"
+)
+
+// intradaySeries 单只持仓的日内估值序列
+type intradaySeries struct {
+	shares  float64
+	baseNAV float64
+	points  map[string]float64 // key: HH:MM, value: 估算净值
+}
+
+// GetIntradayCurve 计算用户组合的日内估值曲线
+// 各持仓的日内估值点按份额加权合并为分钟级曲线；某一分钟缺少估值点的基金沿用其上一个估值点，
+// 当日首个估值点之前以及全天无估值数据的基金按前一交易日净值计入。date 为空时为当日
+func (s *PortfolioService) GetIntradayCurve(userID int64, date string) (*model.PortfolioIntraday, error) {
+	if date == "" {
+		date = s.now().In(chinaZone).Format("2006-01-02")
+	}
+	holdings, err := s.store.ListHoldings(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.PortfolioIntraday{
+		Date:  date,
+		Funds: make([]model.PortfolioIntradayFund, 0, len(holdings)),
+		Data:  []model.PortfolioIntradayPoint{},
+	}
+	series := make([]intradaySeries, 0, len(holdings))
+	timeSet := make(map[string]bool)
+
+	for _, holding := range holdings {
+		if holding.Shares <= 0 {
+			continue
+		}
+		item := intradaySeries{shares: holding.Shares, points: make(map[string]float64)}
+
+		if s.intradayService != nil {
+			if data, err := s.intradayService.GetIntradayDataByDate(holding.Code, date); err == nil {
+				for _, point := range data.Data {
+					if point.Value <= 0 || point.Time == "unknown" {
+						continue
+					}
+					item.points[point.Time] = point.Value
+					timeSet[point.Time] = true
+					// 前一交易日净值缺失时用估值和涨跌幅反推
+					if item.baseNAV == 0 && point.Rate > -100 {
+						item.baseNAV = point.Value / (1 + point.Rate/100)
+					}
+				}
+			}
+		}
+
+		if baseNAV, err := s.fundService.previousNAV(holding.Code, date); err != nil {
+			return nil, err
+		} else if baseNAV > 0 {
+			item.baseNAV = baseNAV
+		}
+		if item.baseNAV == 0 {
+			return nil, fmt.Errorf("基金 %s 缺少 %s 之前的净值数据", holding.Code, date)
+		}
+
+		if len(item.points) == 0 {
+			result.Missing = append(result.Missing, holding.Code)
+		}
+		result.BaseValue += item.shares * item.baseNAV
+		result.Funds = append(result.Funds, model.PortfolioIntradayFund{
+			Code:    holding.Code,
+			Name:    holding.Name,
+			Shares:  holding.Shares,
+			BaseNAV: roundMetric(item.baseNAV),
+			Points:  len(item.points),
+		})
+		series = append(series, item)
+	}
+
+	times := make([]string, 0, len(timeSet))
+	for t := range timeSet {
+		times = append(times, t)
+	}
+	sort.Strings(times)
+
+	// 每只基金的最新估值，初始为前一交易日净值
+	latest := make([]float64, len(series))
+	for i, item := range series {
+		latest[i] = item.baseNAV
+	}
+	for _, t := range times {
+		value := 0.0
+		for i, item := range series {
+			if nav, ok := item.points[t]; ok {
+				latest[i] = nav
+			}
+			value += item.shares * latest[i]
+		}
+		point := model.PortfolioIntradayPoint{
+			Time:   t,
+			Value:  roundMoney(value),
+			Profit: roundMoney(value - result.BaseValue),
+		}
+		if result.BaseValue > 0 {
+			point.Rate = roundMetric((value - result.BaseValue) / result.BaseValue * 100)
+		}
+		result.Data = append(result.Data, point)
+	}
+
+	result.BaseValue = roundMoney(result.BaseValue)
+	return result, nil
+}
@@ -0,0 +1,308 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"fund/model"
+	"fund/storage"
+	"log"
+	"math"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrHoldingNotFound 持仓不存在
+	ErrHoldingNotFound = errors.New("持仓不存在")
+	// ErrInvalidPortfolio 持仓或交易参数无效
+	ErrInvalidPortfolio = errors.New("持仓参数无效")
+)
+
+// sharesEpsilon 份额比较的容差（份额保留 2 位小数）
+const sharesEpsilon = 1e-6
+
+// PortfolioService 持仓管理服务（按用户隔离）
+// 持仓和交易记录保存在 PortfolioStore 中，估值使用 FundService 的官方净值和 IntradayService 的实时估值
+type PortfolioService struct {
+	store           storage.PortfolioStore // 持仓存储
+	fundService     *FundService           // 基金服务（官方净值、基金名称）
+	intradayService *IntradayService       // 日内数据服务（实时估值，可选）
+	mutex           sync.Mutex             // 持仓修改锁（读取-计算-保存需串行）
+	now             func() time.Time       // 时钟（可替换，便于测试）
+}
+
+// NewPortfolioService 创建持仓管理服务
+func NewPortfolioService(store storage.PortfolioStore, fundService *FundService, intradayService *IntradayService) *PortfolioService {
+	return &PortfolioService{
+		store:           store,
+		fundService:     fundService,
+		intradayService: intradayService,
+		now:             time.Now,
+	}
+}
+
+// SetClock 设置时钟，用于测试
+func (s *PortfolioService) SetClock(now func() time.Time) {
+	s.now = now
+}
+
+// ListHoldings 获取全部持仓
+func (s *PortfolioService) ListHoldings(userID int64) ([]model.Holding, error) {
+	return s.store.ListHoldings(userID)
+}
+
+// GetHolding 获取单只基金的持仓
+func (s *PortfolioService) GetHolding(userID int64, fundCode string) (*model.Holding, error) {
+	holding, err := s.store.GetHolding(userID, fundCode)
+	if err != nil {
+		return nil, err
+	}
+	if holding == nil {
+		return nil, ErrHoldingNotFound
+	}
+	return holding, nil
+}
+
+// SaveHolding 新增或修改持仓（直接设置份额、成本和买入日期，不产生交易记录）
+func (s *PortfolioService) SaveHolding(userID int64, holding model.Holding) (*model.Holding, error) {
+	if holding.Shares < 0 || holding.Cost < 0 {
+		return nil, fmt.Errorf("%w: 份额和成本不能为负数", ErrInvalidPortfolio)
+	}
+	if holding.PurchaseDate == "" {
+		holding.PurchaseDate = s.now().In(chinaZone).Format("2006-01-02")
+	} else if _, err := time.Parse("2006-01-02", holding.PurchaseDate); err != nil {
+		return nil, fmt.Errorf("%w: 买入日期格式错误,应为 YYYY-MM-DD", ErrInvalidPortfolio)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	existing, err := s.store.GetHolding(userID, holding.Code)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		holding.Name = existing.Name
+	} else if holding.Name, err = s.fundName(holding.Code); err != nil {
+		return nil, err
+	}
+	holding.Shares = roundMoney(holding.Shares)
+	holding.Cost = roundMoney(holding.Cost)
+	holding.Realized = roundMoney(holding.Realized)
+	holding.UpdatedAt = s.now()
+
+	if err := s.store.SaveHolding(userID, holding); err != nil {
+		return nil, err
+	}
+	return &holding, nil
+}
+
+// DeleteHolding 删除持仓及其交易记录
+func (s *PortfolioService) DeleteHolding(userID int64, fundCode string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	existing, err := s.store.GetHolding(userID, fundCode)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return ErrHoldingNotFound
+	}
+	return s.store.DeleteHolding(userID, fundCode)
+}
+
+// ListTransactions 获取交易记录，fundCode 为空时返回全部
+func (s *PortfolioService) ListTransactions(userID int64, fundCode string) ([]model.Transaction, error) {
+	return s.store.ListTransactions(userID, fundCode)
+}
+
+// AddTransaction 记录一笔交易并更新持仓
+// buy: 份额增加，成本增加支付金额；sell: 按平均成本扣减成本，差额计入已实现收益；
+// dividend: 现金分红计入已实现收益，红利再投资增加份额（成本不变）
+func (s *PortfolioService) AddTransaction(userID int64, tx model.Transaction) (*model.Transaction, *model.Holding, error) {
+	if tx.Date == "" {
+		tx.Date = s.now().In(chinaZone).Format("2006-01-02")
+	} else if _, err := time.Parse("2006-01-02", tx.Date); err != nil {
+		return nil, nil, fmt.Errorf("%w: 交易日期格式错误,应为 YYYY-MM-DD", ErrInvalidPortfolio)
+	}
+	if tx.Shares < 0 || tx.Price < 0 || tx.Amount < 0 || tx.Fee < 0 {
+		return nil, nil, fmt.Errorf("%w: 份额、净值、金额和手续费不能为负数", ErrInvalidPortfolio)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	existing, err := s.store.GetHolding(userID, tx.Code)
+	if err != nil {
+		return nil, nil, err
+	}
+	var holding model.Holding
+	switch {
+	case existing != nil:
+		holding = *existing
+	case tx.Type == "buy":
+		name, err := s.fundName(tx.Code)
+		if err != nil {
+			return nil, nil, err
+		}
+		holding = model.Holding{Code: tx.Code, Name: name, PurchaseDate: tx.Date}
+	default:
+		return nil, nil, ErrHoldingNotFound
+	}
+
+	if err := applyTransaction(&holding, &tx); err != nil {
+		return nil, nil, err
+	}
+	holding.UpdatedAt = s.now()
+	tx.CreatedAt = holding.UpdatedAt
+
+	recorded, err := s.store.RecordTransaction(userID, tx, holding)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &recorded, &holding, nil
+}
+
+// applyTransaction 将交易应用到持仓，并补全交易记录中可推算的份额或金额
+func applyTransaction(holding *model.Holding, tx *model.Transaction) error {
+	switch tx.Type {
+	case "buy":
+		// 只提供金额和净值时按净值计算份额，只提供份额和净值时计算支付金额
+		if tx.Shares == 0 && tx.Price > 0 {
+			tx.Shares = (tx.Amount - tx.Fee) / tx.Price
+		}
+		if tx.Amount == 0 && tx.Price > 0 {
+			tx.Amount = tx.Shares*tx.Price + tx.Fee
+		}
+		tx.Shares, tx.Amount = roundMoney(tx.Shares), roundMoney(tx.Amount)
+		if tx.Shares <= 0 || tx.Amount <= 0 {
+			return fmt.Errorf("%w: 买入需提供份额和金额（或其中之一及成交净值）", ErrInvalidPortfolio)
+		}
+		holding.Shares += tx.Shares
+		holding.Cost += tx.Amount
+		if holding.PurchaseDate == "" || tx.Date < holding.PurchaseDate {
+			holding.PurchaseDate = tx.Date
+		}
+
+	case "sell":
+		if tx.Shares <= 0 {
+			return fmt.Errorf("%w: 卖出需提供份额", ErrInvalidPortfolio)
+		}
+		if tx.Shares > holding.Shares+sharesEpsilon {
+			return fmt.Errorf("%w: 卖出份额 %.2f 超过持有份额 %.2f", ErrInvalidPortfolio, tx.Shares, holding.Shares)
+		}
+		if tx.Amount == 0 && tx.Price > 0 {
+			tx.Amount = math.Max(tx.Shares*tx.Price-tx.Fee, 0)
+		}
+		if tx.Amount == 0 && tx.Price == 0 {
+			return fmt.Errorf("%w: 卖出需提供到账金额或成交净值", ErrInvalidPortfolio)
+		}
+		tx.Shares, tx.Amount = roundMoney(tx.Shares), roundMoney(tx.Amount)
+		soldCost := holding.Cost * tx.Shares / holding.Shares
+		holding.Shares -= tx.Shares
+		holding.Cost -= soldCost
+		holding.Realized += tx.Amount - soldCost
+
+	case "dividend":
+		if tx.Amount == 0 && tx.Shares == 0 {
+			return fmt.Errorf("%w: 分红需提供现金金额或再投资份额", ErrInvalidPortfolio)
+		}
+		tx.Shares, tx.Amount = roundMoney(tx.Shares), roundMoney(tx.Amount)
+		holding.Realized += tx.Amount
+		holding.Shares += tx.Shares
+
+	default:
+		return fmt.Errorf("%w: 未知的交易类型 %q, 可选值: buy/sell/dividend", ErrInvalidPortfolio, tx.Type)
+	}
+
+	holding.Shares = roundMoney(holding.Shares)
+	holding.Cost = roundMoney(holding.Cost)
+	holding.Realized = roundMoney(holding.Realized)
+	return nil
+}
+
+// Valuate 计算组合估值
+// 当日净值已公布时按官方净值计算当日收益，否则按实时估值估算，都没有时当日收益为 0
+func (s *PortfolioService) Valuate(userID int64) (*model.PortfolioValuation, error) {
+	holdings, err := s.store.ListHoldings(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	today := now.In(chinaZone).Format("2006-01-02")
+	valuation := &model.PortfolioValuation{
+		Holdings:  make([]model.HoldingValuation, 0, len(holdings)),
+		UpdatedAt: now,
+	}
+	for _, holding := range holdings {
+		item, err := s.valuateHolding(holding, today)
+		if err != nil {
+			return nil, err
+		}
+		valuation.Holdings = append(valuation.Holdings, *item)
+		valuation.Cost += item.Cost
+		valuation.MarketValue += item.MarketValue
+		valuation.TodayProfit += item.TodayProfit
+		valuation.TotalProfit += item.TotalProfit
+	}
+
+	valuation.Cost = roundMoney(valuation.Cost)
+	valuation.MarketValue = roundMoney(valuation.MarketValue)
+	valuation.TodayProfit = roundMoney(valuation.TodayProfit)
+	valuation.TotalProfit = roundMoney(valuation.TotalProfit)
+	if valuation.Cost > 0 {
+		valuation.TotalReturn = roundMetric(valuation.TotalProfit / valuation.Cost * 100)
+	}
+	return valuation, nil
+}
+
+// valuateHolding 计算单只基金的持仓估值
+func (s *PortfolioService) valuateHolding(holding model.Holding, today string) (*model.HoldingValuation, error) {
+	history, err := s.fundService.fetchNAVHistory(holding.Code)
+	if err != nil {
+		return nil, fmt.Errorf("获取基金 %s 净值失败: %v", holding.Code, err)
+	}
+	item := &model.HoldingValuation{Holding: holding}
+	if len(history.Data) == 0 {
+		return item, nil
+	}
+
+	last := history.Data[len(history.Data)-1]
+	item.NAV, item.NAVDate, item.Price = last.Value, last.Date, last.Value
+
+	if s.intradayService != nil {
+		if date, point, ok := s.intradayService.LatestPoint(holding.Code); ok && date == today && point.Value > 0 {
+			item.Estimate, item.EstimateRate, item.EstimateTime = point.Value, point.Rate, point.Time
+		}
+	}
+
+	switch {
+	case last.Date == today && len(history.Data) > 1:
+		// 当日净值已公布
+		prev := history.Data[len(history.Data)-2]
+		item.TodayProfit = holding.Shares * (last.Value - prev.Value)
+	case item.Estimate > 0 && last.Date < today:
+		item.Price = item.Estimate
+		item.TodayProfit = holding.Shares * (item.Estimate - last.Value)
+	}
+
+	item.MarketValue = roundMoney(holding.Shares * item.Price)
+	item.TodayProfit = roundMoney(item.TodayProfit)
+	item.TotalProfit = roundMoney(item.MarketValue - holding.Cost + holding.Realized)
+	if holding.Cost > 0 {
+		item.TotalReturn = roundMetric(item.TotalProfit / holding.Cost * 100)
+	}
+	return item, nil
+}
+
+// fundName 获取基金名称（同时校验基金代码存在）
+func (s *PortfolioService) fundName(fundCode string) (string, error) {
+	history, err := s.fundService.fetchNAVHistory(fundCode)
+	if err != nil {
+		log.Printf("⚠️  获取基金 %s 信息失败: %v", fundCode, err)
+		return "", fmt.Errorf("获取基金 %s 信息失败: %v", fundCode, err)
+	}
+	return history.Name, nil
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"errors"
+	"fund/model"
+	"fund/storage"
+	"testing"
+	"time"
+)
+
+// TestPortfolioService 测试买入、卖出、分红对持仓的影响以及按估值/官方净值计算收益
+func TestPortfolioService(t *testing.T) {
+	fundService := NewFundServiceWithProvider(NewEastmoneyProvider())
+	now := time.Date(2024, 1, 10, 10, 30, 0, 0, chinaZone)
+	fundService.SetClock(func() time.Time { return now })
+	fundService.navCache["000001"] = &navCacheEntry{
+		refreshedAt: now,
+		trend: &model.FundTrend{Code: "000001", Name: "测试基金", Data: []model.TrendPoint{
+			{Date: "2024-01-08", Value: 1.0},
+			{Date: "2024-01-09", Value: 1.2},
+		}},
+	}
+	intradayService := newTestIntradayService(t, NewEastmoneyProvider())
+	intradayService.intradayData["000001"] = &model.FundIntradayData{
+		Code: "000001",
+		Date: "2024-01-10",
+		Data: []model.IntradayPoint{{Time: "10:30", Value: 1.26, Rate: 5}},
+	}
+
+	portfolioService := NewPortfolioService(storage.NewFilePortfolioStore(t.TempDir()), fundService, intradayService)
+	portfolioService.SetClock(func() time.Time { return now })
+
+	// 按金额和净值买入: (1010 - 10) / 1.0 = 1000 份
+	if _, holding, err := portfolioService.AddTransaction(1, model.Transaction{
+		Code: "000001", Type: "buy", Date: "2024-01-08", Amount: 1010, Fee: 10, Price: 1.0,
+	}); err != nil || holding.Shares != 1000 || holding.Cost != 1010 || holding.Name != "测试基金" {
+		t.Fatalf("❌ 买入后持仓异常: %+v %v", holding, err)
+	}
+
+	// 卖出 400 份到账 480 元: 按平均成本扣减 404 元，已实现收益 76 元
+	if _, holding, err := portfolioService.AddTransaction(1, model.Transaction{
+		Code: "000001", Type: "sell", Date: "2024-01-09", Shares: 400, Amount: 480,
+	}); err != nil || holding.Shares != 600 || holding.Cost != 606 || holding.Realized != 76 {
+		t.Fatalf("❌ 卖出后持仓异常: %+v %v", holding, err)
+	}
+
+	// 现金分红 12 元、红利再投资 10 份
+	if _, holding, err := portfolioService.AddTransaction(1, model.Transaction{
+		Code: "000001", Type: "dividend", Date: "2024-01-09", Amount: 12, Shares: 10,
+	}); err != nil || holding.Shares != 610 || holding.Cost != 606 || holding.Realized != 88 {
+		t.Fatalf("❌ 分红后持仓异常: %+v %v", holding, err)
+	}
+
+	for _, tx := range []model.Transaction{
+		{Code: "000001", Type: "sell", Shares: 1000, Amount: 1},
+		{Code: "000001", Type: "transfer", Shares: 1},
+		{Code: "000001", Type: "buy", Date: "2024/01/09", Amount: 100, Price: 1},
+	} {
+		if _, _, err := portfolioService.AddTransaction(1, tx); !errors.Is(err, ErrInvalidPortfolio) {
+			t.Errorf("❌ %+v 应返回 ErrInvalidPortfolio: %v", tx, err)
+		}
+	}
+	if _, _, err := portfolioService.AddTransaction(1, model.Transaction{Code: "110022", Type: "sell", Shares: 1}); !errors.Is(err, ErrHoldingNotFound) {
+		t.Errorf("❌ 卖出未持有的基金应返回 ErrHoldingNotFound: %v", err)
+	}
+	if transactions, err := portfolioService.ListTransactions(1, "000001"); err != nil || len(transactions) != 3 {
+		t.Errorf("❌ 交易记录异常: %+v %v", transactions, err)
+	}
+
+	// 当日净值未公布: 按估值 1.26 计算市值，当日收益 = 610 × (1.26 - 1.2)
+	valuation, err := portfolioService.Valuate(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	item := valuation.Holdings[0]
+	if item.Price != 1.26 || item.MarketValue != 768.6 || item.TodayProfit != 36.6 || item.TotalProfit != 250.6 {
+		t.Errorf("❌ 按估值计算的持仓收益异常: %+v", item)
+	}
+	if valuation.Cost != 606 || valuation.TotalReturn != roundMetric(250.6/606*100) {
+		t.Errorf("❌ 组合汇总异常: %+v", valuation)
+	}
+
+	// 当日净值已公布: 以官方净值为准
+	fundService.navCache["000001"].trend.Data = append(fundService.navCache["000001"].trend.Data,
+		model.TrendPoint{Date: "2024-01-10", Value: 1.25})
+	valuation, err = portfolioService.Valuate(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item := valuation.Holdings[0]; item.Price != 1.25 || item.MarketValue != 762.5 || item.TodayProfit != 30.5 {
+		t.Errorf("❌ 按官方净值计算的持仓收益异常: %+v", item)
+	}
+
+	if err := portfolioService.DeleteHolding(1, "000001"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := portfolioService.GetHolding(1, "000001"); !errors.Is(err, ErrHoldingNotFound) {
+		t.Errorf("❌ 删除后应返回 ErrHoldingNotFound: %v", err)
+	}
+}
+
+// TestPortfolioIntradayCurve 测试按份额加权合并日内估值并前向填充缺失的估值点
+func TestPortfolioIntradayCurve(t *testing.T) {
+	fundService := NewFundServiceWithProvider(NewEastmoneyProvider())
+	now := time.Date(2024, 1, 10, 10, 30, 0, 0, chinaZone)
+	fundService.SetClock(func() time.Time { return now })
+	for code, nav := range map[string]float64{"000001": 1.0, "110022": 2.0, "161725": 1.5} {
+		fundService.navCache[code] = &navCacheEntry{
+			refreshedAt: now,
+			trend: &model.FundTrend{Code: code, Name: "基金" + code, Data: []model.TrendPoint{
+				{Date: "2024-01-09", Value: nav},
+			}},
+		}
+	}
+	intradayService := newTestIntradayService(t, NewEastmoneyProvider())
+	intradayService.intradayData["000001"] = &model.FundIntradayData{Code: "000001", Date: "2024-01-10", Data: []model.IntradayPoint{
+		{Time: "09:30", Value: 1.01, Rate: 1},
+		{Time: "09:32", Value: 1.02, Rate: 2},
+	}}
+	intradayService.intradayData["110022"] = &model.FundIntradayData{Code: "110022", Date: "2024-01-10", Data: []model.IntradayPoint{
+		{Time: "09:31", Value: 1.9, Rate: -5},
+	}}
+
+	store := storage.NewFilePortfolioStore(t.TempDir())
+	for _, holding := range []model.Holding{
+		{Code: "000001", Shares: 1000, Cost: 1000},
+		{Code: "110022", Shares: 100, Cost: 200},
+		{Code: "161725", Shares: 200, Cost: 300},
+	} {
+		if err := store.SaveHolding(1, holding); err != nil {
+			t.Fatal(err)
+		}
+	}
+	portfolioService := NewPortfolioService(store, fundService, intradayService)
+	portfolioService.SetClock(func() time.Time { return now })
+
+	curve, err := portfolioService.GetIntradayCurve(1, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 昨收市值: 1000×1.0 + 100×2.0 + 200×1.5 = 1500
+	if curve.Date != "2024-01-10" || curve.BaseValue != 1500 || len(curve.Missing) != 1 || curve.Missing[0] != "161725" {
+		t.Fatalf("❌ 组合曲线汇总异常: %+v", curve)
+	}
+	want := []model.PortfolioIntradayPoint{
+		{Time: "09:30", Value: 1510, Profit: 10, Rate: roundMetric(10.0 / 1500 * 100)}, // 110022 尚无估值，按昨收计入
+		{Time: "09:31", Value: 1500, Profit: 0, Rate: 0},                               // 000001 沿用 09:30 的估值
+		{Time: "09:32", Value: 1510, Profit: 10, Rate: roundMetric(10.0 / 1500 * 100)}, // 110022 沿用 09:31 的估值
+	}
+	if len(curve.Data) != len(want) {
+		t.Fatalf("❌ 曲线点数 %d, 期望 %d: %+v", len(curve.Data), len(want), curve.Data)
+	}
+	for i := range want {
+		if curve.Data[i] != want[i] {
+			t.Errorf("❌ 第 %d 个点 %+v, 期望 %+v", i, curve.Data[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"fmt"
+	"fund/model"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Provider 上游数据源接口
+// 不同的数据源（东方财富、天天基金等）通过实现该接口接入，服务层只依赖接口
+type Provider interface {
+	// Name 数据源名称
+	Name() string
+	// FetchFundList 获取全量基金列表
+	FetchFundList() ([]model.FundBasicInfo, error)
+	// FetchFundDetail 获取基金详情（名称、净值、阶段涨幅等）
+	// 部分字段解析失败时同时返回已解析的数据和错误
+	FetchFundDetail(fundCode string) (map[string]string, error)
+	// FetchFundProfile 获取基金档案（持仓、资产配置、规模、基金经理、费率）
+	// 部分字段解析失败时同时返回已解析的数据和错误
+	FetchFundProfile(fundCode string) (*model.FundProfile, error)
+	// FetchRealtimeEstimate 获取单只基金的实时估值
+	FetchRealtimeEstimate(fundCode string) (*model.RealtimeData, error)
+	// FetchNAVHistory 获取基金历史净值走势（全部数据）
+	FetchNAVHistory(fundCode string) (*model.FundTrend, error)
+	// FetchNAVSince 获取 since（YYYY-MM-DD，不含）之后的历史净值，按日期升序，用于增量更新
+	FetchNAVSince(fundCode, since string) ([]model.TrendPoint, error)
+	// FetchReturnSeries 获取累计收益率曲线，第一条为本基金，其余为同类平均、业绩比较基准等
+	FetchReturnSeries(fundCode string) ([]model.ReturnSeries, error)
+	// FetchBatchQuotes 分页批量获取基金行情
+	// 返回 map[基金代码] = {name, netValue, dayGrowth, updateDate}
+	// 个别记录解析失败时同时返回其余记录和错误
+	FetchBatchQuotes(page, pageSize int) (map[string]map[string]interface{}, error)
+}
+
+// ProviderFactory 数据源构造函数
+// baseURL 用于覆盖上游接口地址（如本地模拟上游），为空时使用数据源默认地址
+type ProviderFactory func(baseURL string) Provider
+
+var (
+	providerMutex     sync.RWMutex
+	providerFactories = map[string]ProviderFactory{
+		"eastmoney": func(baseURL string) Provider {
+			return NewEastmoneyProviderWithBaseURL(baseURL)
+		},
+		"eastmoney-batch": func(baseURL string) Provider {
+			return NewBatchEstimateProvider(NewEastmoneyProviderWithBaseURL(baseURL), time.Minute)
+		},
+	}
+)
+
+// RegisterProvider 注册数据源，同名数据源会被覆盖
+func RegisterProvider(name string, factory ProviderFactory) {
+	providerMutex.Lock()
+	defer providerMutex.Unlock()
+	providerFactories[name] = factory
+}
+
+// NewProvider 根据名称创建数据源
+func NewProvider(name, baseURL string) (Provider, error) {
+	providerMutex.RLock()
+	factory, exists := providerFactories[name]
+	providerMutex.RUnlock()
+
+	if !exists {
+		return nil, fmt.Errorf("未知的数据源: %s, 可选值: %v", name, ProviderNames())
+	}
+	return factory(baseURL), nil
+}
+
+// ProviderNames 获取已注册的数据源名称
+func ProviderNames() []string {
+	providerMutex.RLock()
+	defer providerMutex.RUnlock()
+
+	names := make([]string, 0, len(providerFactories))
+	for name := range providerFactories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
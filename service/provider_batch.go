@@ -0,0 +1,116 @@
+package service
+
+import (
+	"fmt"
+	"fund/model"
+	"log"
+	"sync"
+	"time"
+)
+
+// maxSnapshotPages 批量行情快照的最大页数（防止上游分页异常导致死循环）
+const maxSnapshotPages = 200
+
+// BatchEstimateProvider 基于批量行情接口的实时估值数据源
+// 通过分页拉取批量行情生成全市场快照，并在快照中查找单只基金，
+// 主要作为单基金估值接口被限流时的备用数据源
+type BatchEstimateProvider struct {
+	Provider // 底层数据源，除实时估值外的接口直接委托
+
+	pageSize    int           // 每页数量
+	snapshotTTL time.Duration // 快照有效期
+
+	snapshotMutex sync.Mutex                        // 快照锁（同一时间只刷新一次）
+	snapshot      map[string]map[string]interface{} // 全市场行情快照
+	snapshotTime  time.Time                         // 快照生成时间
+}
+
+// NewBatchEstimateProvider 创建批量估值数据源
+func NewBatchEstimateProvider(base Provider, snapshotTTL time.Duration) *BatchEstimateProvider {
+	return &BatchEstimateProvider{
+		Provider:    base,
+		pageSize:    200,
+		snapshotTTL: snapshotTTL,
+	}
+}
+
+// Name 数据源名称
+func (p *BatchEstimateProvider) Name() string {
+	return p.Provider.Name() + "-batch"
+}
+
+// FetchRealtimeEstimate 从批量行情快照中获取实时估值
+func (p *BatchEstimateProvider) FetchRealtimeEstimate(fundCode string) (*model.RealtimeData, error) {
+	snapshot, err := p.getSnapshot()
+	if err != nil {
+		return nil, err
+	}
+
+	data, exists := snapshot[fundCode]
+	if !exists {
+		return nil, fmt.Errorf("批量行情中未找到基金 %s", fundCode)
+	}
+
+	netValue, _ := data["netValue"].(string)
+	if netValue == "" || netValue == "---" {
+		return nil, fmt.Errorf("基金 %s 暂无有效行情", fundCode)
+	}
+
+	realtimeData := &model.RealtimeData{
+		FundCode: fundCode,
+		Gsz:      netValue,
+		Source:   p.Name(),
+	}
+	realtimeData.Name, _ = data["name"].(string)
+	realtimeData.GsZzl, _ = data["dayGrowth"].(string)
+	realtimeData.Gztime, _ = data["updateDate"].(string)
+
+	return realtimeData, nil
+}
+
+// getSnapshot 获取全市场行情快照，过期时重新拉取
+func (p *BatchEstimateProvider) getSnapshot() (map[string]map[string]interface{}, error) {
+	p.snapshotMutex.Lock()
+	defer p.snapshotMutex.Unlock()
+
+	if p.snapshot != nil && time.Since(p.snapshotTime) < p.snapshotTTL {
+		return p.snapshot, nil
+	}
+
+	startTime := time.Now()
+	snapshot := make(map[string]map[string]interface{})
+	for page := 1; page <= maxSnapshotPages; page++ {
+		if page > 1 {
+			// 请求间隔，避免触发反爬虫
+			time.Sleep(200 * time.Millisecond)
+		}
+
+		pageData, err := p.Provider.FetchBatchQuotes(page, p.pageSize)
+		if err != nil && pageData != nil {
+			// 个别记录解析失败，使用其余记录
+			log.Printf("⚠️  批量行情第 %d 页部分记录解析失败: %v", page, err)
+		} else if err != nil {
+			if len(snapshot) == 0 {
+				return nil, fmt.Errorf("获取批量行情失败: %v", err)
+			}
+			// 部分页失败时使用已获取的数据
+			log.Printf("⚠️  获取批量行情第 %d 页失败: %v", page, err)
+			break
+		}
+
+		for code, data := range pageData {
+			snapshot[code] = data
+		}
+
+		// 最后一页
+		if len(pageData) < p.pageSize {
+			break
+		}
+	}
+
+	p.snapshot = snapshot
+	p.snapshotTime = time.Now()
+	log.Printf("🔄 已刷新批量行情快照: %d 只基金, 耗时 %v", len(snapshot), time.Since(startTime))
+
+	return p.snapshot, nil
+}
@@ -0,0 +1,883 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"fund/model"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxTimestampMillis 上游时间戳上限（9999-12-31），超出视为格式错误
+const maxTimestampMillis = 253402214400000
+
+// chinaZone 北京时间，上游的日期和时间戳均以北京时间为准
+var chinaZone = time.FixedZone("CST", 8*3600)
+
+// 东方财富默认接口地址
+const (
+	defaultFundBaseURL     = "http://fund.eastmoney.com"      // 基金数据（pingzhongdata、基金列表）
+	defaultBatchBaseURL    = "https://fund.eastmoney.com"     // 批量行情
+	defaultEstimateBaseURL = "http://fundgz.1234567.com.cn"   // 实时估值
+	defaultNAVBaseURL      = "https://api.fund.eastmoney.com" // 历史净值分页接口
+)
+
+// navPageSize 历史净值分页接口每页数量
+const navPageSize = 40
+
+// EastmoneyProvider 东方财富/天天基金数据源
+type EastmoneyProvider struct {
+	httpClient      *http.Client
+	fundBaseURL     string // 基金数据接口地址
+	batchBaseURL    string // 批量行情接口地址
+	estimateBaseURL string // 实时估值接口地址
+	navBaseURL      string // 历史净值分页接口地址
+}
+
+// NewEastmoneyProvider 创建东方财富数据源实例
+func NewEastmoneyProvider() *EastmoneyProvider {
+	return &EastmoneyProvider{
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+		fundBaseURL:     defaultFundBaseURL,
+		batchBaseURL:    defaultBatchBaseURL,
+		estimateBaseURL: defaultEstimateBaseURL,
+		navBaseURL:      defaultNAVBaseURL,
+	}
+}
+
+// NewEastmoneyProviderWithBaseURL 使用指定地址创建东方财富数据源实例
+// 所有接口都指向 baseURL，用于接入本地模拟上游或代理；baseURL 为空时使用默认地址
+func NewEastmoneyProviderWithBaseURL(baseURL string) *EastmoneyProvider {
+	p := NewEastmoneyProvider()
+	if baseURL != "" {
+		baseURL = strings.TrimRight(baseURL, "/")
+		p.fundBaseURL = baseURL
+		p.batchBaseURL = baseURL
+		p.estimateBaseURL = baseURL
+		p.navBaseURL = baseURL
+	}
+	return p
+}
+
+// Name 数据源名称
+func (p *EastmoneyProvider) Name() string {
+	return "eastmoney"
+}
+
+// FetchFundList 获取全量基金列表
+func (p *EastmoneyProvider) FetchFundList() ([]model.FundBasicInfo, error) {
+	url := p.fundBaseURL + "/js/fundcode_search.js"
+
+	body, err := p.get(url)
+	if err != nil {
+		return nil, fmt.Errorf("获取基金列表失败: %v", err)
+	}
+
+	return p.parseFundListJS(string(body))
+}
+
+// FetchFundDetail 获取基金详情数据
+func (p *EastmoneyProvider) FetchFundDetail(fundCode string) (map[string]string, error) {
+	body, err := p.fetchPingzhongData(fundCode)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.parseFundDetailJS(string(body))
+}
+
+// FetchRealtimeEstimate 获取实时估值数据
+func (p *EastmoneyProvider) FetchRealtimeEstimate(fundCode string) (*model.RealtimeData, error) {
+	timestamp := time.Now().UnixNano() / 1e6
+	url := fmt.Sprintf("%s/js/%s.js?rt=%d", p.estimateBaseURL, fundCode, timestamp)
+
+	// 创建带超时的请求
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := p.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.parseRealtimeJS(string(body))
+}
+
+// FetchNAVHistory 获取基金历史净值走势
+func (p *EastmoneyProvider) FetchNAVHistory(fundCode string) (*model.FundTrend, error) {
+	body, err := p.fetchPingzhongData(fundCode)
+	if err != nil {
+		return nil, fmt.Errorf("获取基金数据失败: %v", err)
+	}
+
+	doc := parseJSVars("pingzhongdata", string(body))
+
+	// 提取净值走势数据
+	trendData, err := p.netWorthTrend(doc)
+	if err != nil {
+		return nil, fmt.Errorf("解析走势数据失败: %v", err)
+	}
+
+	// 基金名称缺失不影响走势数据
+	fundName, _ := doc.str("fS_name")
+
+	return &model.FundTrend{
+		Code:   fundCode,
+		Name:   fundName,
+		Period: "all",
+		Data:   trendData,
+	}, nil
+}
+
+// FetchBatchQuotes 批量获取基金行情
+func (p *EastmoneyProvider) FetchBatchQuotes(page, pageSize int) (map[string]map[string]interface{}, error) {
+	timestamp := time.Now().UnixNano() / 1e6
+	// 东方财富批量基金接口
+	url := fmt.Sprintf("%s/Data/Fund_JJJZ_Data.aspx?t=10&lx=1&letter=&gsid=&text=&sort=rzdf,desc&page=%d,%d&dt=%d&atfc=&onlySale=0&isLatest=0&_=%d",
+		p.batchBaseURL, page, pageSize, timestamp, timestamp)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("创建请求失败: %v", err)
+	}
+
+	// 设置请求头，模拟浏览器
+	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36")
+	req.Header.Set("Referer", "https://fund.eastmoney.com/data/fundranking.html")
+
+	body, err := p.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("请求失败: %v", err)
+	}
+
+	// 解析响应数据
+	return p.parseBatchFundsForRealtime(string(body))
+}
+
+// FetchNAVSince 获取 since 之后的历史净值（分页接口，只下载新增部分）
+func (p *EastmoneyProvider) FetchNAVSince(fundCode, since string) ([]model.TrendPoint, error) {
+	var result []model.TrendPoint
+	for page := 1; ; page++ {
+		url := fmt.Sprintf("%s/f10/lsjz?fundCode=%s&pageIndex=%d&pageSize=%d&startDate=%s&endDate=",
+			p.navBaseURL, fundCode, page, navPageSize, since)
+		req, err := http.NewRequest("GET", url, nil)
+		if err != nil {
+			return nil, err
+		}
+		// 该接口校验 Referer
+		req.Header.Set("Referer", "https://fundf10.eastmoney.com/")
+
+		body, err := p.do(req)
+		if err != nil {
+			return nil, fmt.Errorf("获取历史净值失败: %v", err)
+		}
+		points, total, err := p.parseNAVListJSON(string(body))
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, points...)
+
+		if len(points) == 0 || page*navPageSize >= total {
+			break
+		}
+	}
+
+	// 接口按日期倒序返回且包含 since 当天
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Date < result[j].Date
+	})
+	filtered := result[:0]
+	for _, point := range result {
+		if point.Date > since {
+			filtered = append(filtered, point)
+		}
+	}
+	return filtered, nil
+}
+
+// FetchReturnSeries 获取基金、同类平均和基准的累计收益率曲线
+func (p *EastmoneyProvider) FetchReturnSeries(fundCode string) ([]model.ReturnSeries, error) {
+	body, err := p.fetchPingzhongData(fundCode)
+	if err != nil {
+		return nil, fmt.Errorf("获取基金数据失败: %v", err)
+	}
+	return p.parseReturnSeriesJS(string(body))
+}
+
+// FetchFundProfile 获取基金档案（持仓、资产配置、规模、基金经理、费率）
+func (p *EastmoneyProvider) FetchFundProfile(fundCode string) (*model.FundProfile, error) {
+	body, err := p.fetchPingzhongData(fundCode)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.parseFundProfileJS(string(body))
+}
+
+// fetchPingzhongData 获取 pingzhongdata 基金数据JS
+func (p *EastmoneyProvider) fetchPingzhongData(fundCode string) ([]byte, error) {
+	timestamp := time.Now().UnixNano() / 1e6
+	url := fmt.Sprintf("%s/pingzhongdata/%s.js?v=%d", p.fundBaseURL, fundCode, timestamp)
+	return p.get(url)
+}
+
+// get 发起GET请求并读取响应体
+func (p *EastmoneyProvider) get(url string) ([]byte, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return p.do(req)
+}
+
+// do 发送请求并读取响应体，非 200 状态码视为失败
+func (p *EastmoneyProvider) do(req *http.Request) ([]byte, error) {
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("上游返回状态码 %d", resp.StatusCode)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+// parseFundListJS 解析基金列表JS
+// 格式: var r = [["000001","HXCZHH","华夏成长混合","混合型-偏股","HUAXIACHENGZHANGHUNHE"],...]
+func (p *EastmoneyProvider) parseFundListJS(jsContent string) ([]model.FundBasicInfo, error) {
+	doc := parseJSVars("fundcode_search", jsContent)
+
+	var rawList [][]string
+	if err := doc.decode("r", &rawList); err != nil {
+		return nil, err
+	}
+
+	// 转换为基金信息列表
+	fundList := make([]model.FundBasicInfo, 0, len(rawList))
+	for i, item := range rawList {
+		if len(item) < 4 {
+			return nil, invalidField(doc.parser, fmt.Sprintf("r[%d]", i), fmt.Errorf("字段数 %d 少于 4", len(item)))
+		}
+		fundList = append(fundList, model.FundBasicInfo{
+			Code: item[0],
+			Name: item[2],
+			Type: item[3],
+		})
+	}
+
+	return fundList, nil
+}
+
+// parseFundDetailJS 解析东方财富基金详情JS
+// 名称、代码缺失时返回 nil；其余字段解析失败时返回已解析的部分数据和 *ParseError
+func (p *EastmoneyProvider) parseFundDetailJS(jsContent string) (map[string]string, error) {
+	doc := parseJSVars("pingzhongdata", jsContent)
+	result := make(map[string]string)
+	var errs []error
+
+	// 提取基金名称、代码
+	name, err := doc.str("fS_name")
+	if err != nil {
+		return nil, err
+	}
+	result["name"] = name
+
+	code, err := doc.str("fS_code")
+	if err != nil {
+		return nil, err
+	}
+	result["code"] = code
+
+	// 最新净值、日涨幅、周涨幅 (Data_netWorthTrend)
+	trendData, err := p.netWorthTrend(doc)
+	if err != nil {
+		errs = append(errs, err)
+	} else if len(trendData) > 0 {
+		result["currentPrice"] = fmt.Sprintf("%.4f", trendData[len(trendData)-1].Value)
+		if growth, ok := growthSince(trendData, 1); ok {
+			result["dayGrowth"] = growth
+		}
+		if growth, ok := growthSince(trendData, 7); ok {
+			result["weekGrowth"] = growth
+		}
+	}
+
+	// 阶段收益率 (syl_1y 近1月, syl_3y 近3月, syl_6y 近6月, syl_1n 近1年)
+	stageGrowth := []struct{ key, variable string }{
+		{"monthGrowth", "syl_1y"},
+		{"threeMonth", "syl_3y"},
+		{"sixMonth", "syl_6y"},
+		{"yearGrowth", "syl_1n"},
+	}
+	for _, stage := range stageGrowth {
+		value, err := doc.str(stage.variable)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			errs = append(errs, invalidField(doc.parser, stage.variable, err))
+			continue
+		}
+		result[stage.key] = value
+	}
+
+	// 成立以来（区间）累计收益率，取 Data_grandTotal 第一条曲线（本基金）的最后一个值
+	if series, err := grandTotal(doc); err != nil {
+		errs = append(errs, err)
+	} else if data := series[0].Data; len(data) == 0 {
+		errs = append(errs, newParseError(doc.parser, "Data_grandTotal[0].data", ErrFieldMissing))
+	} else {
+		result["totalGrowth"] = fmt.Sprintf("%.2f", data[len(data)-1].Return)
+	}
+
+	return result, errors.Join(errs...)
+}
+
+// parseReturnSeriesJS 解析东方财富基金详情JS中的累计收益率曲线（本基金、同类平均、基准）
+func (p *EastmoneyProvider) parseReturnSeriesJS(jsContent string) ([]model.ReturnSeries, error) {
+	return grandTotal(parseJSVars("pingzhongdata", jsContent))
+}
+
+// grandTotal 从 Data_grandTotal 变量读取全部累计收益率曲线
+// 格式: [{name:'华夏成长混合',data:[[1719763200000,0.0],...]},{name:'同类平均',...},{name:'沪深300',...}]
+// 第一条为本基金，名称含"同类"的为同类平均，其余为业绩比较基准或指数
+func grandTotal(doc *jsDocument) ([]model.ReturnSeries, error) {
+	var raw []struct {
+		Name string          `json:"name"`
+		Data [][]interface{} `json:"data"`
+	}
+	if err := doc.decode("Data_grandTotal", &raw); err != nil {
+		return nil, err
+	}
+	if len(raw) == 0 {
+		return nil, newParseError(doc.parser, "Data_grandTotal", ErrFieldMissing)
+	}
+
+	series := make([]model.ReturnSeries, 0, len(raw))
+	for i, item := range raw {
+		role := "benchmark"
+		switch {
+		case i == 0:
+			role = "fund"
+		case strings.Contains(item.Name, "同类"):
+			role = "peer"
+		}
+
+		points := make([]model.ReturnPoint, 0, len(item.Data))
+		for j, pair := range item.Data {
+			field := fmt.Sprintf("Data_grandTotal[%d].data[%d]", i, j)
+			if len(pair) < 2 {
+				return nil, invalidField(doc.parser, field, nil)
+			}
+			timestamp, ok := pair[0].(float64)
+			if !ok || timestamp < 0 || timestamp > maxTimestampMillis {
+				return nil, invalidField(doc.parser, field, nil)
+			}
+			value, ok := pair[1].(float64)
+			if !ok {
+				// 上游对停牌、未成立等日期返回 null，跳过该数据点
+				continue
+			}
+			points = append(points, model.ReturnPoint{
+				Date:   time.UnixMilli(int64(timestamp)).In(chinaZone).Format("2006-01-02"),
+				Return: value,
+			})
+		}
+		series = append(series, model.ReturnSeries{Name: item.Name, Role: role, Data: points})
+	}
+	return series, nil
+}
+
+// parseFundProfileJS 解析东方财富基金详情JS中的档案数据
+// 名称、代码缺失时返回 nil；其余部分解析失败时返回已解析的部分数据和 *ParseError
+func (p *EastmoneyProvider) parseFundProfileJS(jsContent string) (*model.FundProfile, error) {
+	doc := parseJSVars("pingzhongdata", jsContent)
+	var errs []error
+
+	name, err := doc.str("fS_name")
+	if err != nil {
+		return nil, err
+	}
+	code, err := doc.str("fS_code")
+	if err != nil {
+		return nil, err
+	}
+
+	profile := &model.FundProfile{
+		Code:            code,
+		Name:            name,
+		Holdings:        []model.StockHolding{},
+		BondCodes:       []string{},
+		AssetAllocation: []model.AssetAllocation{},
+		Scale:           []model.ScaleChange{},
+		HolderStructure: []model.HolderStructure{},
+		Managers:        []model.FundManager{},
+	}
+
+	// 持仓股票代码 (stockCodesNew 格式: 市场号.代码)
+	var stockCodes []string
+	if err := doc.decode("stockCodesNew", &stockCodes); err != nil {
+		errs = append(errs, err)
+	}
+	for i, stockCode := range stockCodes {
+		marketID, code, ok := strings.Cut(stockCode, ".")
+		if !ok || code == "" {
+			errs = append(errs, invalidField(doc.parser, fmt.Sprintf("stockCodesNew[%d]", i), fmt.Errorf("%q", stockCode)))
+			continue
+		}
+		profile.Holdings = append(profile.Holdings, model.StockHolding{
+			Code:   code,
+			Market: stockMarkets[marketID],
+		})
+	}
+
+	// 持仓债券代码 (zqCodesNew 为逗号分隔的字符串)
+	if bondCodes, err := doc.str("zqCodesNew"); err != nil {
+		errs = append(errs, err)
+	} else {
+		for _, bondCode := range strings.Split(bondCodes, ",") {
+			if bondCode = strings.TrimSpace(bondCode); bondCode != "" {
+				profile.BondCodes = append(profile.BondCodes, bondCode)
+			}
+		}
+	}
+
+	// 资产配置
+	var allocation chartSeries
+	if err := doc.decode("Data_assetAllocation", &allocation); err != nil {
+		errs = append(errs, err)
+	} else {
+		for i, date := range allocation.Categories {
+			profile.AssetAllocation = append(profile.AssetAllocation, model.AssetAllocation{
+				Date:      date,
+				Stock:     allocation.value("股票占净比", i),
+				Bond:      allocation.value("债券占净比", i),
+				Cash:      allocation.value("现金占净比", i),
+				NetAssets: allocation.value("净资产", i),
+			})
+		}
+	}
+
+	// 规模变动
+	var scale struct {
+		Categories []string `json:"categories"`
+		Series     []struct {
+			Y   float64 `json:"y"`
+			Mom string  `json:"mom"`
+		} `json:"series"`
+	}
+	if err := doc.decode("Data_fluctuationScale", &scale); err != nil {
+		errs = append(errs, err)
+	} else if len(scale.Categories) != len(scale.Series) {
+		errs = append(errs, invalidField(doc.parser, "Data_fluctuationScale",
+			fmt.Errorf("报告期 %d 个, 数据 %d 个", len(scale.Categories), len(scale.Series))))
+	} else {
+		for i, date := range scale.Categories {
+			profile.Scale = append(profile.Scale, model.ScaleChange{
+				Date:   date,
+				Scale:  scale.Series[i].Y,
+				Change: scale.Series[i].Mom,
+			})
+		}
+	}
+
+	// 持有人结构
+	var holders chartSeries
+	if err := doc.decode("Data_holderStructure", &holders); err != nil {
+		errs = append(errs, err)
+	} else {
+		for i, date := range holders.Categories {
+			profile.HolderStructure = append(profile.HolderStructure, model.HolderStructure{
+				Date:        date,
+				Institution: holders.value("机构持有比例", i),
+				Individual:  holders.value("个人持有比例", i),
+				Internal:    holders.value("内部持有比例", i),
+			})
+		}
+	}
+
+	// 现任基金经理
+	if err := doc.decode("Data_currentFundManager", &profile.Managers); err != nil {
+		profile.Managers = []model.FundManager{}
+		errs = append(errs, err)
+	}
+
+	// 申购费率
+	fees := []struct {
+		field    *string
+		variable string
+	}{
+		{&profile.Fees.SourceRate, "fund_sourceRate"},
+		{&profile.Fees.Rate, "fund_Rate"},
+		{&profile.Fees.MinPurchase, "fund_minsg"},
+	}
+	for _, fee := range fees {
+		value, err := doc.str(fee.variable)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		*fee.field = value
+	}
+
+	return profile, errors.Join(errs...)
+}
+
+// stockMarkets 东方财富市场号 -> 交易市场
+var stockMarkets = map[string]string{
+	"0":   "SZ",
+	"1":   "SH",
+	"116": "HK",
+}
+
+// chartSeries 东方财富图表数据格式 {series:[{name, data:[...]}], categories:[...]}
+type chartSeries struct {
+	Categories []string `json:"categories"`
+	Series     []struct {
+		Name string     `json:"name"`
+		Data []*float64 `json:"data"`
+	} `json:"series"`
+}
+
+// value 获取指定名称曲线的第 i 个值，不存在时返回0
+func (c *chartSeries) value(name string, i int) float64 {
+	for _, series := range c.Series {
+		if series.Name == name && i < len(series.Data) && series.Data[i] != nil {
+			return *series.Data[i]
+		}
+	}
+	return 0
+}
+
+// growthSince 计算最新净值相对 days 个自然日前净值的涨幅（百分比字符串）
+// days 为 1 时取前一个交易日
+func growthSince(data []model.TrendPoint, days int) (string, bool) {
+	if len(data) < 2 {
+		return "", false
+	}
+	last := data[len(data)-1]
+	base := data[len(data)-2]
+	if days > 1 {
+		lastDate, err := time.Parse("2006-01-02", last.Date)
+		if err != nil {
+			return "", false
+		}
+		target := lastDate.AddDate(0, 0, -days).Format("2006-01-02")
+		found := false
+		for i := len(data) - 2; i >= 0; i-- {
+			if data[i].Date <= target {
+				base = data[i]
+				found = true
+				break
+			}
+		}
+		if !found {
+			return "", false
+		}
+	}
+	if base.Value == 0 {
+		return "", false
+	}
+	return fmt.Sprintf("%.2f", (last.Value/base.Value-1)*100), true
+}
+
+// parseNAVListJSON 解析历史净值分页接口响应，返回本页净值和总条数
+// 格式: {"Data":{"LSJZList":[{"FSRQ":"2025-06-30","DWJZ":"1.1112","LJJZ":"3.6012","JZZZL":"0.49","FHSP":""},...]},"ErrCode":0,"TotalCount":600}
+func (p *EastmoneyProvider) parseNAVListJSON(content string) ([]model.TrendPoint, int, error) {
+	const parser = "lsjz"
+
+	var resp struct {
+		Data *struct {
+			LSJZList []struct {
+				FSRQ  string `json:"FSRQ"`  // 净值日期
+				DWJZ  string `json:"DWJZ"`  // 单位净值
+				LJJZ  string `json:"LJJZ"`  // 累计净值
+				JZZZL string `json:"JZZZL"` // 日增长率（%）
+				FHSP  string `json:"FHSP"`  // 分红送配
+			} `json:"LSJZList"`
+		} `json:"Data"`
+		ErrCode    int    `json:"ErrCode"`
+		ErrMsg     string `json:"ErrMsg"`
+		TotalCount int    `json:"TotalCount"`
+	}
+	if err := json.Unmarshal([]byte(content), &resp); err != nil {
+		return nil, 0, invalidField(parser, "", err)
+	}
+	if resp.ErrCode != 0 {
+		return nil, 0, invalidField(parser, "ErrCode", fmt.Errorf("%d %s", resp.ErrCode, resp.ErrMsg))
+	}
+	if resp.Data == nil {
+		return nil, 0, newParseError(parser, "Data", ErrFieldMissing)
+	}
+
+	points := make([]model.TrendPoint, 0, len(resp.Data.LSJZList))
+	for i, item := range resp.Data.LSJZList {
+		if _, err := time.Parse("2006-01-02", item.FSRQ); err != nil {
+			return nil, 0, invalidField(parser, fmt.Sprintf("Data.LSJZList[%d].FSRQ", i), err)
+		}
+		value, err := strconv.ParseFloat(item.DWJZ, 64)
+		if err != nil {
+			return nil, 0, invalidField(parser, fmt.Sprintf("Data.LSJZList[%d].DWJZ", i), err)
+		}
+		// 累计净值、日增长率为辅助字段（新成立或暂停估值的基金可能为空），解析失败时忽略
+		accValue, _ := strconv.ParseFloat(item.LJJZ, 64)
+		dailyReturn, _ := strconv.ParseFloat(item.JZZZL, 64)
+		points = append(points, model.TrendPoint{
+			Date:        item.FSRQ,
+			Value:       value,
+			AccValue:    accValue,
+			DailyReturn: dailyReturn,
+			Dividend:    parseDividend(item.FHSP),
+		})
+	}
+
+	return points, resp.TotalCount, nil
+}
+
+// parseRealtimeJS 解析实时估值JS
+func (p *EastmoneyProvider) parseRealtimeJS(jsContent string) (*model.RealtimeData, error) {
+	const parser = "fundgz"
+
+	// 去除jsonpgz()包裹
+	content := strings.TrimSpace(jsContent)
+	if !strings.HasPrefix(content, "jsonpgz(") {
+		return nil, newParseError(parser, "jsonpgz", ErrFieldMissing)
+	}
+	s := &jsScanner{src: content, pos: len("jsonpgz(")}
+	s.skipSpace()
+	if s.consume(')') {
+		// 上游对没有估值的基金返回空的 jsonpgz()
+		return nil, newParseError(parser, "jsonpgz", fmt.Errorf("%w: 该基金暂无实时估值", ErrFieldMissing))
+	}
+
+	value, err := s.parseValue(0)
+	if err != nil {
+		return nil, invalidField(parser, "jsonpgz", err)
+	}
+	s.skipSpace()
+	if !s.consume(')') {
+		return nil, invalidField(parser, "jsonpgz", fmt.Errorf("位置 %d 期望 ')'", s.pos))
+	}
+
+	doc := &jsDocument{parser: parser, vars: map[string]interface{}{"jsonpgz": value}}
+	var realtimeData model.RealtimeData
+	if err := doc.decode("jsonpgz", &realtimeData); err != nil {
+		return nil, err
+	}
+
+	// 校验关键字段
+	if realtimeData.FundCode == "" {
+		return nil, newParseError(parser, "fundcode", ErrFieldMissing)
+	}
+	numericFields := []struct{ field, value string }{
+		{"gsz", realtimeData.Gsz},
+		{"gszzl", realtimeData.GsZzl},
+	}
+	for _, f := range numericFields {
+		if f.value == "" {
+			return nil, newParseError(parser, f.field, ErrFieldMissing)
+		}
+		if _, err := strconv.ParseFloat(f.value, 64); err != nil {
+			return nil, invalidField(parser, f.field, err)
+		}
+	}
+
+	return &realtimeData, nil
+}
+
+// extractNetWorthTrend 提取净值走势数据
+func (p *EastmoneyProvider) extractNetWorthTrend(jsContent string) ([]model.TrendPoint, error) {
+	return p.netWorthTrend(parseJSVars("pingzhongdata", jsContent))
+}
+
+// netWorthTrend 从 Data_netWorthTrend 变量读取净值走势
+func (p *EastmoneyProvider) netWorthTrend(doc *jsDocument) ([]model.TrendPoint, error) {
+	value, err := doc.value("Data_netWorthTrend")
+	if err != nil {
+		return nil, err
+	}
+	rawData, ok := value.([]interface{})
+	if !ok {
+		return nil, invalidField(doc.parser, "Data_netWorthTrend", fmt.Errorf("期望数组, 实际为 %T", value))
+	}
+
+	// 累计净值按时间戳对应
+	accValues := accWorthTrend(doc)
+
+	// 转换为 TrendPoint 数组
+	result := make([]model.TrendPoint, 0, len(rawData))
+	for i, raw := range rawData {
+		item, ok := raw.(map[string]interface{})
+		if !ok {
+			return nil, invalidField(doc.parser, fmt.Sprintf("Data_netWorthTrend[%d]", i), nil)
+		}
+		timestamp, ok := item["x"].(float64)
+		if !ok || timestamp < 0 || timestamp > maxTimestampMillis {
+			return nil, invalidField(doc.parser, fmt.Sprintf("Data_netWorthTrend[%d].x", i), nil)
+		}
+		value, ok := item["y"].(float64)
+		if !ok {
+			return nil, invalidField(doc.parser, fmt.Sprintf("Data_netWorthTrend[%d].y", i), nil)
+		}
+
+		// 转换时间戳为日期字符串（上游时间戳为北京时间零点）
+		date := time.UnixMilli(int64(timestamp)).In(chinaZone).Format("2006-01-02")
+
+		// 日增长率、分红送配为辅助字段，缺失或格式错误时忽略
+		dailyReturn, _ := item["equityReturn"].(float64)
+		unitMoney, _ := item["unitMoney"].(string)
+
+		result = append(result, model.TrendPoint{
+			Date:        date,
+			Value:       value,
+			AccValue:    accValues[int64(timestamp)],
+			DailyReturn: dailyReturn,
+			Dividend:    parseDividend(unitMoney),
+		})
+	}
+
+	return result, nil
+}
+
+// accWorthTrend 从 Data_ACWorthTrend 变量读取累计净值，key: 时间戳（毫秒）
+// 格式: [[1672675200000,3.651],...]，缺失或格式错误的数据点忽略
+func accWorthTrend(doc *jsDocument) map[int64]float64 {
+	result := make(map[int64]float64)
+	value, err := doc.value("Data_ACWorthTrend")
+	if err != nil {
+		return result
+	}
+	items, _ := value.([]interface{})
+	for _, raw := range items {
+		pair, ok := raw.([]interface{})
+		if !ok || len(pair) < 2 {
+			continue
+		}
+		timestamp, ok1 := pair[0].(float64)
+		accValue, ok2 := pair[1].(float64)
+		if ok1 && ok2 {
+			result[int64(timestamp)] = accValue
+		}
+	}
+	return result
+}
+
+var (
+	dividendCashPattern  = regexp.MustCompile(`派现金\s*([0-9.]+)\s*元`)
+	dividendSplitPattern = regexp.MustCompile(`(?:折算|分拆)\s*([0-9.]+)\s*份`)
+)
+
+// parseDividend 解析分红送配描述，如 "每份派现金0.0500元"、"每份基金份额折算1.0203份"
+// 描述为空时返回 nil；无法识别金额时只保留原始描述
+func parseDividend(text string) *model.Dividend {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil
+	}
+	dividend := &model.Dividend{Text: text}
+	if match := dividendCashPattern.FindStringSubmatch(text); match != nil {
+		dividend.Cash, _ = strconv.ParseFloat(match[1], 64)
+	}
+	if match := dividendSplitPattern.FindStringSubmatch(text); match != nil {
+		dividend.Split, _ = strconv.ParseFloat(match[1], 64)
+	}
+	return dividend
+}
+
+// parseBatchFundsForRealtime 解析批量基金响应为实时数据格式
+// 格式: var db={chars:[...],datas:[["000001","华夏成长混合",...],...],count:[...],...}
+// 个别记录格式错误时跳过该记录，返回其余记录和 *ParseError
+func (p *EastmoneyProvider) parseBatchFundsForRealtime(content string) (map[string]map[string]interface{}, error) {
+	doc := parseJSVars("Fund_JJJZ_Data", content)
+	result := make(map[string]map[string]interface{})
+	var errs []error
+
+	// 提取基金数据数组
+	var db struct {
+		Datas *[][]interface{} `json:"datas"`
+	}
+	if err := doc.decode("db", &db); err != nil {
+		return nil, err
+	}
+	if db.Datas == nil {
+		return nil, newParseError(doc.parser, "db.datas", ErrFieldMissing)
+	}
+
+	for i, record := range *db.Datas {
+		// 所有字段转换为字符串
+		fields := make([]string, len(record))
+		for j, field := range record {
+			switch v := field.(type) {
+			case string:
+				fields[j] = v
+			case float64:
+				fields[j] = strconv.FormatFloat(v, 'f', -1, 64)
+			}
+		}
+
+		// 至少要有基本字段
+		if len(fields) < 7 {
+			errs = append(errs, invalidField(doc.parser, fmt.Sprintf("datas[%d]", i),
+				fmt.Errorf("字段数 %d 少于 7", len(fields))))
+			continue
+		}
+
+		code := fields[0]
+		name := fields[1]
+		netValue := fields[4]
+		dayGrowth := fields[6]
+		updateDate := ""
+		if len(fields) > 16 {
+			updateDate = fields[16]
+		}
+
+		if code == "" {
+			errs = append(errs, newParseError(doc.parser, fmt.Sprintf("datas[%d].code", i), ErrFieldMissing))
+			continue
+		}
+		if !isBatchNumber(netValue) {
+			errs = append(errs, invalidField(doc.parser, fmt.Sprintf("datas[%d].netValue", i), fmt.Errorf("%q", netValue)))
+			continue
+		}
+		if !isBatchNumber(dayGrowth) {
+			errs = append(errs, invalidField(doc.parser, fmt.Sprintf("datas[%d].dayGrowth", i), fmt.Errorf("%q", dayGrowth)))
+			continue
+		}
+
+		result[code] = map[string]interface{}{
+			"name":       name,
+			"netValue":   netValue,
+			"dayGrowth":  dayGrowth,
+			"updateDate": updateDate,
+		}
+	}
+
+	return result, errors.Join(errs...)
+}
+
+// isBatchNumber 判断批量行情字段是否为数字（空值和 "---" 表示暂无数据）
+func isBatchNumber(value string) bool {
+	if value == "" || value == "---" {
+		return true
+	}
+	_, err := strconv.ParseFloat(value, 64)
+	return err == nil
+}
@@ -0,0 +1,208 @@
+package service
+
+import (
+	"fmt"
+	"fund/model"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// FailoverProvider 多数据源故障转移
+// 按顺序尝试各数据源，前一个失败时自动切换到下一个；
+// 开启交叉验证时，实时估值会再向备用的单基金估值数据源取一次并比对估算涨跌幅
+type FailoverProvider struct {
+	providers  []Provider // 数据源列表（按优先级排序）
+	tolerance  float64    // 估算涨跌幅允许偏差（百分点）
+	validators []Provider // 交叉验证使用的备用数据源
+}
+
+// NewFailoverProvider 创建故障转移数据源
+// tolerance 为交叉验证的容差（百分点），小于等于0时不做交叉验证。
+// 批量行情数据源不参与交叉验证：其涨跌幅是最近公布的净值涨跌幅而非盘中估算，
+// 且每次查询都可能触发全市场分页拉取
+func NewFailoverProvider(tolerance float64, providers ...Provider) *FailoverProvider {
+	p := &FailoverProvider{
+		providers: providers,
+		tolerance: tolerance,
+	}
+	if tolerance > 0 && len(providers) > 1 {
+		for _, provider := range providers[1:] {
+			if _, isBatch := provider.(*BatchEstimateProvider); !isBatch {
+				p.validators = append(p.validators, provider)
+			}
+		}
+	}
+	return p
+}
+
+// CrossValidating 是否对实时估值做交叉验证
+func (p *FailoverProvider) CrossValidating() bool {
+	return len(p.validators) > 0
+}
+
+// Name 数据源名称
+func (p *FailoverProvider) Name() string {
+	names := make([]string, len(p.providers))
+	for i, provider := range p.providers {
+		names[i] = provider.Name()
+	}
+	return strings.Join(names, ",")
+}
+
+// FetchFundList 获取全量基金列表
+func (p *FailoverProvider) FetchFundList() ([]model.FundBasicInfo, error) {
+	var errs []string
+	for _, provider := range p.providers {
+		fundList, err := provider.FetchFundList()
+		if err == nil {
+			return fundList, nil
+		}
+		errs = append(errs, fmt.Sprintf("%s: %v", provider.Name(), err))
+	}
+	return nil, p.joinErrors(errs)
+}
+
+// FetchFundDetail 获取基金详情数据
+func (p *FailoverProvider) FetchFundDetail(fundCode string) (map[string]string, error) {
+	var errs []string
+	for _, provider := range p.providers {
+		detail, err := provider.FetchFundDetail(fundCode)
+		if detail != nil {
+			// 部分字段解析失败时不再切换数据源
+			return detail, err
+		}
+		errs = append(errs, fmt.Sprintf("%s: %v", provider.Name(), err))
+	}
+	return nil, p.joinErrors(errs)
+}
+
+// FetchFundProfile 获取基金档案
+func (p *FailoverProvider) FetchFundProfile(fundCode string) (*model.FundProfile, error) {
+	var errs []string
+	for _, provider := range p.providers {
+		profile, err := provider.FetchFundProfile(fundCode)
+		if profile != nil {
+			// 部分字段解析失败时不再切换数据源
+			return profile, err
+		}
+		errs = append(errs, fmt.Sprintf("%s: %v", provider.Name(), err))
+	}
+	return nil, p.joinErrors(errs)
+}
+
+// FetchNAVHistory 获取基金历史净值走势
+func (p *FailoverProvider) FetchNAVHistory(fundCode string) (*model.FundTrend, error) {
+	var errs []string
+	for _, provider := range p.providers {
+		history, err := provider.FetchNAVHistory(fundCode)
+		if err == nil {
+			return history, nil
+		}
+		errs = append(errs, fmt.Sprintf("%s: %v", provider.Name(), err))
+	}
+	return nil, p.joinErrors(errs)
+}
+
+// FetchNAVSince 获取增量历史净值
+func (p *FailoverProvider) FetchNAVSince(fundCode, since string) ([]model.TrendPoint, error) {
+	var errs []string
+	for _, provider := range p.providers {
+		points, err := provider.FetchNAVSince(fundCode, since)
+		if err == nil {
+			return points, nil
+		}
+		errs = append(errs, fmt.Sprintf("%s: %v", provider.Name(), err))
+	}
+	return nil, p.joinErrors(errs)
+}
+
+// FetchReturnSeries 获取累计收益率曲线，主数据源失败时切换备用数据源
+func (p *FailoverProvider) FetchReturnSeries(fundCode string) ([]model.ReturnSeries, error) {
+	var errs []string
+	for _, provider := range p.providers {
+		series, err := provider.FetchReturnSeries(fundCode)
+		if err == nil {
+			return series, nil
+		}
+		errs = append(errs, fmt.Sprintf("%s: %v", provider.Name(), err))
+	}
+	return nil, p.joinErrors(errs)
+}
+
+// FetchBatchQuotes 批量获取基金行情，每条记录标注数据来源
+func (p *FailoverProvider) FetchBatchQuotes(page, pageSize int) (map[string]map[string]interface{}, error) {
+	var errs []string
+	for _, provider := range p.providers {
+		quotes, err := provider.FetchBatchQuotes(page, pageSize)
+		if quotes != nil {
+			for _, data := range quotes {
+				data["source"] = provider.Name()
+			}
+			// 部分记录解析失败时不再切换数据源
+			return quotes, err
+		}
+		errs = append(errs, fmt.Sprintf("%s: %v", provider.Name(), err))
+	}
+	return nil, p.joinErrors(errs)
+}
+
+// FetchRealtimeEstimate 获取实时估值，主数据源失败时切换备用数据源
+func (p *FailoverProvider) FetchRealtimeEstimate(fundCode string) (*model.RealtimeData, error) {
+	var errs []string
+	for i, provider := range p.providers {
+		realtimeData, err := provider.FetchRealtimeEstimate(fundCode)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", provider.Name(), err))
+			continue
+		}
+		if realtimeData.Source == "" {
+			realtimeData.Source = provider.Name()
+		}
+
+		if i > 0 {
+			log.Printf("🔀 基金 %s 实时估值已切换到备用数据源 %s", fundCode, realtimeData.Source)
+		} else if p.CrossValidating() {
+			p.validate(fundCode, realtimeData)
+		}
+
+		return realtimeData, nil
+	}
+	return nil, p.joinErrors(errs)
+}
+
+// validate 使用备用的单基金估值数据源交叉验证估算涨跌幅
+func (p *FailoverProvider) validate(fundCode string, primary *model.RealtimeData) {
+	primaryRate, err := strconv.ParseFloat(primary.GsZzl, 64)
+	if err != nil {
+		return
+	}
+
+	for _, provider := range p.validators {
+		other, err := provider.FetchRealtimeEstimate(fundCode)
+		if err != nil {
+			continue
+		}
+		otherRate, err := strconv.ParseFloat(other.GsZzl, 64)
+		if err != nil {
+			continue
+		}
+
+		primary.Deviation = math.Round((primaryRate-otherRate)*10000) / 10000
+		if math.Abs(primary.Deviation) > p.tolerance {
+			primary.Divergent = true
+			log.Printf("⚠️  基金 %s 估值偏差超出容差: %s %.2f%% vs %s %.2f%%",
+				fundCode, primary.Source, primaryRate, provider.Name(), otherRate)
+		}
+		return
+	}
+}
+
+// joinErrors 合并各数据源的错误信息
+func (p *FailoverProvider) joinErrors(errs []string) error {
+	if len(errs) == 0 {
+		return fmt.Errorf("未配置数据源")
+	}
+	return fmt.Errorf("所有数据源均失败: %s", strings.Join(errs, "; "))
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"fmt"
+	"fund/internal/fakeupstream"
+	"fund/model"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// stubProvider 测试用数据源，按基金代码返回固定的估算涨跌幅并记录调用次数
+type stubProvider struct {
+	Provider
+	name       string
+	rates      map[string]string
+	estimates  int // 实时估值调用次数
+	batchPages int // 批量行情调用次数
+}
+
+// Name 数据源名称
+func (p *stubProvider) Name() string {
+	return p.name
+}
+
+// FetchRealtimeEstimate 返回固定的估算涨跌幅
+func (p *stubProvider) FetchRealtimeEstimate(fundCode string) (*model.RealtimeData, error) {
+	p.estimates++
+	rate, ok := p.rates[fundCode]
+	if !ok {
+		return nil, fmt.Errorf("基金 %s 暂无估值", fundCode)
+	}
+	return &model.RealtimeData{FundCode: fundCode, GsZzl: rate}, nil
+}
+
+// FetchBatchQuotes 返回固定的最近净值涨跌幅
+func (p *stubProvider) FetchBatchQuotes(page, pageSize int) (map[string]map[string]interface{}, error) {
+	p.batchPages++
+	quotes := make(map[string]map[string]interface{})
+	for code, rate := range p.rates {
+		quotes[code] = map[string]interface{}{"name": code, "netValue": "1.0000", "dayGrowth": rate, "updateDate": "2025-06-30"}
+	}
+	return quotes, nil
+}
+
+// TestFailoverProvider 测试按顺序故障转移和可选的交叉验证
+func TestFailoverProvider(t *testing.T) {
+	primary := &stubProvider{name: "primary", rates: map[string]string{"000001": "0.50", "110022": "1.20"}}
+	backup := &stubProvider{name: "backup", rates: map[string]string{"000001": "0.30", "110022": "-0.40", "161725": "0.10"}}
+
+	// 主数据源失败时切换到备用数据源
+	provider := NewFailoverProvider(0, primary, backup)
+	data, err := provider.FetchRealtimeEstimate("161725")
+	if err != nil || data.Source != "backup" {
+		t.Fatalf("❌ 应切换到备用数据源: %+v %v", data, err)
+	}
+	if _, err := provider.FetchRealtimeEstimate("999999"); err == nil || !strings.Contains(err.Error(), "primary") || !strings.Contains(err.Error(), "backup") {
+		t.Errorf("❌ 所有数据源失败时应返回各数据源的错误: %v", err)
+	}
+
+	// 容差为 0 时不交叉验证，主数据源成功后不请求备用数据源
+	backup.estimates = 0
+	if data, err := provider.FetchRealtimeEstimate("110022"); err != nil || data.Source != "primary" || data.Divergent || backup.estimates != 0 {
+		t.Errorf("❌ 未开启交叉验证时不应请求备用数据源: %+v %v, 备用调用 %d 次", data, err, backup.estimates)
+	}
+
+	// 开启交叉验证后比对估算涨跌幅
+	provider = NewFailoverProvider(0.5, primary, backup)
+	if !provider.CrossValidating() {
+		t.Fatal("❌ 有单基金估值备用数据源时应开启交叉验证")
+	}
+	if data, _ := provider.FetchRealtimeEstimate("000001"); data.Divergent || data.Deviation != 0.2 {
+		t.Errorf("❌ 000001 偏差应在容差内: %+v", data)
+	}
+	if data, _ := provider.FetchRealtimeEstimate("110022"); !data.Divergent || data.Deviation != 1.6 {
+		t.Errorf("❌ 110022 应标记为偏差超出容差: %+v", data)
+	}
+
+	// 批量行情数据源只用于故障转移，不参与交叉验证
+	batchBase := &stubProvider{name: "batch", rates: map[string]string{"000001": "-3.00"}}
+	provider = NewFailoverProvider(0.5, primary, NewBatchEstimateProvider(batchBase, time.Minute))
+	if provider.CrossValidating() {
+		t.Error("❌ 批量行情数据源不应用于交叉验证")
+	}
+	if data, _ := provider.FetchRealtimeEstimate("000001"); data.Divergent || batchBase.batchPages != 0 {
+		t.Errorf("❌ 主数据源成功时不应拉取批量行情: %+v, 拉取 %d 页", data, batchBase.batchPages)
+	}
+}
+
+// TestRealtimeFailover 测试主数据源被限流时日内采集切换到批量行情
+func TestRealtimeFailover(t *testing.T) {
+	upstream, primary := newFakeUpstream(t)
+	backup := NewBatchEstimateProvider(NewEastmoneyProviderWithBaseURL(upstream.URL), time.Minute)
+	provider := NewFailoverProvider(0.5, primary, backup)
+
+	// 主数据源正常时不拉取批量行情
+	data, err := provider.FetchRealtimeEstimate("000001")
+	if err != nil {
+		t.Fatalf("❌ 获取实时估值失败: %v", err)
+	}
+	if data.Source != "eastmoney" || data.Divergent || upstream.Hits(fakeupstream.RouteBatch) != 0 {
+		t.Errorf("❌ 000001 应来自主数据源且不请求批量行情: %+v", data)
+	}
+
+	upstream.SetFault(fakeupstream.RouteEstimate, fakeupstream.FaultServerError)
+	intradayService := newTestIntradayService(t, provider)
+	if err := os.WriteFile(intradayService.configFile, []byte(`{"watch_list":["000001"],"fetch_interval":1}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := intradayService.LoadWatchConfig(); err != nil {
+		t.Fatal(err)
+	}
+	intradayService.fetchWatchListRealtime()
+
+	intraday, err := intradayService.GetIntradayData("000001")
+	if err != nil {
+		t.Fatalf("❌ 获取日内数据失败: %v", err)
+	}
+	point := intraday.Data[len(intraday.Data)-1]
+	if point.Source != "eastmoney-batch" || point.Value != 1.1162 || point.Rate != 0.45 {
+		t.Errorf("❌ 数据点应来自备用数据源: %+v", point)
+	}
+}
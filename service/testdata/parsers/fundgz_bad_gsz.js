@@ -0,0 +1 @@
+jsonpgz({"fundcode":"000009","name":"测试漂移基金","jzrq":"2025-06-30","dwjz":"1.0150","gsz":"","gszzl":"0.12","gztime":"2025-07-01 10:30"});
\ No newline at end of file
@@ -0,0 +1 @@
+jsonpgz();
\ No newline at end of file
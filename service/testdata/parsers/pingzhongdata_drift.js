@@ -0,0 +1 @@
+/*基金或股票信息*/var fS_name = "测试漂移基金";var fS_code = "000009";/*收益率*//*近6月收益率*/var syl_6y="3.21";/*近三月收益率*/var syl_3y="--";/*近一月收益率*/var syl_1y="0.85";/*单位净值走势*/var Data_netWorthTrend = [{"x":1719763200000,"y":1.0123,"equityReturn":0,"unitMoney":""},{"x":1719849600000,"y":"1.0150","equityReturn":0.27,"unitMoney":""}];/*累计收益率走势*/var Data_grandTotal = [[1719763200000,0],[1719849600000,0.27]];
\ No newline at end of file
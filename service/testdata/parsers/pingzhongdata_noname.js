@@ -0,0 +1 @@
+/*基金或股票信息*/var fS_code = "000009";var Data_netWorthTrend = [];
\ No newline at end of file
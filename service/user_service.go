@@ -0,0 +1,262 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"fund/model"
+	"fund/storage"
+	"log"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	// ErrInvalidUser 用户名、密码或令牌名称不符合要求
+	ErrInvalidUser = errors.New("用户参数无效")
+	// ErrUserExists 用户名已存在
+	ErrUserExists = storage.ErrUserExists
+	// ErrInvalidCredentials 用户名或密码错误
+	ErrInvalidCredentials = errors.New("用户名或密码错误")
+	// ErrRegistrationClosed 未开放注册
+	ErrRegistrationClosed = errors.New("未开放注册,请联系管理员")
+	// ErrUnauthorized 令牌无效或已过期
+	ErrUnauthorized = errors.New("令牌无效或已过期")
+	// ErrTokenNotFound 令牌不存在
+	ErrTokenNotFound = errors.New("令牌不存在")
+)
+
+const (
+	tokenPrefix        = "fund_"             // 令牌前缀，便于在日志和配置中识别
+	defaultSessionTTL  = 30 * 24 * time.Hour // 登录令牌默认有效期
+	tokenTouchInterval = time.Minute         // 最近使用时间的最小更新间隔
+	minPasswordLength  = 8
+	maxPasswordLength  = 72 // bcrypt 只使用前 72 字节
+	maxTokenNameLength = 64
+)
+
+// usernamePattern 用户名: 3-32 位字母、数字、下划线、点或短横线
+var usernamePattern = regexp.MustCompile(`^[A-Za-z0-9_.-]{3,32}$`)
+
+// UserService 用户服务：注册、登录和访问令牌管理
+// 密码使用 bcrypt 哈希保存，令牌只保存 SHA-256 哈希
+type UserService struct {
+	store            storage.UserStore // 用户存储
+	mutex            sync.Mutex        // 注册锁
+	now              func() time.Time  // 时钟（可替换，便于测试）
+	sessionTTL       time.Duration     // 登录令牌有效期
+	registrationOpen bool              // 是否开放注册
+	bcryptCost       int               // bcrypt 计算强度
+	dummyOnce        sync.Once         // 延迟生成 dummyHash
+	dummyHash        []byte            // 用户不存在时用于比较的哈希
+}
+
+// NewUserService 创建用户服务
+func NewUserService(store storage.UserStore) *UserService {
+	return &UserService{
+		store:            store,
+		now:              time.Now,
+		sessionTTL:       defaultSessionTTL,
+		registrationOpen: true,
+		bcryptCost:       bcrypt.DefaultCost,
+	}
+}
+
+// SetClock 设置时钟，用于测试
+func (s *UserService) SetClock(now func() time.Time) {
+	s.now = now
+}
+
+// SetSessionTTL 设置登录令牌有效期
+func (s *UserService) SetSessionTTL(ttl time.Duration) {
+	s.sessionTTL = ttl
+}
+
+// SetRegistrationOpen 设置是否开放注册（关闭时由管理员线下分发账号）
+func (s *UserService) SetRegistrationOpen(open bool) {
+	s.registrationOpen = open
+}
+
+// Register 注册普通用户，管理员只能通过 BootstrapAdmin 创建
+func (s *UserService) Register(username, password string) (*model.User, error) {
+	if !s.registrationOpen {
+		return nil, ErrRegistrationClosed
+	}
+	return s.createUser(username, password, false)
+}
+
+// BootstrapAdmin 服务启动时按配置创建管理员
+// 用户不存在时以给定密码创建为管理员；已存在且是管理员时不做修改（不重置密码）；
+// 已存在但不是管理员时返回错误，避免他人抢先注册同名账号后获得管理员权限
+func (s *UserService) BootstrapAdmin(username, password string) (*model.User, error) {
+	existing, err := s.store.GetUserByName(strings.TrimSpace(username))
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		if !existing.Admin {
+			return nil, fmt.Errorf("%w: %s 不是管理员, 请更换管理员用户名", ErrUserExists, existing.Username)
+		}
+		return existing, nil
+	}
+	return s.createUser(username, password, true)
+}
+
+// createUser 校验用户名和密码并创建用户
+func (s *UserService) createUser(username, password string, admin bool) (*model.User, error) {
+	username = strings.TrimSpace(username)
+	if !usernamePattern.MatchString(username) {
+		return nil, fmt.Errorf("%w: 用户名应为 3-32 位字母、数字、下划线、点或短横线", ErrInvalidUser)
+	}
+	if len(password) < minPasswordLength || len(password) > maxPasswordLength {
+		return nil, fmt.Errorf("%w: 密码长度应为 %d-%d 位", ErrInvalidUser, minPasswordLength, maxPasswordLength)
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), s.bcryptCost)
+	if err != nil {
+		return nil, fmt.Errorf("生成密码哈希失败: %v", err)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	user, err := s.store.CreateUser(model.User{
+		Username:     username,
+		PasswordHash: string(hash),
+		Admin:        admin,
+		CreatedAt:    s.now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("👤 注册用户: %s (ID %d, 管理员 %v)", user.Username, user.ID, user.Admin)
+	return &user, nil
+}
+
+// Login 校验用户名和密码，签发登录令牌，同时清理该用户已过期的登录令牌
+// 返回的令牌明文只在此时可见。没有登录失败次数限制，暴露到公网时应在反向代理上对 /api/auth/login 限流
+func (s *UserService) Login(username, password string) (*model.User, string, *model.APIToken, error) {
+	user, err := s.store.GetUserByName(strings.TrimSpace(username))
+	if err != nil {
+		return nil, "", nil, err
+	}
+	if user == nil {
+		// 用户不存在时同样比较一次哈希，避免通过响应时间探测用户名
+		bcrypt.CompareHashAndPassword(s.dummyPasswordHash(), []byte(password))
+		return nil, "", nil, ErrInvalidCredentials
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		return nil, "", nil, ErrInvalidCredentials
+	}
+
+	if deleted, err := s.store.DeleteExpiredTokens(user.ID, s.now()); err != nil {
+		log.Printf("⚠️  清理过期令牌失败: %v", err)
+	} else if deleted > 0 {
+		log.Printf("🧹 清理用户 %s 的过期令牌: %d 个", user.Username, deleted)
+	}
+
+	expiresAt := s.now().Add(s.sessionTTL)
+	plain, token, err := s.issueToken(user.ID, "login", &expiresAt)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	return user, plain, token, nil
+}
+
+// CreateToken 为用户创建长期有效的 API 令牌（用于脚本、CI 等）
+func (s *UserService) CreateToken(userID int64, name string) (string, *model.APIToken, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > maxTokenNameLength {
+		return "", nil, fmt.Errorf("%w: 令牌名称长度应为 1-%d", ErrInvalidUser, maxTokenNameLength)
+	}
+	return s.issueToken(userID, name, nil)
+}
+
+// ListTokens 获取用户的全部令牌（不含明文）
+func (s *UserService) ListTokens(userID int64) ([]model.APIToken, error) {
+	return s.store.ListTokens(userID)
+}
+
+// RevokeToken 吊销用户的令牌
+func (s *UserService) RevokeToken(userID, tokenID int64) error {
+	deleted, err := s.store.DeleteToken(userID, tokenID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrTokenNotFound
+	}
+	return nil
+}
+
+// Authenticate 校验令牌，返回令牌所属用户
+func (s *UserService) Authenticate(plain string) (*model.User, error) {
+	if !strings.HasPrefix(plain, tokenPrefix) {
+		return nil, ErrUnauthorized
+	}
+	token, err := s.store.GetTokenByHash(hashToken(plain))
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	if token == nil || (token.ExpiresAt != nil && now.After(*token.ExpiresAt)) {
+		return nil, ErrUnauthorized
+	}
+	user, err := s.store.GetUser(token.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUnauthorized
+	}
+
+	// 降低写入频率：最近使用时间只在间隔超过 tokenTouchInterval 时更新
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) >= tokenTouchInterval {
+		if err := s.store.TouchToken(token.ID, now); err != nil {
+			log.Printf("⚠️  更新令牌使用时间失败: %v", err)
+		}
+	}
+	return user, nil
+}
+
+// issueToken 生成并保存令牌，返回明文和令牌信息
+func (s *UserService) issueToken(userID int64, name string, expiresAt *time.Time) (string, *model.APIToken, error) {
+	random := make([]byte, 32)
+	if _, err := rand.Read(random); err != nil {
+		return "", nil, fmt.Errorf("生成令牌失败: %v", err)
+	}
+	plain := tokenPrefix + hex.EncodeToString(random)
+
+	token, err := s.store.CreateToken(model.APIToken{
+		UserID:    userID,
+		Name:      name,
+		Prefix:    plain[:len(tokenPrefix)+8],
+		TokenHash: hashToken(plain),
+		CreatedAt: s.now(),
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	return plain, &token, nil
+}
+
+// dummyPasswordHash 用户不存在时用于比较的密码哈希
+func (s *UserService) dummyPasswordHash() []byte {
+	s.dummyOnce.Do(func() {
+		s.dummyHash, _ = bcrypt.GenerateFromPassword([]byte("fund-dummy-password"), s.bcryptCost)
+	})
+	return s.dummyHash
+}
+
+// hashToken 计算令牌的 SHA-256 哈希
+func hashToken(plain string) string {
+	sum := sha256.Sum256([]byte(plain))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"errors"
+	"fund/storage"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// TestUserService 测试注册、登录、令牌校验、过期和吊销
+func TestUserService(t *testing.T) {
+	now := time.Date(2025, 7, 1, 10, 30, 0, 0, chinaZone)
+	userService := NewUserService(storage.NewFileUserStore(t.TempDir()))
+	userService.bcryptCost = bcrypt.MinCost
+	userService.SetClock(func() time.Time { return now })
+	userService.SetSessionTTL(time.Hour)
+
+	admin, err := userService.BootstrapAdmin(" alice ", "correct horse")
+	if err != nil || !admin.Admin || admin.Username != "alice" {
+		t.Fatalf("❌ 创建管理员失败: %+v %v", admin, err)
+	}
+	if again, err := userService.BootstrapAdmin("alice", "other password"); err != nil || again.ID != admin.ID {
+		t.Errorf("❌ 管理员已存在时应直接返回: %+v %v", again, err)
+	}
+	if strings.Contains(admin.PasswordHash, "correct horse") {
+		t.Error("❌ 不应保存明文密码")
+	}
+	for _, c := range [][2]string{{"al", "correct horse"}, {"bob!", "correct horse"}, {"bob", "short"}} {
+		if _, err := userService.Register(c[0], c[1]); !errors.Is(err, ErrInvalidUser) {
+			t.Errorf("❌ %v 应返回 ErrInvalidUser: %v", c, err)
+		}
+	}
+	if _, err := userService.Register("ALICE", "another password"); !errors.Is(err, ErrUserExists) {
+		t.Errorf("❌ 重复用户名应返回 ErrUserExists: %v", err)
+	}
+
+	if _, _, _, err := userService.Login("alice", "wrong password"); !errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("❌ 密码错误应返回 ErrInvalidCredentials: %v", err)
+	}
+	if _, _, _, err := userService.Login("nobody", "correct horse"); !errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("❌ 用户不存在应返回 ErrInvalidCredentials: %v", err)
+	}
+	_, session, sessionToken, err := userService.Login("alice", "correct horse")
+	if err != nil || !strings.HasPrefix(session, tokenPrefix) || sessionToken.ExpiresAt == nil {
+		t.Fatalf("❌ 登录失败: %q %+v %v", session, sessionToken, err)
+	}
+	if user, err := userService.Authenticate(session); err != nil || user.ID != admin.ID {
+		t.Errorf("❌ 登录令牌校验失败: %+v %v", user, err)
+	}
+	for _, token := range []string{"", "fund_nonexistent", session + "x", strings.TrimPrefix(session, tokenPrefix)} {
+		if _, err := userService.Authenticate(token); !errors.Is(err, ErrUnauthorized) {
+			t.Errorf("❌ 令牌 %q 应返回 ErrUnauthorized: %v", token, err)
+		}
+	}
+
+	plain, apiToken, err := userService.CreateToken(admin.ID, "ci")
+	if err != nil || apiToken.ExpiresAt != nil || !strings.HasPrefix(plain, apiToken.Prefix) {
+		t.Fatalf("❌ 创建 API 令牌失败: %q %+v %v", plain, apiToken, err)
+	}
+	if _, _, err := userService.CreateToken(admin.ID, " "); !errors.Is(err, ErrInvalidUser) {
+		t.Errorf("❌ 空令牌名称应返回 ErrInvalidUser: %v", err)
+	}
+
+	// 登录令牌过期，API 令牌长期有效
+	now = now.Add(2 * time.Hour)
+	if _, err := userService.Authenticate(session); !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("❌ 过期的登录令牌应返回 ErrUnauthorized: %v", err)
+	}
+	if _, err := userService.Authenticate(plain); err != nil {
+		t.Errorf("❌ API 令牌应长期有效: %v", err)
+	}
+	tokens, err := userService.ListTokens(admin.ID)
+	if err != nil || len(tokens) != 2 || tokens[1].LastUsedAt == nil {
+		t.Fatalf("❌ 令牌列表异常: %+v %v", tokens, err)
+	}
+
+	// 再次登录时清理已过期的登录令牌
+	if _, _, _, err := userService.Login("alice", "correct horse"); err != nil {
+		t.Fatal(err)
+	}
+	if tokens, _ := userService.ListTokens(admin.ID); len(tokens) != 2 || tokens[0].ID != apiToken.ID {
+		t.Errorf("❌ 登录后应清理过期的登录令牌: %+v", tokens)
+	}
+
+	bob, err := userService.Register("bob", "password-bob")
+	if err != nil || bob.Admin {
+		t.Fatalf("❌ 注册的用户不应是管理员: %+v %v", bob, err)
+	}
+	if _, err := userService.BootstrapAdmin("bob", "password-bob"); !errors.Is(err, ErrUserExists) {
+		t.Errorf("❌ 已注册的普通用户不应被设为管理员: %v", err)
+	}
+	if err := userService.RevokeToken(bob.ID, apiToken.ID); !errors.Is(err, ErrTokenNotFound) {
+		t.Errorf("❌ 不能吊销其他用户的令牌: %v", err)
+	}
+	if err := userService.RevokeToken(admin.ID, apiToken.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := userService.Authenticate(plain); !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("❌ 吊销后的令牌应返回 ErrUnauthorized: %v", err)
+	}
+
+	userService.SetRegistrationOpen(false)
+	if _, err := userService.Register("carol", "password-carol"); !errors.Is(err, ErrRegistrationClosed) {
+		t.Errorf("❌ 关闭注册后应返回 ErrRegistrationClosed: %v", err)
+	}
+
+	// 新部署中第一个注册的用户也不是管理员
+	fresh := NewUserService(storage.NewFileUserStore(t.TempDir()))
+	fresh.bcryptCost = bcrypt.MinCost
+	if first, err := fresh.Register("mallory", "password-mallory"); err != nil || first.Admin {
+		t.Errorf("❌ 第一个注册的用户不应成为管理员: %+v %v", first, err)
+	}
+}
@@ -0,0 +1,281 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// ErrInvalidWatchConfig 监控配置参数无效
+var ErrInvalidWatchConfig = errors.New("监控配置参数无效")
+
+const (
+	maxFetchInterval  = 3600                   // 监控采集周期上限（秒）
+	maxWatchCodes     = 500                    // 监控列表基金数量上限
+	configReloadDelay = 200 * time.Millisecond // 配置文件变更后延迟加载，合并编辑器保存时的多次写入
+)
+
+// GetWatchConfig 获取当前监控配置（副本），未配置时监控列表为空、采集周期为默认值
+func (s *IntradayService) GetWatchConfig() WatchConfig {
+	s.configMutex.RLock()
+	defer s.configMutex.RUnlock()
+
+	config := WatchConfig{WatchList: []string{}, FetchInterval: defaultFetchInterval}
+	if s.watchConfig != nil {
+		config.WatchList = append(config.WatchList, s.watchConfig.WatchList...)
+		if s.watchConfig.FetchInterval > 0 {
+			config.FetchInterval = s.watchConfig.FetchInterval
+		}
+	}
+	return config
+}
+
+// UpdateWatchConfig 修改监控配置并写回配置文件
+// patch.WatchList 为 nil 时保留当前监控列表（空列表表示切换为全量模式），patch.FetchInterval 为 0 时保留当前采集周期
+func (s *IntradayService) UpdateWatchConfig(patch WatchConfig) (WatchConfig, error) {
+	return s.modifyWatchConfig(func(config *WatchConfig) error {
+		if patch.WatchList != nil {
+			config.WatchList = patch.WatchList
+		}
+		if patch.FetchInterval != 0 {
+			config.FetchInterval = patch.FetchInterval
+		}
+		return nil
+	})
+}
+
+// AddWatchCodes 向监控列表追加基金（已存在的忽略）并写回配置文件
+func (s *IntradayService) AddWatchCodes(codes []string) (WatchConfig, error) {
+	if len(codes) == 0 {
+		return WatchConfig{}, fmt.Errorf("%w: 请提供基金代码", ErrInvalidWatchConfig)
+	}
+	return s.modifyWatchConfig(func(config *WatchConfig) error {
+		config.WatchList = append(config.WatchList, codes...)
+		return nil
+	})
+}
+
+// RemoveWatchCodes 从监控列表移除基金并写回配置文件，列表为空后切换为全量模式
+func (s *IntradayService) RemoveWatchCodes(codes []string) (WatchConfig, error) {
+	if len(codes) == 0 {
+		return WatchConfig{}, fmt.Errorf("%w: 请提供基金代码", ErrInvalidWatchConfig)
+	}
+	return s.modifyWatchConfig(func(config *WatchConfig) error {
+		for _, code := range codes {
+			config.WatchList = removeCode(config.WatchList, code)
+		}
+		return nil
+	})
+}
+
+// modifyWatchConfig 在当前配置的副本上执行修改，校验后写回配置文件并立即生效
+func (s *IntradayService) modifyWatchConfig(modify func(config *WatchConfig) error) (WatchConfig, error) {
+	s.configMutex.Lock()
+	defer s.configMutex.Unlock()
+
+	config := WatchConfig{FetchInterval: defaultFetchInterval}
+	if s.watchConfig != nil {
+		config.WatchList = append(config.WatchList, s.watchConfig.WatchList...)
+		config.FetchInterval = s.watchConfig.FetchInterval
+	}
+	if err := modify(&config); err != nil {
+		return WatchConfig{}, err
+	}
+	if err := validateWatchConfig(&config); err != nil {
+		return WatchConfig{}, err
+	}
+
+	data, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return WatchConfig{}, fmt.Errorf("序列化监控配置失败: %v", err)
+	}
+	data = append(data, '\n')
+	if err := writeFileAtomic(s.configFile, data); err != nil {
+		return WatchConfig{}, fmt.Errorf("保存监控配置失败: %v", err)
+	}
+
+	s.setWatchConfigLocked(&config, data)
+	log.Printf("📝 监控配置已更新: %d 只基金, 采集周期 %d 秒", len(config.WatchList), config.FetchInterval)
+	return config, nil
+}
+
+// validateWatchConfig 校验监控配置，基金代码去重（管理接口和配置文件加载使用相同的规则）
+func validateWatchConfig(config *WatchConfig) error {
+	codes, err := normalizeWatchCodes(config.WatchList)
+	if err != nil {
+		return err
+	}
+	config.WatchList = codes
+	if config.FetchInterval < 1 || config.FetchInterval > maxFetchInterval {
+		return fmt.Errorf("%w: 采集周期应为 1-%d 秒", ErrInvalidWatchConfig, maxFetchInterval)
+	}
+	return nil
+}
+
+// normalizeWatchCodes 校验基金代码并去重（保持原有顺序）
+func normalizeWatchCodes(codes []string) ([]string, error) {
+	result := make([]string, 0, len(codes))
+	seen := make(map[string]bool, len(codes))
+	for _, code := range codes {
+		if !fundCodePattern.MatchString(code) {
+			return nil, fmt.Errorf("%w: 基金代码 %q 格式错误,应为6位数字", ErrInvalidWatchConfig, code)
+		}
+		if !seen[code] {
+			seen[code] = true
+			result = append(result, code)
+		}
+	}
+	if len(result) > maxWatchCodes {
+		return nil, fmt.Errorf("%w: 监控列表最多 %d 只基金", ErrInvalidWatchConfig, maxWatchCodes)
+	}
+	return result, nil
+}
+
+// writeFileAtomic 先写临时文件再重命名，避免文件监听读到写了一半的配置
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// readWatchConfig 读取、解析并校验配置文件，文件不存在时返回 nil
+func (s *IntradayService) readWatchConfig() (*WatchConfig, []byte, error) {
+	data, err := os.ReadFile(s.configFile)
+	if os.IsNotExist(err) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("打开配置文件失败: %v", err)
+	}
+
+	var config WatchConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, nil, fmt.Errorf("解析配置文件失败: %v", err)
+	}
+	if config.FetchInterval <= 0 {
+		config.FetchInterval = defaultFetchInterval
+	}
+	if err := validateWatchConfig(&config); err != nil {
+		return nil, nil, fmt.Errorf("配置文件内容无效: %w", err)
+	}
+	return &config, data, nil
+}
+
+// applyWatchConfig 使用新的监控配置
+func (s *IntradayService) applyWatchConfig(config *WatchConfig, data []byte) {
+	s.configMutex.Lock()
+	defer s.configMutex.Unlock()
+	s.setWatchConfigLocked(config, data)
+}
+
+// setWatchConfigLocked 替换监控配置并通知采集任务，调用方需持有 configMutex 写锁
+func (s *IntradayService) setWatchConfigLocked(config *WatchConfig, data []byte) {
+	wasWatchMode := s.watchConfig != nil && len(s.watchConfig.WatchList) > 0
+	s.watchConfig = config
+	s.configData = data
+
+	if isWatchMode := len(config.WatchList) > 0; isWatchMode != wasWatchMode && s.isRunning {
+		if isWatchMode {
+			log.Printf("🔀 切换为监控模式: %d 只基金", len(config.WatchList))
+		} else {
+			log.Println("🔀 监控列表为空，切换为全量模式")
+		}
+	}
+
+	select {
+	case s.configChange <- struct{}{}:
+	default:
+	}
+}
+
+// watchMode 配置文件中的监控列表不为空时只采集监控基金，否则采集全量基金
+func (s *IntradayService) watchMode() bool {
+	s.configMutex.RLock()
+	defer s.configMutex.RUnlock()
+	return s.watchConfig != nil && len(s.watchConfig.WatchList) > 0
+}
+
+// reloadWatchConfig 配置文件变更后重新加载，内容无效时保留当前配置
+func (s *IntradayService) reloadWatchConfig() {
+	config, data, err := s.readWatchConfig()
+	if err != nil {
+		log.Printf("❌ 重新加载监控配置失败: %v, 保留当前配置", err)
+		return
+	}
+	if config == nil {
+		config = &WatchConfig{FetchInterval: defaultFetchInterval}
+	}
+
+	s.configMutex.Lock()
+	defer s.configMutex.Unlock()
+	// 管理接口自身写入的内容已经生效，无需重复加载
+	if bytes.Equal(data, s.configData) {
+		return
+	}
+	s.setWatchConfigLocked(config, data)
+	log.Printf("🔄 重新加载监控配置: %d 只基金, 采集周期 %d 秒", len(config.WatchList), config.FetchInterval)
+}
+
+// watchConfigFile 监听配置文件所在目录，配置文件被修改、替换或删除后重新加载，服务停止时退出
+// 监听目录而不是文件本身，编辑器“写临时文件再重命名”的保存方式也能感知
+func (s *IntradayService) watchConfigFile() error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("创建配置文件监听失败: %v", err)
+	}
+	if err := watcher.Add(filepath.Dir(s.configFile)); err != nil {
+		watcher.Close()
+		return fmt.Errorf("监听配置文件目录失败: %v", err)
+	}
+	target := filepath.Clean(s.configFile)
+
+	go func() {
+		defer watcher.Close()
+		var reload <-chan time.Time
+
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if filepath.Clean(event.Name) == target && !event.Has(fsnotify.Chmod) {
+					reload = time.After(configReloadDelay)
+				}
+
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				log.Printf("⚠️  监听配置文件出错: %v", err)
+
+			case <-reload:
+				reload = nil
+				s.reloadWatchConfig()
+
+			case <-s.stopChan:
+				return
+			}
+		}
+	}()
+
+	log.Printf("👀 正在监听配置文件: %s", s.configFile)
+	return nil
+}
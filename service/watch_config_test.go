@@ -0,0 +1,141 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestWatchConfigRuntime 测试运行时修改监控配置并写回配置文件
+func TestWatchConfigRuntime(t *testing.T) {
+	intradayService := NewIntradayServiceWithProvider(NewEastmoneyProvider())
+	configFile := filepath.Join(t.TempDir(), "watch_funds.json")
+	intradayService.SetConfigFile(configFile)
+
+	// 无配置文件时为全量模式
+	if config := intradayService.GetWatchConfig(); len(config.WatchList) != 0 || config.FetchInterval != defaultFetchInterval || intradayService.watchMode() {
+		t.Errorf("❌ 默认配置异常: %+v", config)
+	}
+
+	config, err := intradayService.AddWatchCodes([]string{"000001", "110022", "000001"})
+	if err != nil || !reflect.DeepEqual(config.WatchList, []string{"000001", "110022"}) || !intradayService.watchMode() {
+		t.Fatalf("❌ 添加监控基金异常: %+v %v", config, err)
+	}
+	if _, err := intradayService.AddWatchCodes([]string{"abc"}); !errors.Is(err, ErrInvalidWatchConfig) {
+		t.Errorf("❌ 非法基金代码应返回 ErrInvalidWatchConfig: %v", err)
+	}
+	for _, interval := range []int{-1, maxFetchInterval + 1} {
+		if _, err := intradayService.UpdateWatchConfig(WatchConfig{FetchInterval: interval}); !errors.Is(err, ErrInvalidWatchConfig) {
+			t.Errorf("❌ 采集周期 %d 应返回 ErrInvalidWatchConfig: %v", interval, err)
+		}
+	}
+
+	// 采集周期变化会通知采集任务
+	for len(intradayService.configChange) > 0 {
+		<-intradayService.configChange
+	}
+	if config, err := intradayService.UpdateWatchConfig(WatchConfig{FetchInterval: 10}); err != nil || config.FetchInterval != 10 || len(config.WatchList) != 2 {
+		t.Errorf("❌ 修改采集周期异常: %+v %v", config, err)
+	}
+	if len(intradayService.configChange) != 1 || intradayService.fetchInterval() != 10 {
+		t.Error("❌ 修改采集周期后未通知采集任务")
+	}
+
+	// 重新加载配置文件得到相同配置
+	reloaded := NewIntradayServiceWithProvider(NewEastmoneyProvider())
+	reloaded.SetConfigFile(configFile)
+	if err := reloaded.LoadWatchConfig(); err != nil {
+		t.Fatal(err)
+	}
+	if got := reloaded.GetWatchConfig(); !reflect.DeepEqual(got, intradayService.GetWatchConfig()) {
+		t.Errorf("❌ 配置文件内容与当前配置不一致: %+v", got)
+	}
+
+	// 移除全部基金后切换为全量模式
+	if config, err := intradayService.RemoveWatchCodes([]string{"000001", "110022"}); err != nil || len(config.WatchList) != 0 || intradayService.watchMode() {
+		t.Errorf("❌ 移除监控基金异常: %+v %v", config, err)
+	}
+}
+
+// TestWatchConfigHotReload 测试修改配置文件后自动重新加载
+func TestWatchConfigHotReload(t *testing.T) {
+	intradayService := NewIntradayServiceWithProvider(NewEastmoneyProvider())
+	configFile := filepath.Join(t.TempDir(), "watch_funds.json")
+	intradayService.SetConfigFile(configFile)
+	if err := os.WriteFile(configFile, []byte(`{"watch_list":["000001"],"fetch_interval":5}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := intradayService.LoadWatchConfig(); err != nil {
+		t.Fatal(err)
+	}
+	if err := intradayService.watchConfigFile(); err != nil {
+		t.Fatal(err)
+	}
+	defer close(intradayService.stopChan)
+
+	waitFor := func(check func(WatchConfig) bool) WatchConfig {
+		t.Helper()
+		deadline := time.Now().Add(5 * time.Second)
+		for {
+			config := intradayService.GetWatchConfig()
+			if check(config) || time.Now().After(deadline) {
+				return config
+			}
+			time.Sleep(20 * time.Millisecond)
+		}
+	}
+
+	if err := os.WriteFile(configFile, []byte(`{"watch_list":["000001","110022"],"fetch_interval":15}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if config := waitFor(func(c WatchConfig) bool { return c.FetchInterval == 15 }); len(config.WatchList) != 2 || config.FetchInterval != 15 {
+		t.Fatalf("❌ 修改配置文件后未重新加载: %+v", config)
+	}
+
+	// 内容无效时保留当前配置
+	if err := os.WriteFile(configFile, []byte(`{"watch_list":`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(4 * configReloadDelay)
+	if config := intradayService.GetWatchConfig(); len(config.WatchList) != 2 || config.FetchInterval != 15 {
+		t.Errorf("❌ 配置文件无效时应保留当前配置: %+v", config)
+	}
+
+	// 与管理接口相同的校验不通过时保留当前配置，管理接口仍可继续修改
+	for _, content := range []string{
+		`{"watch_list":["000001","abc"],"fetch_interval":15}`,
+		`{"watch_list":["000001"],"fetch_interval":100000}`,
+	} {
+		if err := os.WriteFile(configFile, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(4 * configReloadDelay)
+		if config := intradayService.GetWatchConfig(); len(config.WatchList) != 2 || config.FetchInterval != 15 {
+			t.Errorf("❌ 配置文件校验失败时应保留当前配置: %+v", config)
+		}
+	}
+	tooMany := make([]string, maxWatchCodes+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("%06d", i+1)
+	}
+	if err := os.WriteFile(configFile, []byte(fmt.Sprintf(`{"watch_list":["%s"]}`, strings.Join(tooMany, `","`))), 0644); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(4 * configReloadDelay)
+	if config, err := intradayService.AddWatchCodes([]string{"161725"}); err != nil || len(config.WatchList) != 3 {
+		t.Errorf("❌ 配置文件无效后管理接口应基于当前配置修改: %+v %v", config, err)
+	}
+
+	// 删除配置文件后切换为全量模式
+	if err := os.Remove(configFile); err != nil {
+		t.Fatal(err)
+	}
+	if config := waitFor(func(c WatchConfig) bool { return len(c.WatchList) == 0 }); len(config.WatchList) != 0 {
+		t.Errorf("❌ 删除配置文件后应切换为全量模式: %+v", config)
+	}
+}
@@ -0,0 +1,261 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"fund/model"
+	"fund/storage"
+	"log"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	// ErrWatchlistNotFound 自选分组不存在
+	ErrWatchlistNotFound = errors.New("自选分组不存在")
+	// ErrInvalidWatchlist 自选分组参数无效
+	ErrInvalidWatchlist = errors.New("自选分组参数无效")
+)
+
+const (
+	maxWatchlistsPerUser = 20  // 每个用户的分组数量上限
+	maxWatchlistCodes    = 200 // 每个分组的基金数量上限
+	maxWatchlistName     = 32  // 分组名称长度上限（字符）
+)
+
+// fundCodePattern 基金代码: 6 位数字
+var fundCodePattern = regexp.MustCompile(`^\d{6}$`)
+
+// WatchlistService 用户自选分组服务
+type WatchlistService struct {
+	store storage.WatchlistStore // 自选分组存储
+	mutex sync.Mutex             // 修改锁（读取-修改-保存需串行）
+	now   func() time.Time       // 时钟（可替换，便于测试）
+}
+
+// NewWatchlistService 创建自选分组服务
+func NewWatchlistService(store storage.WatchlistStore) *WatchlistService {
+	return &WatchlistService{
+		store: store,
+		now:   time.Now,
+	}
+}
+
+// SetClock 设置时钟，用于测试
+func (s *WatchlistService) SetClock(now func() time.Time) {
+	s.now = now
+}
+
+// List 获取用户的全部分组
+func (s *WatchlistService) List(userID int64) ([]model.Watchlist, error) {
+	return s.store.ListWatchlists(userID)
+}
+
+// Get 获取用户的分组
+func (s *WatchlistService) Get(userID, id int64) (*model.Watchlist, error) {
+	watchlist, err := s.store.GetWatchlist(userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if watchlist == nil {
+		return nil, ErrWatchlistNotFound
+	}
+	return watchlist, nil
+}
+
+// Create 新建分组，排在用户已有分组之后
+func (s *WatchlistService) Create(userID int64, name string, codes []string) (*model.Watchlist, error) {
+	name, codes, err := normalizeWatchlist(name, codes)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	existing, err := s.store.ListWatchlists(userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= maxWatchlistsPerUser {
+		return nil, fmt.Errorf("%w: 最多创建 %d 个分组", ErrInvalidWatchlist, maxWatchlistsPerUser)
+	}
+	position := 0
+	if len(existing) > 0 {
+		position = existing[len(existing)-1].Position + 1
+	}
+
+	now := s.now()
+	watchlist, err := s.store.SaveWatchlist(userID, model.Watchlist{
+		Name:      name,
+		Position:  position,
+		Codes:     codes,
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &watchlist, nil
+}
+
+// Update 修改分组名称和基金列表（基金列表的顺序即展示顺序）
+func (s *WatchlistService) Update(userID, id int64, name string, codes []string) (*model.Watchlist, error) {
+	name, codes, err := normalizeWatchlist(name, codes)
+	if err != nil {
+		return nil, err
+	}
+	return s.modify(userID, id, func(watchlist *model.Watchlist) error {
+		watchlist.Name, watchlist.Codes = name, codes
+		return nil
+	})
+}
+
+// AddCode 向分组添加基金，position 为插入位置（<0 或超出范围时追加到末尾），已存在时移动到该位置
+func (s *WatchlistService) AddCode(userID, id int64, code string, position int) (*model.Watchlist, error) {
+	if !fundCodePattern.MatchString(code) {
+		return nil, fmt.Errorf("%w: 基金代码格式错误,应为6位数字", ErrInvalidWatchlist)
+	}
+	return s.modify(userID, id, func(watchlist *model.Watchlist) error {
+		codes := removeCode(watchlist.Codes, code)
+		if len(codes) >= maxWatchlistCodes {
+			return fmt.Errorf("%w: 每个分组最多 %d 只基金", ErrInvalidWatchlist, maxWatchlistCodes)
+		}
+		if position < 0 || position > len(codes) {
+			position = len(codes)
+		}
+		codes = append(codes[:position], append([]string{code}, codes[position:]...)...)
+		watchlist.Codes = codes
+		return nil
+	})
+}
+
+// RemoveCode 从分组移除基金
+func (s *WatchlistService) RemoveCode(userID, id int64, code string) (*model.Watchlist, error) {
+	return s.modify(userID, id, func(watchlist *model.Watchlist) error {
+		watchlist.Codes = removeCode(watchlist.Codes, code)
+		return nil
+	})
+}
+
+// Delete 删除分组
+func (s *WatchlistService) Delete(userID, id int64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	deleted, err := s.store.DeleteWatchlist(userID, id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrWatchlistNotFound
+	}
+	return nil
+}
+
+// Reorder 调整分组顺序，ids 必须恰好包含用户的全部分组
+func (s *WatchlistService) Reorder(userID int64, ids []int64) ([]model.Watchlist, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	existing, err := s.store.ListWatchlists(userID)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int64]model.Watchlist, len(existing))
+	for _, watchlist := range existing {
+		byID[watchlist.ID] = watchlist
+	}
+	if len(ids) != len(existing) {
+		return nil, fmt.Errorf("%w: 排序需包含全部 %d 个分组", ErrInvalidWatchlist, len(existing))
+	}
+
+	now := s.now()
+	result := make([]model.Watchlist, 0, len(ids))
+	for position, id := range ids {
+		watchlist, exists := byID[id]
+		if !exists {
+			return nil, fmt.Errorf("%w: 分组 %d 不存在或重复", ErrInvalidWatchlist, id)
+		}
+		delete(byID, id)
+		if watchlist.Position != position {
+			watchlist.Position = position
+			watchlist.UpdatedAt = now
+			if watchlist, err = s.store.SaveWatchlist(userID, watchlist); err != nil {
+				return nil, err
+			}
+		}
+		result = append(result, watchlist)
+	}
+	return result, nil
+}
+
+// WatchCodes 所有用户自选基金的并集，作为日内采集的监控来源
+func (s *WatchlistService) WatchCodes() []string {
+	codes, err := s.store.AllWatchCodes()
+	if err != nil {
+		log.Printf("⚠️  读取用户自选基金失败: %v", err)
+		return nil
+	}
+	return codes
+}
+
+// modify 读取分组、修改并保存
+func (s *WatchlistService) modify(userID, id int64, fn func(watchlist *model.Watchlist) error) (*model.Watchlist, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	watchlist, err := s.store.GetWatchlist(userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if watchlist == nil {
+		return nil, ErrWatchlistNotFound
+	}
+	if err := fn(watchlist); err != nil {
+		return nil, err
+	}
+	watchlist.UpdatedAt = s.now()
+	saved, err := s.store.SaveWatchlist(userID, *watchlist)
+	if err != nil {
+		return nil, err
+	}
+	return &saved, nil
+}
+
+// normalizeWatchlist 校验分组名称和基金列表，基金去重并保持顺序
+func normalizeWatchlist(name string, codes []string) (string, []string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxWatchlistName {
+		return "", nil, fmt.Errorf("%w: 分组名称长度应为 1-%d 个字符", ErrInvalidWatchlist, maxWatchlistName)
+	}
+	result := make([]string, 0, len(codes))
+	seen := make(map[string]bool, len(codes))
+	for _, code := range codes {
+		if !fundCodePattern.MatchString(code) {
+			return "", nil, fmt.Errorf("%w: 基金代码 %q 格式错误,应为6位数字", ErrInvalidWatchlist, code)
+		}
+		if !seen[code] {
+			seen[code] = true
+			result = append(result, code)
+		}
+	}
+	if len(result) > maxWatchlistCodes {
+		return "", nil, fmt.Errorf("%w: 每个分组最多 %d 只基金", ErrInvalidWatchlist, maxWatchlistCodes)
+	}
+	return name, result, nil
+}
+
+// removeCode 返回移除指定基金后的新列表
+func removeCode(codes []string, code string) []string {
+	result := make([]string, 0, len(codes))
+	for _, c := range codes {
+		if c != code {
+			result = append(result, c)
+		}
+	}
+	return result
+}
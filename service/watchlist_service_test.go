@@ -0,0 +1,119 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"fund/storage"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestWatchlistService 测试自选分组的增删改、基金排序和分组排序
+func TestWatchlistService(t *testing.T) {
+	watchlistService := NewWatchlistService(storage.NewFileWatchlistStore(t.TempDir()))
+	watchlistService.SetClock(tradingClock)
+
+	tech, err := watchlistService.Create(1, " 科技 ", []string{"161725", "000001", "161725"})
+	if err != nil || tech.Name != "科技" || !reflect.DeepEqual(tech.Codes, []string{"161725", "000001"}) || tech.Position != 0 {
+		t.Fatalf("❌ 新建分组应去重并保持顺序: %+v %v", tech, err)
+	}
+	consumer, err := watchlistService.Create(1, "消费", nil)
+	if err != nil || consumer.Position != 1 || len(consumer.Codes) != 0 {
+		t.Fatalf("❌ 新分组应排在末尾: %+v %v", consumer, err)
+	}
+	for _, c := range []struct {
+		name  string
+		codes []string
+	}{{"", nil}, {strings.Repeat("长", maxWatchlistName+1), nil}, {"坏代码", []string{"abc"}}} {
+		if _, err := watchlistService.Create(1, c.name, c.codes); !errors.Is(err, ErrInvalidWatchlist) {
+			t.Errorf("❌ %+v 应返回 ErrInvalidWatchlist: %v", c, err)
+		}
+	}
+
+	// 添加到指定位置、已存在的基金移动位置
+	if got, err := watchlistService.AddCode(1, tech.ID, "110022", 0); err != nil || !reflect.DeepEqual(got.Codes, []string{"110022", "161725", "000001"}) {
+		t.Errorf("❌ 插入到开头异常: %+v %v", got, err)
+	}
+	if got, err := watchlistService.AddCode(1, tech.ID, "161725", -1); err != nil || !reflect.DeepEqual(got.Codes, []string{"110022", "000001", "161725"}) {
+		t.Errorf("❌ 已存在的基金应移动到末尾: %+v %v", got, err)
+	}
+	if got, err := watchlistService.RemoveCode(1, tech.ID, "000001"); err != nil || !reflect.DeepEqual(got.Codes, []string{"110022", "161725"}) {
+		t.Errorf("❌ 移除基金异常: %+v %v", got, err)
+	}
+	if _, err := watchlistService.AddCode(2, tech.ID, "000001", -1); !errors.Is(err, ErrWatchlistNotFound) {
+		t.Errorf("❌ 不能修改其他用户的分组: %v", err)
+	}
+
+	// 分组排序
+	if _, err := watchlistService.Reorder(1, []int64{consumer.ID}); !errors.Is(err, ErrInvalidWatchlist) {
+		t.Errorf("❌ 排序缺少分组应返回 ErrInvalidWatchlist: %v", err)
+	}
+	if _, err := watchlistService.Reorder(1, []int64{consumer.ID, consumer.ID}); !errors.Is(err, ErrInvalidWatchlist) {
+		t.Errorf("❌ 排序包含重复分组应返回 ErrInvalidWatchlist: %v", err)
+	}
+	if _, err := watchlistService.Reorder(1, []int64{consumer.ID, tech.ID}); err != nil {
+		t.Fatal(err)
+	}
+	watchlists, err := watchlistService.List(1)
+	if err != nil || len(watchlists) != 2 || watchlists[0].ID != consumer.ID || watchlists[1].ID != tech.ID {
+		t.Errorf("❌ 排序后分组顺序异常: %+v %v", watchlists, err)
+	}
+
+	if _, err := watchlistService.Create(2, "默认", []string{"000001", "110022"}); err != nil {
+		t.Fatal(err)
+	}
+	if codes := watchlistService.WatchCodes(); !reflect.DeepEqual(codes, []string{"000001", "110022", "161725"}) {
+		t.Errorf("❌ 所有用户自选的并集异常: %v", codes)
+	}
+
+	if err := watchlistService.Delete(1, tech.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := watchlistService.Get(1, tech.ID); !errors.Is(err, ErrWatchlistNotFound) {
+		t.Errorf("❌ 删除后应返回 ErrWatchlistNotFound: %v", err)
+	}
+}
+
+// TestIntradayWatchSource 测试采集列表合并配置文件与用户自选
+func TestIntradayWatchSource(t *testing.T) {
+	_, provider := newFakeUpstream(t)
+	intradayService := newTestIntradayService(t, provider)
+
+	userCodes := []string{"110022"}
+	intradayService.SetWatchSource(func() []string { return userCodes })
+	if err := intradayService.LoadAllFunds(); err != nil {
+		t.Fatal(err)
+	}
+	if got := intradayService.watchList(); !reflect.DeepEqual(got, []string{"110022"}) || intradayService.fetchInterval() != defaultFetchInterval {
+		t.Errorf("❌ 无配置文件时应只采集用户自选: %v %d", got, intradayService.fetchInterval())
+	}
+
+	intradayService.watchConfig = &WatchConfig{WatchList: []string{"000001", "110022"}, FetchInterval: 10}
+	userCodes = []string{"110022", "161725"}
+	if got := intradayService.watchList(); !reflect.DeepEqual(got, []string{"000001", "110022", "161725"}) || intradayService.fetchInterval() != 10 {
+		t.Errorf("❌ 采集列表应为配置与自选的并集: %v", got)
+	}
+
+	// 不在基金列表中的代码不采集，合并后超出上限的部分舍弃
+	userCodes = []string{"999999", "005827", "161725"}
+	if got := intradayService.watchList(); !reflect.DeepEqual(got, []string{"000001", "110022", "005827", "161725"}) {
+		t.Errorf("❌ 应忽略基金列表中不存在的代码: %v", got)
+	}
+	full := make([]string, maxWatchCodes-1)
+	for i := range full {
+		full[i] = fmt.Sprintf("%06d", 200000+i)
+	}
+	intradayService.watchConfig = &WatchConfig{WatchList: full}
+	if got := intradayService.watchList(); len(got) != maxWatchCodes || got[len(got)-1] != "005827" {
+		t.Errorf("❌ 采集列表应截断到 %d 只: %d %v", maxWatchCodes, len(got), got[len(got)-1])
+	}
+
+	// 用户自选的基金会被采集
+	userCodes = []string{"000001"}
+	intradayService.watchConfig = nil
+	intradayService.fetchWatchListRealtime()
+	if data, err := intradayService.GetIntradayData("000001"); err != nil || len(data.Data) != 1 {
+		t.Errorf("❌ 用户自选基金未被采集: %+v %v", data, err)
+	}
+}
@@ -0,0 +1,92 @@
+package storage
+
+import (
+	"fund/model"
+	"testing"
+	"time"
+)
+
+// TestAlertStore 测试文件和 SQLite 两种提醒存储
+func TestAlertStore(t *testing.T) {
+	forEachStore(t, func(dir string) AlertStore { return NewFileAlertStore(dir) }, func(t *testing.T, open func() AlertStore) {
+		testAlertStore(t, open())
+	})
+}
+
+// testAlertStore 提醒存储的通用测试
+func testAlertStore(t *testing.T, store AlertStore) {
+	now := time.Date(2025, 7, 1, 10, 30, 0, 0, time.UTC)
+
+	rule, err := store.SaveAlertRule(model.AlertRule{UserID: 1, Code: "000001", Type: "rate_above", Threshold: 2, Enabled: true, CreatedAt: now, UpdatedAt: now})
+	if err != nil || rule.ID == 0 {
+		t.Fatalf("❌ 新增规则失败: %+v %v", rule, err)
+	}
+	disabled, err := store.SaveAlertRule(model.AlertRule{UserID: 1, Code: "110022", Type: "drawdown", Threshold: 1, CreatedAt: now, UpdatedAt: now})
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := store.SaveAlertRule(model.AlertRule{UserID: 2, Code: "000001", Type: "value_below", Threshold: 1.1, Enabled: true, CreatedAt: now, UpdatedAt: now})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rules, err := store.ListAlertRules(1); err != nil || len(rules) != 2 || rules[0].ID != rule.ID || rules[1].Enabled {
+		t.Errorf("❌ 用户规则异常: %+v %v", rules, err)
+	}
+	if rules, err := store.EnabledAlertRules(); err != nil || len(rules) != 2 || rules[1].ID != other.ID {
+		t.Errorf("❌ 已启用规则异常: %+v %v", rules, err)
+	}
+	if got, _ := store.GetAlertRule(2, rule.ID); got != nil {
+		t.Errorf("❌ 不应读取到其他用户的规则: %+v", got)
+	}
+	rule.Threshold = 3
+	if _, err := store.SaveAlertRule(rule); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := store.GetAlertRule(1, rule.ID); err != nil || got == nil || got.Threshold != 3 || !got.CreatedAt.Equal(now) {
+		t.Errorf("❌ 修改规则异常: %+v %v", got, err)
+	}
+	other.UserID = 1
+	if _, err := store.SaveAlertRule(other); err == nil {
+		t.Error("❌ 修改其他用户的规则应返回错误")
+	}
+
+	// 同一规则同一交易日只保存一次
+	alert := model.Alert{RuleID: rule.ID, UserID: 1, Code: "000001", Name: "华夏成长混合", Type: "rate_above", Threshold: 3,
+		Observed: 3.2, Date: "2025-07-01", Time: "10:30", Value: 1.2, Rate: 3.2, Message: "涨幅超过 3%", CreatedAt: now}
+	first, inserted, err := store.RecordAlert(alert)
+	if err != nil || !inserted || first.ID == 0 {
+		t.Fatalf("❌ 保存提醒失败: %+v %v %v", first, inserted, err)
+	}
+	if _, inserted, err := store.RecordAlert(alert); err != nil || inserted {
+		t.Errorf("❌ 同一交易日重复提醒不应保存: %v %v", inserted, err)
+	}
+	alert.Date = "2025-07-02"
+	if second, inserted, err := store.RecordAlert(alert); err != nil || !inserted || second.ID <= first.ID {
+		t.Errorf("❌ 新的交易日应保存提醒: %+v %v %v", second, inserted, err)
+	}
+	if _, _, err := store.RecordAlert(model.Alert{RuleID: other.ID, UserID: 2, Date: "2025-07-01", CreatedAt: now}); err != nil {
+		t.Fatal(err)
+	}
+
+	alerts, err := store.ListAlerts(1, "", 0)
+	if err != nil || len(alerts) != 2 || alerts[0].Date != "2025-07-02" || alerts[1].Message != "涨幅超过 3%" {
+		t.Errorf("❌ 用户提醒异常: %+v %v", alerts, err)
+	}
+	if alerts, _ := store.ListAlerts(1, "2025-07-01", 0); len(alerts) != 1 || alerts[0].ID != first.ID {
+		t.Errorf("❌ 按交易日读取提醒异常: %+v", alerts)
+	}
+	if alerts, _ := store.ListAlerts(1, "", 1); len(alerts) != 1 || alerts[0].Date != "2025-07-02" {
+		t.Errorf("❌ 限制数量读取提醒异常: %+v", alerts)
+	}
+
+	if deleted, err := store.DeleteAlertRule(2, disabled.ID); err != nil || deleted {
+		t.Errorf("❌ 不应删除其他用户的规则: %v %v", deleted, err)
+	}
+	if deleted, err := store.DeleteAlertRule(1, rule.ID); err != nil || !deleted {
+		t.Errorf("❌ 删除规则失败: %v %v", deleted, err)
+	}
+	if alerts, _ := store.ListAlerts(1, "", 0); len(alerts) != 2 {
+		t.Errorf("❌ 删除规则后应保留已触发的提醒: %+v", alerts)
+	}
+}
@@ -0,0 +1,192 @@
+package storage
+
+import (
+	"fmt"
+	"fund/model"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// maxFileAlerts 文件存储保留的提醒数量上限（超出后丢弃最早的提醒）
+const maxFileAlerts = 10000
+
+// FileAlertStore 基于 JSON 文件的提醒存储，所有用户的规则和提醒保存在 <dir>/alerts.json
+type FileAlertStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+// alertFile 提醒文件内容
+type alertFile struct {
+	Rules       []model.AlertRule `json:"rules"`
+	Alerts      []model.Alert     `json:"alerts"`
+	NextRuleID  int64             `json:"nextRuleId"`
+	NextAlertID int64             `json:"nextAlertId"`
+}
+
+// NewFileAlertStore 创建文件存储
+func NewFileAlertStore(dir string) *FileAlertStore {
+	return &FileAlertStore{path: filepath.Join(dir, "alerts.json")}
+}
+
+// ListAlertRules 读取用户的全部规则
+func (s *FileAlertStore) ListAlertRules(userID int64) ([]model.AlertRule, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	rules := []model.AlertRule{}
+	for _, rule := range content.Rules {
+		if rule.UserID == userID {
+			rules = append(rules, rule)
+		}
+	}
+	return rules, nil
+}
+
+// GetAlertRule 读取用户的规则
+func (s *FileAlertStore) GetAlertRule(userID, id int64) (*model.AlertRule, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	for _, rule := range content.Rules {
+		if rule.UserID == userID && rule.ID == id {
+			return &rule, nil
+		}
+	}
+	return nil, nil
+}
+
+// SaveAlertRule 新增或覆盖规则
+func (s *FileAlertStore) SaveAlertRule(rule model.AlertRule) (model.AlertRule, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := s.load()
+	if err != nil {
+		return rule, err
+	}
+	if rule.ID == 0 {
+		content.NextRuleID++
+		rule.ID = content.NextRuleID
+		content.Rules = append(content.Rules, rule)
+	} else {
+		found := false
+		for i, existing := range content.Rules {
+			if existing.UserID == rule.UserID && existing.ID == rule.ID {
+				content.Rules[i] = rule
+				found = true
+				break
+			}
+		}
+		if !found {
+			return rule, fmt.Errorf("提醒规则 %d 不存在", rule.ID)
+		}
+	}
+	if err := writeJSON(s.path, content); err != nil {
+		return rule, err
+	}
+	return rule, nil
+}
+
+// DeleteAlertRule 删除用户的规则
+func (s *FileAlertStore) DeleteAlertRule(userID, id int64) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := s.load()
+	if err != nil {
+		return false, err
+	}
+	for i, rule := range content.Rules {
+		if rule.UserID == userID && rule.ID == id {
+			content.Rules = append(content.Rules[:i], content.Rules[i+1:]...)
+			return true, writeJSON(s.path, content)
+		}
+	}
+	return false, nil
+}
+
+// EnabledAlertRules 所有用户已启用的规则
+func (s *FileAlertStore) EnabledAlertRules() ([]model.AlertRule, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	rules := []model.AlertRule{}
+	for _, rule := range content.Rules {
+		if rule.Enabled {
+			rules = append(rules, rule)
+		}
+	}
+	return rules, nil
+}
+
+// RecordAlert 保存触发的提醒，同一规则同一交易日只保存一次
+func (s *FileAlertStore) RecordAlert(alert model.Alert) (model.Alert, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := s.load()
+	if err != nil {
+		return alert, false, err
+	}
+	for _, existing := range content.Alerts {
+		if existing.RuleID == alert.RuleID && existing.Date == alert.Date {
+			return existing, false, nil
+		}
+	}
+	content.NextAlertID++
+	alert.ID = content.NextAlertID
+	content.Alerts = append(content.Alerts, alert)
+	if len(content.Alerts) > maxFileAlerts {
+		content.Alerts = content.Alerts[len(content.Alerts)-maxFileAlerts:]
+	}
+	if err := writeJSON(s.path, content); err != nil {
+		return alert, false, err
+	}
+	return alert, true, nil
+}
+
+// ListAlerts 读取用户的提醒（最新的在前）
+func (s *FileAlertStore) ListAlerts(userID int64, date string, limit int) ([]model.Alert, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	alerts := []model.Alert{}
+	for i := len(content.Alerts) - 1; i >= 0; i-- {
+		alert := content.Alerts[i]
+		if alert.UserID != userID || (date != "" && alert.Date != date) {
+			continue
+		}
+		alerts = append(alerts, alert)
+		if limit > 0 && len(alerts) >= limit {
+			break
+		}
+	}
+	return alerts, nil
+}
+
+// load 读取提醒文件（调用方需持有锁），文件不存在时返回空数据
+func (s *FileAlertStore) load() (*alertFile, error) {
+	content := &alertFile{}
+	if err := readJSON(s.path, content); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	return content, nil
+}
@@ -0,0 +1,182 @@
+package storage
+
+import (
+	"fmt"
+	"fund/model"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// maxFileDeliveries 文件存储保留的投递记录数量上限（超出后丢弃最早的记录）
+const maxFileDeliveries = 10000
+
+// FileNotifyStore 基于 JSON 文件的通知存储，所有用户的通知渠道和投递记录保存在 <dir>/notify.json
+type FileNotifyStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+// channelRecord 通知文件中的渠道（包含签名密钥）
+type channelRecord struct {
+	model.NotifyChannel
+	Secret string `json:"secret,omitempty"`
+}
+
+// notifyFile 通知文件内容
+type notifyFile struct {
+	Channels       []channelRecord        `json:"channels"`
+	Deliveries     []model.NotifyDelivery `json:"deliveries"`
+	NextChannelID  int64                  `json:"nextChannelId"`
+	NextDeliveryID int64                  `json:"nextDeliveryId"`
+}
+
+// NewFileNotifyStore 创建文件存储
+func NewFileNotifyStore(dir string) *FileNotifyStore {
+	return &FileNotifyStore{path: filepath.Join(dir, "notify.json")}
+}
+
+// ListChannels 读取用户的全部通知渠道
+func (s *FileNotifyStore) ListChannels(userID int64) ([]model.NotifyChannel, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	channels := []model.NotifyChannel{}
+	for _, record := range content.Channels {
+		if record.UserID == userID {
+			channels = append(channels, record.channel())
+		}
+	}
+	return channels, nil
+}
+
+// GetChannel 读取用户的通知渠道
+func (s *FileNotifyStore) GetChannel(userID, id int64) (*model.NotifyChannel, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range content.Channels {
+		if record.UserID == userID && record.ID == id {
+			channel := record.channel()
+			return &channel, nil
+		}
+	}
+	return nil, nil
+}
+
+// SaveChannel 新增或覆盖通知渠道
+func (s *FileNotifyStore) SaveChannel(channel model.NotifyChannel) (model.NotifyChannel, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := s.load()
+	if err != nil {
+		return channel, err
+	}
+	if channel.ID == 0 {
+		content.NextChannelID++
+		channel.ID = content.NextChannelID
+		content.Channels = append(content.Channels, channelRecord{NotifyChannel: channel, Secret: channel.Secret})
+	} else {
+		found := false
+		for i, record := range content.Channels {
+			if record.UserID == channel.UserID && record.ID == channel.ID {
+				content.Channels[i] = channelRecord{NotifyChannel: channel, Secret: channel.Secret}
+				found = true
+				break
+			}
+		}
+		if !found {
+			return channel, fmt.Errorf("通知渠道 %d 不存在", channel.ID)
+		}
+	}
+	if err := writeJSON(s.path, content); err != nil {
+		return channel, err
+	}
+	return channel, nil
+}
+
+// DeleteChannel 删除用户的通知渠道
+func (s *FileNotifyStore) DeleteChannel(userID, id int64) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := s.load()
+	if err != nil {
+		return false, err
+	}
+	for i, record := range content.Channels {
+		if record.UserID == userID && record.ID == id {
+			content.Channels = append(content.Channels[:i], content.Channels[i+1:]...)
+			return true, writeJSON(s.path, content)
+		}
+	}
+	return false, nil
+}
+
+// RecordDelivery 保存投递记录
+func (s *FileNotifyStore) RecordDelivery(delivery model.NotifyDelivery) (model.NotifyDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := s.load()
+	if err != nil {
+		return delivery, err
+	}
+	content.NextDeliveryID++
+	delivery.ID = content.NextDeliveryID
+	content.Deliveries = append(content.Deliveries, delivery)
+	if len(content.Deliveries) > maxFileDeliveries {
+		content.Deliveries = content.Deliveries[len(content.Deliveries)-maxFileDeliveries:]
+	}
+	if err := writeJSON(s.path, content); err != nil {
+		return delivery, err
+	}
+	return delivery, nil
+}
+
+// ListDeliveries 读取用户的投递记录（最新的在前）
+func (s *FileNotifyStore) ListDeliveries(userID int64, limit int) ([]model.NotifyDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	deliveries := []model.NotifyDelivery{}
+	for i := len(content.Deliveries) - 1; i >= 0; i-- {
+		if content.Deliveries[i].UserID != userID {
+			continue
+		}
+		deliveries = append(deliveries, content.Deliveries[i])
+		if limit > 0 && len(deliveries) >= limit {
+			break
+		}
+	}
+	return deliveries, nil
+}
+
+// channel 转换为包含签名密钥的渠道
+func (r channelRecord) channel() model.NotifyChannel {
+	channel := r.NotifyChannel
+	channel.Secret = r.Secret
+	return channel
+}
+
+// load 读取通知文件（调用方需持有锁），文件不存在时返回空数据
+func (s *FileNotifyStore) load() (*notifyFile, error) {
+	content := &notifyFile{}
+	if err := readJSON(s.path, content); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	return content, nil
+}
@@ -0,0 +1,185 @@
+package storage
+
+import (
+	"fmt"
+	"fund/model"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// FilePortfolioStore 基于 JSON 文件的持仓和交易记录存储
+// 数据量很小，每个用户的数据保存在 <dir>/portfolios/<userID>.json 一个文件中，每次修改整体重写
+type FilePortfolioStore struct {
+	mu  sync.Mutex
+	dir string
+}
+
+// portfolioFile 持仓文件内容
+type portfolioFile struct {
+	Holdings     map[string]model.Holding `json:"holdings"`
+	Transactions []model.Transaction      `json:"transactions"`
+	NextID       int64                    `json:"nextId"`
+}
+
+// NewFilePortfolioStore 创建文件存储
+func NewFilePortfolioStore(dir string) *FilePortfolioStore {
+	return &FilePortfolioStore{dir: dir}
+}
+
+// ListHoldings 读取全部持仓
+func (s *FilePortfolioStore) ListHoldings(userID int64) ([]model.Holding, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := s.load(userID)
+	if err != nil {
+		return nil, err
+	}
+	holdings := make([]model.Holding, 0, len(content.Holdings))
+	for _, holding := range content.Holdings {
+		holdings = append(holdings, holding)
+	}
+	sort.Slice(holdings, func(i, j int) bool {
+		return holdings[i].Code < holdings[j].Code
+	})
+	return holdings, nil
+}
+
+// GetHolding 读取单只基金的持仓
+func (s *FilePortfolioStore) GetHolding(userID int64, code string) (*model.Holding, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := s.load(userID)
+	if err != nil {
+		return nil, err
+	}
+	holding, exists := content.Holdings[code]
+	if !exists {
+		return nil, nil
+	}
+	return &holding, nil
+}
+
+// SaveHolding 新增或覆盖持仓
+func (s *FilePortfolioStore) SaveHolding(userID int64, holding model.Holding) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := s.load(userID)
+	if err != nil {
+		return err
+	}
+	content.Holdings[holding.Code] = holding
+	return writeJSON(s.path(userID), content)
+}
+
+// DeleteHolding 删除持仓及其交易记录
+func (s *FilePortfolioStore) DeleteHolding(userID int64, code string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := s.load(userID)
+	if err != nil {
+		return err
+	}
+	delete(content.Holdings, code)
+	kept := content.Transactions[:0]
+	for _, tx := range content.Transactions {
+		if tx.Code != code {
+			kept = append(kept, tx)
+		}
+	}
+	content.Transactions = kept
+	return writeJSON(s.path(userID), content)
+}
+
+// RecordTransaction 追加交易记录并保存持仓
+func (s *FilePortfolioStore) RecordTransaction(userID int64, tx model.Transaction, holding model.Holding) (model.Transaction, error) {
+	if tx.Code != holding.Code {
+		return tx, fmt.Errorf("交易记录与持仓的基金代码不一致: %s/%s", tx.Code, holding.Code)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := s.load(userID)
+	if err != nil {
+		return tx, err
+	}
+	content.NextID++
+	tx.ID = content.NextID
+	content.Transactions = append(content.Transactions, tx)
+	content.Holdings[holding.Code] = holding
+	if err := writeJSON(s.path(userID), content); err != nil {
+		return tx, err
+	}
+	return tx, nil
+}
+
+// ListTransactions 读取交易记录
+func (s *FilePortfolioStore) ListTransactions(userID int64, code string) ([]model.Transaction, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := s.load(userID)
+	if err != nil {
+		return nil, err
+	}
+	result := []model.Transaction{}
+	for _, tx := range content.Transactions {
+		if code == "" || tx.Code == code {
+			result = append(result, tx)
+		}
+	}
+	return result, nil
+}
+
+// ClaimLegacyPortfolio 将单用户版本的 <dir>/portfolio.json 移动为用户的持仓文件
+func (s *FilePortfolioStore) ClaimLegacyPortfolio(userID int64) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	legacy := filepath.Join(s.dir, "portfolio.json")
+	content := &portfolioFile{}
+	if err := readJSON(legacy, content); err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if _, err := os.Stat(s.path(userID)); err == nil {
+		return false, nil
+	} else if !os.IsNotExist(err) {
+		return false, err
+	}
+
+	if err := writeJSON(s.path(userID), content); err != nil {
+		return false, err
+	}
+	// 旧文件保留为备份，不再参与迁移
+	if err := os.Rename(legacy, legacy+".migrated"); err != nil {
+		return false, fmt.Errorf("备份旧版持仓文件失败: %v", err)
+	}
+	return true, nil
+}
+
+// path 用户持仓文件路径
+func (s *FilePortfolioStore) path(userID int64) string {
+	return filepath.Join(s.dir, "portfolios", fmt.Sprintf("%d.json", userID))
+}
+
+// load 读取用户持仓文件（调用方需持有锁），文件不存在时返回空数据
+func (s *FilePortfolioStore) load(userID int64) (*portfolioFile, error) {
+	content := &portfolioFile{}
+	err := readJSON(s.path(userID), content)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if content.Holdings == nil {
+		content.Holdings = make(map[string]model.Holding)
+	}
+	return content, nil
+}
@@ -0,0 +1,168 @@
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"fund/model"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FileFundStore 基于 JSON 文件的基金列表和历史净值存储
+// 目录结构: <dir>/funds.json、<dir>/nav/<基金代码>.json
+type FileFundStore struct {
+	mu  sync.Mutex
+	dir string
+}
+
+// fundListFile 基金列表文件内容
+type fundListFile struct {
+	UpdatedAt time.Time             `json:"updatedAt"`
+	Funds     []model.FundBasicInfo `json:"funds"`
+}
+
+// navFile 历史净值文件内容
+type navFile struct {
+	Code      string             `json:"code"`
+	Name      string             `json:"name"`
+	UpdatedAt time.Time          `json:"updatedAt"`
+	Data      []model.TrendPoint `json:"data"`
+}
+
+// NewFileFundStore 创建文件存储
+func NewFileFundStore(dir string) *FileFundStore {
+	return &FileFundStore{dir: dir}
+}
+
+// SaveFundList 保存全量基金列表
+func (s *FileFundStore) SaveFundList(funds []model.FundBasicInfo, updatedAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return writeJSON(filepath.Join(s.dir, "funds.json"), fundListFile{UpdatedAt: updatedAt, Funds: funds})
+}
+
+// LoadFundList 读取基金列表
+func (s *FileFundStore) LoadFundList() ([]model.FundBasicInfo, time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var content fundListFile
+	if err := readJSON(filepath.Join(s.dir, "funds.json"), &content); err != nil {
+		if os.IsNotExist(err) {
+			return []model.FundBasicInfo{}, time.Time{}, nil
+		}
+		return nil, time.Time{}, err
+	}
+	return content.Funds, content.UpdatedAt, nil
+}
+
+// SaveNAVHistory 保存基金历史净值（与已保存的数据按日期合并）
+func (s *FileFundStore) SaveNAVHistory(trend *model.FundTrend, updatedAt time.Time) error {
+	path, err := s.navPath(trend.Code)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var content navFile
+	if err := readJSON(path, &content); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	byDate := make(map[string]model.TrendPoint, len(content.Data)+len(trend.Data))
+	for _, point := range content.Data {
+		byDate[point.Date] = point
+	}
+	for _, point := range trend.Data {
+		byDate[point.Date] = point
+	}
+	merged := make([]model.TrendPoint, 0, len(byDate))
+	for _, point := range byDate {
+		merged = append(merged, point)
+	}
+	sort.Slice(merged, func(i, j int) bool {
+		return merged[i].Date < merged[j].Date
+	})
+
+	content.Code = trend.Code
+	if trend.Name != "" {
+		content.Name = trend.Name
+	}
+	content.UpdatedAt = updatedAt
+	content.Data = merged
+
+	return writeJSON(path, content)
+}
+
+// LoadNAVHistory 读取基金历史净值
+func (s *FileFundStore) LoadNAVHistory(code string) (*model.FundTrend, time.Time, error) {
+	path, err := s.navPath(code)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var content navFile
+	if err := readJSON(path, &content); err != nil {
+		if os.IsNotExist(err) {
+			return nil, time.Time{}, nil
+		}
+		return nil, time.Time{}, err
+	}
+	return &model.FundTrend{
+		Code:   content.Code,
+		Name:   content.Name,
+		Period: "all",
+		Data:   content.Data,
+	}, content.UpdatedAt, nil
+}
+
+// navPath 历史净值文件路径
+func (s *FileFundStore) navPath(code string) (string, error) {
+	if code == "" || strings.ContainsAny(code, `/\.`) {
+		return "", fmt.Errorf("非法的基金代码: %q", code)
+	}
+	return filepath.Join(s.dir, "nav", code+".json"), nil
+}
+
+// readJSON 读取JSON文件，文件不存在时返回 os.IsNotExist 可判断的错误
+func readJSON(path string, v interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("解析 %s 失败: %v", path, err)
+	}
+	return nil
+}
+
+// writeJSON 写入JSON文件（先写临时文件再原子替换）
+func writeJSON(path string, v interface{}) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("创建目录失败: %v", err)
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("编码数据失败: %v", err)
+	}
+
+	tmpFile := path + ".tmp"
+	if err := os.WriteFile(tmpFile, data, 0644); err != nil {
+		os.Remove(tmpFile)
+		return fmt.Errorf("写入临时文件失败: %v", err)
+	}
+	if err := os.Rename(tmpFile, path); err != nil {
+		os.Remove(tmpFile)
+		return fmt.Errorf("替换数据文件失败: %v", err)
+	}
+	return nil
+}
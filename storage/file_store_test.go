@@ -0,0 +1,55 @@
+package storage
+
+import (
+	"fund/model"
+	"testing"
+	"time"
+)
+
+// TestFileFundStore 测试历史净值按日期合并保存、基金列表读写
+func TestFileFundStore(t *testing.T) {
+	store := NewFileFundStore(t.TempDir())
+
+	if trend, _, err := store.LoadNAVHistory("000001"); err != nil || trend != nil {
+		t.Fatalf("❌ 无数据时应返回 nil: %+v %v", trend, err)
+	}
+
+	first := time.Date(2025, 6, 30, 21, 0, 0, 0, time.UTC)
+	if err := store.SaveNAVHistory(&model.FundTrend{Code: "000001", Name: "华夏成长混合", Data: []model.TrendPoint{
+		{Date: "2025-06-27", Value: 1.10},
+		{Date: "2025-06-30", Value: 1.11},
+	}}, first); err != nil {
+		t.Fatal(err)
+	}
+	second := first.AddDate(0, 0, 1)
+	if err := store.SaveNAVHistory(&model.FundTrend{Code: "000001", Data: []model.TrendPoint{
+		{Date: "2025-07-01", Value: 1.12},
+		{Date: "2025-06-30", Value: 1.115},
+	}}, second); err != nil {
+		t.Fatal(err)
+	}
+
+	trend, updatedAt, err := store.LoadNAVHistory("000001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !updatedAt.Equal(second) || trend.Name != "华夏成长混合" || len(trend.Data) != 3 {
+		t.Fatalf("❌ 合并结果异常: %+v %v", trend, updatedAt)
+	}
+	if trend.Data[1].Value != 1.115 || trend.Data[2].Date != "2025-07-01" {
+		t.Errorf("❌ 应按日期升序、同一日期以最后写入为准: %+v", trend.Data)
+	}
+
+	funds := []model.FundBasicInfo{{Code: "000001", Name: "华夏成长混合", Type: "混合型"}}
+	if err := store.SaveFundList(funds, first); err != nil {
+		t.Fatal(err)
+	}
+	loaded, listUpdatedAt, err := store.LoadFundList()
+	if err != nil || len(loaded) != 1 || !listUpdatedAt.Equal(first) {
+		t.Errorf("❌ 基金列表读写异常: %+v %v %v", loaded, listUpdatedAt, err)
+	}
+
+	if err := store.SaveNAVHistory(&model.FundTrend{Code: "../x"}, first); err == nil {
+		t.Error("❌ 非法基金代码应拒绝写入")
+	}
+}
@@ -0,0 +1,228 @@
+package storage
+
+import (
+	"fund/model"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FileUserStore 基于 JSON 文件的用户和令牌存储，所有数据保存在 <dir>/users.json
+type FileUserStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+// userRecord 用户文件中的用户（包含密码哈希）
+type userRecord struct {
+	model.User
+	PasswordHash string `json:"passwordHash"`
+}
+
+// tokenRecord 用户文件中的令牌（包含令牌哈希）
+type tokenRecord struct {
+	model.APIToken
+	TokenHash string `json:"tokenHash"`
+}
+
+// userFile 用户文件内容
+type userFile struct {
+	Users       []userRecord  `json:"users"`
+	Tokens      []tokenRecord `json:"tokens"`
+	NextUserID  int64         `json:"nextUserId"`
+	NextTokenID int64         `json:"nextTokenId"`
+}
+
+// NewFileUserStore 创建文件存储
+func NewFileUserStore(dir string) *FileUserStore {
+	return &FileUserStore{path: filepath.Join(dir, "users.json")}
+}
+
+// CreateUser 创建用户
+func (s *FileUserStore) CreateUser(user model.User) (model.User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := s.load()
+	if err != nil {
+		return user, err
+	}
+	for _, record := range content.Users {
+		if strings.EqualFold(record.Username, user.Username) {
+			return user, ErrUserExists
+		}
+	}
+	content.NextUserID++
+	user.ID = content.NextUserID
+	content.Users = append(content.Users, userRecord{User: user, PasswordHash: user.PasswordHash})
+	if err := writeJSON(s.path, content); err != nil {
+		return user, err
+	}
+	return user, nil
+}
+
+// CountUsers 用户数量
+func (s *FileUserStore) CountUsers() (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := s.load()
+	if err != nil {
+		return 0, err
+	}
+	return len(content.Users), nil
+}
+
+// GetUser 按 ID 读取用户
+func (s *FileUserStore) GetUser(id int64) (*model.User, error) {
+	return s.findUser(func(user *model.User) bool { return user.ID == id })
+}
+
+// GetUserByName 按用户名读取用户
+func (s *FileUserStore) GetUserByName(username string) (*model.User, error) {
+	return s.findUser(func(user *model.User) bool { return strings.EqualFold(user.Username, username) })
+}
+
+// CreateToken 保存令牌
+func (s *FileUserStore) CreateToken(token model.APIToken) (model.APIToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := s.load()
+	if err != nil {
+		return token, err
+	}
+	content.NextTokenID++
+	token.ID = content.NextTokenID
+	content.Tokens = append(content.Tokens, tokenRecord{APIToken: token, TokenHash: token.TokenHash})
+	if err := writeJSON(s.path, content); err != nil {
+		return token, err
+	}
+	return token, nil
+}
+
+// GetTokenByHash 按令牌哈希读取令牌
+func (s *FileUserStore) GetTokenByHash(hash string) (*model.APIToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range content.Tokens {
+		if record.TokenHash == hash {
+			token := record.APIToken
+			token.TokenHash = record.TokenHash
+			return &token, nil
+		}
+	}
+	return nil, nil
+}
+
+// ListTokens 读取用户的全部令牌
+func (s *FileUserStore) ListTokens(userID int64) ([]model.APIToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	tokens := []model.APIToken{}
+	for _, record := range content.Tokens {
+		if record.UserID == userID {
+			tokens = append(tokens, record.APIToken)
+		}
+	}
+	return tokens, nil
+}
+
+// DeleteToken 删除用户的令牌
+func (s *FileUserStore) DeleteToken(userID, id int64) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := s.load()
+	if err != nil {
+		return false, err
+	}
+	for i, record := range content.Tokens {
+		if record.ID == id && record.UserID == userID {
+			content.Tokens = append(content.Tokens[:i], content.Tokens[i+1:]...)
+			return true, writeJSON(s.path, content)
+		}
+	}
+	return false, nil
+}
+
+// TouchToken 更新令牌的最近使用时间
+func (s *FileUserStore) TouchToken(id int64, usedAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := s.load()
+	if err != nil {
+		return err
+	}
+	for i := range content.Tokens {
+		if content.Tokens[i].ID == id {
+			content.Tokens[i].LastUsedAt = &usedAt
+			return writeJSON(s.path, content)
+		}
+	}
+	return nil
+}
+
+// DeleteExpiredTokens 删除用户已过期的令牌
+func (s *FileUserStore) DeleteExpiredTokens(userID int64, now time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := s.load()
+	if err != nil {
+		return 0, err
+	}
+	kept := content.Tokens[:0]
+	for _, record := range content.Tokens {
+		if record.UserID != userID || record.ExpiresAt == nil || !now.After(*record.ExpiresAt) {
+			kept = append(kept, record)
+		}
+	}
+	deleted := len(content.Tokens) - len(kept)
+	if deleted == 0 {
+		return 0, nil
+	}
+	content.Tokens = kept
+	return deleted, writeJSON(s.path, content)
+}
+
+// findUser 查找第一个满足条件的用户
+func (s *FileUserStore) findUser(match func(user *model.User) bool) (*model.User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range content.Users {
+		if match(&record.User) {
+			user := record.User
+			user.PasswordHash = record.PasswordHash
+			return &user, nil
+		}
+	}
+	return nil, nil
+}
+
+// load 读取用户文件（调用方需持有锁），文件不存在时返回空数据
+func (s *FileUserStore) load() (*userFile, error) {
+	content := &userFile{}
+	if err := readJSON(s.path, content); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	return content, nil
+}
@@ -0,0 +1,157 @@
+package storage
+
+import (
+	"fmt"
+	"fund/model"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// FileWatchlistStore 基于 JSON 文件的自选分组存储，所有用户的分组保存在 <dir>/watchlists.json
+type FileWatchlistStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+// watchlistRecord 自选文件中的分组
+type watchlistRecord struct {
+	UserID int64 `json:"userId"`
+	model.Watchlist
+}
+
+// watchlistFile 自选文件内容
+type watchlistFile struct {
+	Watchlists []watchlistRecord `json:"watchlists"`
+	NextID     int64             `json:"nextId"`
+}
+
+// NewFileWatchlistStore 创建文件存储
+func NewFileWatchlistStore(dir string) *FileWatchlistStore {
+	return &FileWatchlistStore{path: filepath.Join(dir, "watchlists.json")}
+}
+
+// ListWatchlists 读取用户的全部分组
+func (s *FileWatchlistStore) ListWatchlists(userID int64) ([]model.Watchlist, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	watchlists := []model.Watchlist{}
+	for _, record := range content.Watchlists {
+		if record.UserID == userID {
+			watchlists = append(watchlists, record.Watchlist)
+		}
+	}
+	sort.SliceStable(watchlists, func(i, j int) bool {
+		if watchlists[i].Position != watchlists[j].Position {
+			return watchlists[i].Position < watchlists[j].Position
+		}
+		return watchlists[i].ID < watchlists[j].ID
+	})
+	return watchlists, nil
+}
+
+// GetWatchlist 读取用户的分组
+func (s *FileWatchlistStore) GetWatchlist(userID, id int64) (*model.Watchlist, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range content.Watchlists {
+		if record.UserID == userID && record.ID == id {
+			watchlist := record.Watchlist
+			return &watchlist, nil
+		}
+	}
+	return nil, nil
+}
+
+// SaveWatchlist 新增或覆盖分组
+func (s *FileWatchlistStore) SaveWatchlist(userID int64, watchlist model.Watchlist) (model.Watchlist, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := s.load()
+	if err != nil {
+		return watchlist, err
+	}
+	if watchlist.ID == 0 {
+		content.NextID++
+		watchlist.ID = content.NextID
+		content.Watchlists = append(content.Watchlists, watchlistRecord{UserID: userID, Watchlist: watchlist})
+	} else {
+		found := false
+		for i, record := range content.Watchlists {
+			if record.UserID == userID && record.ID == watchlist.ID {
+				content.Watchlists[i].Watchlist = watchlist
+				found = true
+				break
+			}
+		}
+		if !found {
+			return watchlist, fmt.Errorf("自选分组 %d 不存在", watchlist.ID)
+		}
+	}
+	if err := writeJSON(s.path, content); err != nil {
+		return watchlist, err
+	}
+	return watchlist, nil
+}
+
+// DeleteWatchlist 删除用户的分组
+func (s *FileWatchlistStore) DeleteWatchlist(userID, id int64) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := s.load()
+	if err != nil {
+		return false, err
+	}
+	for i, record := range content.Watchlists {
+		if record.UserID == userID && record.ID == id {
+			content.Watchlists = append(content.Watchlists[:i], content.Watchlists[i+1:]...)
+			return true, writeJSON(s.path, content)
+		}
+	}
+	return false, nil
+}
+
+// AllWatchCodes 所有用户分组中基金代码的并集
+func (s *FileWatchlistStore) AllWatchCodes() ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	codes := []string{}
+	for _, record := range content.Watchlists {
+		for _, code := range record.Codes {
+			if !seen[code] {
+				seen[code] = true
+				codes = append(codes, code)
+			}
+		}
+	}
+	sort.Strings(codes)
+	return codes, nil
+}
+
+// load 读取自选文件（调用方需持有锁），文件不存在时返回空数据
+func (s *FileWatchlistStore) load() (*watchlistFile, error) {
+	content := &watchlistFile{}
+	if err := readJSON(s.path, content); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	return content, nil
+}
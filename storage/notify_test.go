@@ -0,0 +1,78 @@
+package storage
+
+import (
+	"fund/model"
+	"testing"
+	"time"
+)
+
+// TestNotifyStore 测试文件和 SQLite 两种通知存储
+func TestNotifyStore(t *testing.T) {
+	forEachStore(t, func(dir string) NotifyStore { return NewFileNotifyStore(dir) }, func(t *testing.T, open func() NotifyStore) {
+		testNotifyStore(t, open())
+		// 签名密钥需要持久化
+		if channel, err := open().GetChannel(1, 1); err != nil || channel == nil || channel.Secret != "s3cret" {
+			t.Errorf("❌ 重新打开后签名密钥丢失: %+v %v", channel, err)
+		}
+	})
+}
+
+// testNotifyStore 通知存储的通用测试
+func testNotifyStore(t *testing.T, store NotifyStore) {
+	now := time.Date(2025, 7, 1, 10, 30, 0, 0, time.UTC)
+
+	webhook, err := store.SaveChannel(model.NotifyChannel{UserID: 1, Name: "Webhook", Type: "webhook", URL: "http://127.0.0.1/hook",
+		Secret: "s3cret", Enabled: true, CreatedAt: now, UpdatedAt: now})
+	if err != nil || webhook.ID != 1 {
+		t.Fatalf("❌ 新增通知渠道失败: %+v %v", webhook, err)
+	}
+	email, err := store.SaveChannel(model.NotifyChannel{UserID: 2, Name: "邮件", Type: "email", Email: "bob@example.com",
+		Enabled: true, CreatedAt: now, UpdatedAt: now})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	channels, err := store.ListChannels(1)
+	if err != nil || len(channels) != 1 || channels[0].Secret != "s3cret" || channels[0].URL != "http://127.0.0.1/hook" {
+		t.Errorf("❌ 用户通知渠道异常: %+v %v", channels, err)
+	}
+	if channel, _ := store.GetChannel(1, email.ID); channel != nil {
+		t.Errorf("❌ 不应读取到其他用户的通知渠道: %+v", channel)
+	}
+	email.UserID = 1
+	if _, err := store.SaveChannel(email); err == nil {
+		t.Error("❌ 修改其他用户的通知渠道应返回错误")
+	}
+	webhook.Enabled = false
+	if _, err := store.SaveChannel(webhook); err != nil {
+		t.Fatal(err)
+	}
+	if channel, err := store.GetChannel(1, webhook.ID); err != nil || channel == nil || channel.Enabled || channel.Secret != "s3cret" {
+		t.Errorf("❌ 修改通知渠道异常: %+v %v", channel, err)
+	}
+
+	for i, status := range []string{"failed", "sent"} {
+		delivery, err := store.RecordDelivery(model.NotifyDelivery{UserID: 1, ChannelID: webhook.ID, ChannelType: "webhook",
+			AlertID: int64(i + 1), Status: status, Attempts: 3 - i*2, CreatedAt: now})
+		if err != nil || delivery.ID == 0 {
+			t.Fatalf("❌ 保存投递记录失败: %+v %v", delivery, err)
+		}
+	}
+	if _, err := store.RecordDelivery(model.NotifyDelivery{UserID: 2, ChannelID: 2, Status: "sent", Attempts: 1, CreatedAt: now}); err != nil {
+		t.Fatal(err)
+	}
+	deliveries, err := store.ListDeliveries(1, 0)
+	if err != nil || len(deliveries) != 2 || deliveries[0].Status != "sent" || deliveries[1].Attempts != 3 {
+		t.Errorf("❌ 投递记录异常: %+v %v", deliveries, err)
+	}
+	if deliveries, _ := store.ListDeliveries(1, 1); len(deliveries) != 1 || deliveries[0].AlertID != 2 {
+		t.Errorf("❌ 限制数量读取投递记录异常: %+v", deliveries)
+	}
+
+	if deleted, err := store.DeleteChannel(2, webhook.ID); err != nil || deleted {
+		t.Errorf("❌ 不应删除其他用户的通知渠道: %v %v", deleted, err)
+	}
+	if deleted, err := store.DeleteChannel(2, email.ID); err != nil || !deleted {
+		t.Errorf("❌ 删除通知渠道失败: %v %v", deleted, err)
+	}
+}
@@ -0,0 +1,159 @@
+package storage
+
+import (
+	"database/sql"
+	"fund/model"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// TestPortfolioStore 测试文件和 SQLite 两种持仓存储
+func TestPortfolioStore(t *testing.T) {
+	forEachStore(t, func(dir string) PortfolioStore { return NewFilePortfolioStore(dir) }, func(t *testing.T, open func() PortfolioStore) {
+		testPortfolioStore(t, open(), open)
+	})
+}
+
+// testPortfolioStore 持仓存储的通用测试，reopen 模拟重启
+func testPortfolioStore(t *testing.T, store PortfolioStore, reopen func() PortfolioStore) {
+	now := time.Date(2025, 7, 1, 10, 30, 0, 0, time.UTC)
+
+	if holding, err := store.GetHolding(1, "000001"); err != nil || holding != nil {
+		t.Fatalf("❌ 无持仓时应返回 nil: %+v %v", holding, err)
+	}
+
+	holding := model.Holding{Code: "110022", Name: "易方达消费行业股票", Shares: 100, Cost: 500, PurchaseDate: "2025-06-03", UpdatedAt: now}
+	if err := store.SaveHolding(1, holding); err != nil {
+		t.Fatal(err)
+	}
+	holding = model.Holding{Code: "000001", Name: "华夏成长混合", Shares: 1000, Cost: 1100, PurchaseDate: "2025-06-30", UpdatedAt: now}
+	first, err := store.RecordTransaction(1, model.Transaction{
+		Code: "000001", Type: "buy", Date: "2025-06-30", Shares: 1000, Price: 1.1, Amount: 1100, CreatedAt: now,
+	}, holding)
+	if err != nil {
+		t.Fatal(err)
+	}
+	holding.Shares, holding.Cost, holding.Realized = 600, 660, 20
+	second, err := store.RecordTransaction(1, model.Transaction{
+		Code: "000001", Type: "sell", Date: "2025-07-01", Shares: 400, Price: 1.15, Amount: 460, CreatedAt: now,
+	}, holding)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.ID == 0 || second.ID <= first.ID {
+		t.Errorf("❌ 交易记录ID应递增: %d %d", first.ID, second.ID)
+	}
+	if _, err := store.RecordTransaction(1, model.Transaction{Code: "110022"}, holding); err == nil {
+		t.Error("❌ 基金代码不一致应返回错误")
+	}
+
+	// 重启后数据仍在
+	store = reopen()
+	holdings, err := store.ListHoldings(1)
+	if err != nil || len(holdings) != 2 || holdings[0].Code != "000001" || holdings[0].Shares != 600 ||
+		holdings[0].Realized != 20 || !holdings[0].UpdatedAt.Equal(now) {
+		t.Fatalf("❌ 持仓异常: %+v %v", holdings, err)
+	}
+	transactions, err := store.ListTransactions(1, "000001")
+	if err != nil || len(transactions) != 2 || transactions[1].Type != "sell" || transactions[1].Amount != 460 {
+		t.Fatalf("❌ 交易记录异常: %+v %v", transactions, err)
+	}
+	if all, _ := store.ListTransactions(1, ""); len(all) != 2 {
+		t.Errorf("❌ 全部交易记录数量异常: %d", len(all))
+	}
+
+	// 其他用户的数据相互隔离
+	if err := store.SaveHolding(2, model.Holding{Code: "000001", Shares: 1, UpdatedAt: now}); err != nil {
+		t.Fatal(err)
+	}
+	if others, _ := store.ListHoldings(2); len(others) != 1 || others[0].Shares != 1 {
+		t.Errorf("❌ 用户 2 的持仓异常: %+v", others)
+	}
+	if others, _ := store.ListTransactions(2, ""); len(others) != 0 {
+		t.Errorf("❌ 用户 2 不应看到用户 1 的交易记录: %+v", others)
+	}
+
+	if err := store.DeleteHolding(1, "000001"); err != nil {
+		t.Fatal(err)
+	}
+	holdings, _ = store.ListHoldings(1)
+	transactions, _ = store.ListTransactions(1, "000001")
+	if len(holdings) != 1 || len(transactions) != 0 {
+		t.Errorf("❌ 删除后仍有数据: %+v %+v", holdings, transactions)
+	}
+	if others, _ := store.GetHolding(2, "000001"); others == nil {
+		t.Error("❌ 删除用户 1 的持仓不应影响用户 2")
+	}
+}
+
+// TestLegacyPortfolioMigration 测试单用户版本的持仓数据只在分配给管理员后可见
+func TestLegacyPortfolioMigration(t *testing.T) {
+	dir := t.TempDir()
+	legacy := `{"holdings":{"000001":{"code":"000001","shares":10}},"transactions":[],"nextId":0}`
+	if err := os.WriteFile(filepath.Join(dir, "portfolio.json"), []byte(legacy), 0644); err != nil {
+		t.Fatal(err)
+	}
+	fileStore := NewFilePortfolioStore(dir)
+	if holding, _ := fileStore.GetHolding(1, "000001"); holding != nil {
+		t.Errorf("❌ 未分配的旧版持仓不应归属任何用户: %+v", holding)
+	}
+	if err := fileStore.SaveHolding(2, model.Holding{Code: "110022", Shares: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if claimed, err := fileStore.ClaimLegacyPortfolio(2); claimed || err != nil {
+		t.Errorf("❌ 已有持仓的用户不应迁移旧版持仓: %v %v", claimed, err)
+	}
+	if claimed, err := fileStore.ClaimLegacyPortfolio(3); !claimed || err != nil {
+		t.Fatalf("❌ 文件存储迁移旧版持仓失败: %v %v", claimed, err)
+	}
+	if holding, err := fileStore.GetHolding(3, "000001"); err != nil || holding == nil || holding.Shares != 10 {
+		t.Errorf("❌ 迁移后应读取旧版持仓: %+v %v", holding, err)
+	}
+	if claimed, _ := fileStore.ClaimLegacyPortfolio(4); claimed {
+		t.Error("❌ 旧版持仓只应迁移一次")
+	}
+
+	path := filepath.Join(dir, "fund.db")
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, stmt := range []string{
+		`CREATE TABLE holdings (code TEXT PRIMARY KEY, name TEXT NOT NULL, shares REAL NOT NULL, cost REAL NOT NULL,
+			purchase_date TEXT NOT NULL, realized REAL NOT NULL DEFAULT 0, updated_at INTEGER NOT NULL)`,
+		`CREATE TABLE transactions (id INTEGER PRIMARY KEY AUTOINCREMENT, code TEXT NOT NULL, type TEXT NOT NULL,
+			date TEXT NOT NULL, shares REAL NOT NULL, price REAL NOT NULL DEFAULT 0, amount REAL NOT NULL,
+			fee REAL NOT NULL DEFAULT 0, note TEXT NOT NULL DEFAULT '', created_at INTEGER NOT NULL)`,
+		`INSERT INTO holdings VALUES ('110022', '易方达消费行业股票', 100, 500, '2025-06-03', 0, 0)`,
+		`INSERT INTO transactions (code, type, date, shares, amount, created_at) VALUES ('110022', 'buy', '2025-06-03', 100, 500, 0)`,
+	} {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	db.Close()
+
+	store, err := OpenSQLite(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	if holdings, _ := store.ListHoldings(1); len(holdings) != 0 {
+		t.Errorf("❌ 未分配的旧版持仓不应归属用户 1: %+v", holdings)
+	}
+	if claimed, err := store.ClaimLegacyPortfolio(5); !claimed || err != nil {
+		t.Fatalf("❌ SQLite 迁移旧版持仓失败: %v %v", claimed, err)
+	}
+	holdings, err := store.ListHoldings(5)
+	if err != nil || len(holdings) != 1 || holdings[0].Name != "易方达消费行业股票" {
+		t.Errorf("❌ SQLite 旧版持仓迁移异常: %+v %v", holdings, err)
+	}
+	if transactions, _ := store.ListTransactions(5, ""); len(transactions) != 1 {
+		t.Errorf("❌ SQLite 旧版交易记录迁移异常: %+v", transactions)
+	}
+	if claimed, _ := store.ClaimLegacyPortfolio(6); claimed {
+		t.Error("❌ 旧版持仓只应迁移一次")
+	}
+}
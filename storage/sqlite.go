@@ -0,0 +1,1330 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"fund/model"
+	"os"
+	"path/filepath"
+	"time"
+
+	_ "modernc.org/sqlite" // 纯 Go 实现的 SQLite 驱动，无需 CGO
+)
+
+// sqliteSchema 数据库表结构
+const sqliteSchema = `
+CREATE TABLE IF NOT EXISTS funds (
+	code       TEXT PRIMARY KEY,
+	name       TEXT NOT NULL,
+	type       TEXT NOT NULL,
+	updated_at INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS nav_meta (
+	code       TEXT PRIMARY KEY,
+	name       TEXT NOT NULL,
+	updated_at INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS nav_history (
+	code           TEXT NOT NULL,
+	date           TEXT NOT NULL,
+	value          REAL NOT NULL,
+	acc_value      REAL NOT NULL DEFAULT 0,
+	daily_return   REAL NOT NULL DEFAULT 0,
+	dividend_cash  REAL NOT NULL DEFAULT 0,
+	dividend_split REAL NOT NULL DEFAULT 0,
+	dividend_text  TEXT NOT NULL DEFAULT '',
+	PRIMARY KEY (code, date)
+) WITHOUT ROWID;
+
+CREATE TABLE IF NOT EXISTS intraday_points (
+	code      TEXT NOT NULL,
+	date      TEXT NOT NULL,
+	time      TEXT NOT NULL,
+	name      TEXT NOT NULL,
+	value     REAL NOT NULL,
+	rate      REAL NOT NULL,
+	source    TEXT NOT NULL DEFAULT '',
+	deviation REAL NOT NULL DEFAULT 0,
+	divergent INTEGER NOT NULL DEFAULT 0,
+	PRIMARY KEY (code, date, time)
+) WITHOUT ROWID;
+
+CREATE INDEX IF NOT EXISTS idx_intraday_points_date ON intraday_points (date);
+
+CREATE TABLE IF NOT EXISTS holdings (
+	user_id       INTEGER NOT NULL,
+	code          TEXT NOT NULL,
+	name          TEXT NOT NULL,
+	shares        REAL NOT NULL,
+	cost          REAL NOT NULL,
+	purchase_date TEXT NOT NULL,
+	realized      REAL NOT NULL DEFAULT 0,
+	updated_at    INTEGER NOT NULL,
+	PRIMARY KEY (user_id, code)
+) WITHOUT ROWID;
+
+CREATE TABLE IF NOT EXISTS transactions (
+	id         INTEGER PRIMARY KEY AUTOINCREMENT,
+	user_id    INTEGER NOT NULL DEFAULT 1,
+	code       TEXT NOT NULL,
+	type       TEXT NOT NULL,
+	date       TEXT NOT NULL,
+	shares     REAL NOT NULL,
+	price      REAL NOT NULL DEFAULT 0,
+	amount     REAL NOT NULL,
+	fee        REAL NOT NULL DEFAULT 0,
+	note       TEXT NOT NULL DEFAULT '',
+	created_at INTEGER NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_transactions_code ON transactions (code);
+
+CREATE TABLE IF NOT EXISTS users (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	username      TEXT NOT NULL UNIQUE COLLATE NOCASE,
+	password_hash TEXT NOT NULL,
+	admin         INTEGER NOT NULL DEFAULT 0,
+	created_at    INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS api_tokens (
+	id           INTEGER PRIMARY KEY AUTOINCREMENT,
+	user_id      INTEGER NOT NULL,
+	name         TEXT NOT NULL,
+	prefix       TEXT NOT NULL,
+	token_hash   TEXT NOT NULL UNIQUE,
+	created_at   INTEGER NOT NULL,
+	last_used_at INTEGER NOT NULL DEFAULT 0,
+	expires_at   INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_api_tokens_user ON api_tokens (user_id);
+
+CREATE TABLE IF NOT EXISTS watchlists (
+	id         INTEGER PRIMARY KEY AUTOINCREMENT,
+	user_id    INTEGER NOT NULL,
+	name       TEXT NOT NULL,
+	position   INTEGER NOT NULL DEFAULT 0,
+	created_at INTEGER NOT NULL,
+	updated_at INTEGER NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_watchlists_user ON watchlists (user_id);
+
+CREATE TABLE IF NOT EXISTS watchlist_codes (
+	watchlist_id INTEGER NOT NULL,
+	position     INTEGER NOT NULL,
+	code         TEXT NOT NULL,
+	PRIMARY KEY (watchlist_id, position)
+) WITHOUT ROWID;
+
+CREATE TABLE IF NOT EXISTS alert_rules (
+	id         INTEGER PRIMARY KEY AUTOINCREMENT,
+	user_id    INTEGER NOT NULL,
+	code       TEXT NOT NULL,
+	type       TEXT NOT NULL,
+	threshold  REAL NOT NULL,
+	enabled    INTEGER NOT NULL DEFAULT 1,
+	note       TEXT NOT NULL DEFAULT '',
+	created_at INTEGER NOT NULL,
+	updated_at INTEGER NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_alert_rules_user ON alert_rules (user_id);
+
+CREATE TABLE IF NOT EXISTS alerts (
+	id         INTEGER PRIMARY KEY AUTOINCREMENT,
+	rule_id    INTEGER NOT NULL,
+	user_id    INTEGER NOT NULL,
+	code       TEXT NOT NULL,
+	name       TEXT NOT NULL,
+	type       TEXT NOT NULL,
+	threshold  REAL NOT NULL,
+	observed   REAL NOT NULL,
+	date       TEXT NOT NULL,
+	time       TEXT NOT NULL,
+	value      REAL NOT NULL,
+	rate       REAL NOT NULL,
+	message    TEXT NOT NULL,
+	created_at INTEGER NOT NULL,
+	UNIQUE (rule_id, date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_alerts_user ON alerts (user_id, date);
+
+CREATE TABLE IF NOT EXISTS notify_channels (
+	id         INTEGER PRIMARY KEY AUTOINCREMENT,
+	user_id    INTEGER NOT NULL,
+	name       TEXT NOT NULL,
+	type       TEXT NOT NULL,
+	url        TEXT NOT NULL DEFAULT '',
+	email      TEXT NOT NULL DEFAULT '',
+	secret     TEXT NOT NULL DEFAULT '',
+	enabled    INTEGER NOT NULL DEFAULT 1,
+	created_at INTEGER NOT NULL,
+	updated_at INTEGER NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_notify_channels_user ON notify_channels (user_id);
+
+CREATE TABLE IF NOT EXISTS notify_deliveries (
+	id           INTEGER PRIMARY KEY AUTOINCREMENT,
+	user_id      INTEGER NOT NULL,
+	channel_id   INTEGER NOT NULL,
+	channel_type TEXT NOT NULL,
+	alert_id     INTEGER NOT NULL,
+	status       TEXT NOT NULL,
+	attempts     INTEGER NOT NULL,
+	error        TEXT NOT NULL DEFAULT '',
+	created_at   INTEGER NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_notify_deliveries_user ON notify_deliveries (user_id);
+`
+
+// sqliteColumns 后续版本新增的列，打开旧数据库时补齐
+var sqliteColumns = []struct {
+	table, column, definition string
+}{
+	{"nav_history", "acc_value", "REAL NOT NULL DEFAULT 0"},
+	{"nav_history", "daily_return", "REAL NOT NULL DEFAULT 0"},
+	{"nav_history", "dividend_cash", "REAL NOT NULL DEFAULT 0"},
+	{"nav_history", "dividend_split", "REAL NOT NULL DEFAULT 0"},
+	{"nav_history", "dividend_text", "TEXT NOT NULL DEFAULT ''"},
+	{"transactions", "user_id", "INTEGER NOT NULL DEFAULT 0"}, // 单用户版本的交易记录归属 LegacyUserID
+}
+
+// SQLiteStore 基于 SQLite 的存储，同时实现 IntradayStore、FundStore、PortfolioStore、UserStore 和 WatchlistStore
+// 数据库文件可直接用 sqlite3 等工具查询
+type SQLiteStore struct {
+	db *sql.DB
+}
+
+// OpenSQLite 打开（或创建）SQLite 数据库
+func OpenSQLite(path string) (*SQLiteStore, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("创建数据库目录失败: %v", err)
+	}
+
+	dsn := "file:" + path + "?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)&_pragma=synchronous(NORMAL)"
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("打开数据库失败: %v", err)
+	}
+	// SQLite 同一时间只允许一个写入者，使用单连接避免 SQLITE_BUSY
+	db.SetMaxOpenConns(1)
+
+	if _, err := db.Exec(sqliteSchema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("初始化数据库表失败: %v", err)
+	}
+	if err := migrateSQLite(db); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("升级数据库表失败: %v", err)
+	}
+
+	return &SQLiteStore{db: db}, nil
+}
+
+// migrateSQLite 为旧数据库补齐新增的列，并升级单用户版本的持仓表
+func migrateSQLite(db *sql.DB) error {
+	if err := migrateHoldings(db); err != nil {
+		return err
+	}
+	for _, c := range sqliteColumns {
+		var exists int
+		err := db.QueryRow(`SELECT COUNT(*) FROM pragma_table_info(?) WHERE name = ?`, c.table, c.column).Scan(&exists)
+		if err != nil {
+			return err
+		}
+		if exists > 0 {
+			continue
+		}
+		if _, err := db.Exec(fmt.Sprintf(`ALTER TABLE %s ADD COLUMN %s %s`, c.table, c.column, c.definition)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateHoldings 单用户版本的持仓表以基金代码为主键，重建为 (user_id, code) 主键，原有持仓归属 LegacyUserID
+func migrateHoldings(db *sql.DB) error {
+	var exists int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM pragma_table_info('holdings') WHERE name = 'user_id'`).Scan(&exists); err != nil {
+		return err
+	}
+	if exists > 0 {
+		return nil
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	for _, stmt := range []string{
+		`ALTER TABLE holdings RENAME TO holdings_legacy`,
+		`CREATE TABLE holdings (
+			user_id       INTEGER NOT NULL,
+			code          TEXT NOT NULL,
+			name          TEXT NOT NULL,
+			shares        REAL NOT NULL,
+			cost          REAL NOT NULL,
+			purchase_date TEXT NOT NULL,
+			realized      REAL NOT NULL DEFAULT 0,
+			updated_at    INTEGER NOT NULL,
+			PRIMARY KEY (user_id, code)
+		) WITHOUT ROWID`,
+		fmt.Sprintf(`INSERT INTO holdings (user_id, code, name, shares, cost, purchase_date, realized, updated_at)
+			SELECT %d, code, name, shares, cost, purchase_date, realized, updated_at FROM holdings_legacy`, LegacyUserID),
+		`DROP TABLE holdings_legacy`,
+	} {
+		if _, err := tx.Exec(stmt); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// SaveFundList 保存全量基金列表
+func (s *SQLiteStore) SaveFundList(funds []model.FundBasicInfo, updatedAt time.Time) error {
+	return s.withTx(func(tx *sql.Tx) error {
+		if _, err := tx.Exec(`DELETE FROM funds`); err != nil {
+			return err
+		}
+		stmt, err := tx.Prepare(`INSERT OR REPLACE INTO funds (code, name, type, updated_at) VALUES (?, ?, ?, ?)`)
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+
+		for _, fund := range funds {
+			if _, err := stmt.Exec(fund.Code, fund.Name, fund.Type, updatedAt.Unix()); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// LoadFundList 读取基金列表
+func (s *SQLiteStore) LoadFundList() ([]model.FundBasicInfo, time.Time, error) {
+	rows, err := s.db.Query(`SELECT code, name, type, updated_at FROM funds ORDER BY code`)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("查询基金列表失败: %v", err)
+	}
+	defer rows.Close()
+
+	funds := []model.FundBasicInfo{}
+	var updatedAt int64
+	for rows.Next() {
+		var fund model.FundBasicInfo
+		var fundUpdatedAt int64
+		if err := rows.Scan(&fund.Code, &fund.Name, &fund.Type, &fundUpdatedAt); err != nil {
+			return nil, time.Time{}, fmt.Errorf("读取基金列表失败: %v", err)
+		}
+		funds = append(funds, fund)
+		if fundUpdatedAt > updatedAt {
+			updatedAt = fundUpdatedAt
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, time.Time{}, fmt.Errorf("读取基金列表失败: %v", err)
+	}
+
+	if len(funds) == 0 {
+		return funds, time.Time{}, nil
+	}
+	return funds, time.Unix(updatedAt, 0), nil
+}
+
+// SaveNAVHistory 保存基金历史净值
+func (s *SQLiteStore) SaveNAVHistory(trend *model.FundTrend, updatedAt time.Time) error {
+	return s.withTx(func(tx *sql.Tx) error {
+		if _, err := tx.Exec(`INSERT OR REPLACE INTO nav_meta (code, name, updated_at) VALUES (?, ?, ?)`,
+			trend.Code, trend.Name, updatedAt.Unix()); err != nil {
+			return err
+		}
+		stmt, err := tx.Prepare(`INSERT OR REPLACE INTO nav_history
+			(code, date, value, acc_value, daily_return, dividend_cash, dividend_split, dividend_text)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)`)
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+
+		for _, point := range trend.Data {
+			var dividend model.Dividend
+			if point.Dividend != nil {
+				dividend = *point.Dividend
+			}
+			if _, err := stmt.Exec(trend.Code, point.Date, point.Value, point.AccValue, point.DailyReturn,
+				dividend.Cash, dividend.Split, dividend.Text); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// LoadNAVHistory 读取基金历史净值
+func (s *SQLiteStore) LoadNAVHistory(code string) (*model.FundTrend, time.Time, error) {
+	trend := &model.FundTrend{Code: code, Period: "all", Data: []model.TrendPoint{}}
+	var updatedAt int64
+	err := s.db.QueryRow(`SELECT name, updated_at FROM nav_meta WHERE code = ?`, code).Scan(&trend.Name, &updatedAt)
+	if err == sql.ErrNoRows {
+		return nil, time.Time{}, nil
+	}
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("查询历史净值失败: %v", err)
+	}
+
+	rows, err := s.db.Query(`SELECT date, value, acc_value, daily_return, dividend_cash, dividend_split, dividend_text
+		FROM nav_history WHERE code = ? ORDER BY date`, code)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("查询历史净值失败: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var point model.TrendPoint
+		var dividend model.Dividend
+		if err := rows.Scan(&point.Date, &point.Value, &point.AccValue, &point.DailyReturn,
+			&dividend.Cash, &dividend.Split, &dividend.Text); err != nil {
+			return nil, time.Time{}, fmt.Errorf("读取历史净值失败: %v", err)
+		}
+		if dividend.Text != "" {
+			point.Dividend = &dividend
+		}
+		trend.Data = append(trend.Data, point)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, time.Time{}, fmt.Errorf("读取历史净值失败: %v", err)
+	}
+
+	return trend, time.Unix(updatedAt, 0), nil
+}
+
+// Append 追加写入日内数据点
+func (s *SQLiteStore) Append(records ...Record) error {
+	if len(records) == 0 {
+		return nil
+	}
+	for _, record := range records {
+		if !validRecord(record) {
+			return fmt.Errorf("非法的日内数据记录: 基金 %q 日期 %q", record.Code, record.Date)
+		}
+	}
+
+	return s.withTx(func(tx *sql.Tx) error {
+		stmt, err := tx.Prepare(`INSERT OR REPLACE INTO intraday_points
+			(code, date, time, name, value, rate, source, deviation, divergent)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`)
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+
+		for _, record := range records {
+			p := record.Point
+			if _, err := stmt.Exec(record.Code, record.Date, p.Time, record.Name,
+				p.Value, p.Rate, p.Source, p.Deviation, p.Divergent); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Day 读取指定基金某个交易日的日内数据
+func (s *SQLiteStore) Day(code, date string) (*model.FundIntradayData, error) {
+	rows, err := s.db.Query(`SELECT name, time, value, rate, source, deviation, divergent
+		FROM intraday_points WHERE code = ? AND date = ? ORDER BY time`, code, date)
+	if err != nil {
+		return nil, fmt.Errorf("查询日内数据失败: %v", err)
+	}
+	defer rows.Close()
+
+	var fundData *model.FundIntradayData
+	for rows.Next() {
+		var name string
+		var p model.IntradayPoint
+		if err := rows.Scan(&name, &p.Time, &p.Value, &p.Rate, &p.Source, &p.Deviation, &p.Divergent); err != nil {
+			return nil, fmt.Errorf("读取日内数据失败: %v", err)
+		}
+		if fundData == nil {
+			fundData = &model.FundIntradayData{Code: code, Date: date, Data: []model.IntradayPoint{}}
+		}
+		if name != "" {
+			fundData.Name = name
+		}
+		fundData.Data = append(fundData.Data, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("读取日内数据失败: %v", err)
+	}
+
+	return fundData, nil
+}
+
+// Latest 读取最近一个交易日的日内数据
+func (s *SQLiteStore) Latest() (map[string]*model.FundIntradayData, error) {
+	result := make(map[string]*model.FundIntradayData)
+
+	var lastDate sql.NullString
+	if err := s.db.QueryRow(`SELECT MAX(date) FROM intraday_points`).Scan(&lastDate); err != nil {
+		return nil, fmt.Errorf("查询日内数据失败: %v", err)
+	}
+	if !lastDate.Valid {
+		return result, nil
+	}
+
+	rows, err := s.db.Query(`SELECT DISTINCT code FROM intraday_points WHERE date = ?`, lastDate.String)
+	if err != nil {
+		return nil, fmt.Errorf("查询日内数据失败: %v", err)
+	}
+	var codes []string
+	for rows.Next() {
+		var code string
+		if err := rows.Scan(&code); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("读取日内数据失败: %v", err)
+		}
+		codes = append(codes, code)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("读取日内数据失败: %v", err)
+	}
+
+	for _, code := range codes {
+		fundData, err := s.Day(code, lastDate.String)
+		if err != nil {
+			return nil, err
+		}
+		if fundData != nil {
+			result[code] = fundData
+		}
+	}
+	return result, nil
+}
+
+// Dates 已有日内数据的交易日（升序）
+func (s *SQLiteStore) Dates() ([]string, error) {
+	rows, err := s.db.Query(`SELECT DISTINCT date FROM intraday_points ORDER BY date`)
+	if err != nil {
+		return nil, fmt.Errorf("查询交易日失败: %v", err)
+	}
+	defer rows.Close()
+
+	dates := []string{}
+	for rows.Next() {
+		var date string
+		if err := rows.Scan(&date); err != nil {
+			return nil, fmt.Errorf("读取交易日失败: %v", err)
+		}
+		dates = append(dates, date)
+	}
+	return dates, rows.Err()
+}
+
+// Sync 每次写入都已提交事务，无需额外同步
+func (s *SQLiteStore) Sync() error {
+	return nil
+}
+
+// Compact 将 SQLite 预写日志合并回数据库文件
+func (s *SQLiteStore) Compact() error {
+	if _, err := s.db.Exec(`PRAGMA wal_checkpoint(TRUNCATE)`); err != nil {
+		return fmt.Errorf("合并数据库日志失败: %v", err)
+	}
+	return nil
+}
+
+// Prune 删除早于 before 的日内数据
+func (s *SQLiteStore) Prune(before string) error {
+	if _, err := s.db.Exec(`DELETE FROM intraday_points WHERE date < ?`, before); err != nil {
+		return fmt.Errorf("删除过期日内数据失败: %v", err)
+	}
+	return nil
+}
+
+// ListHoldings 读取全部持仓
+func (s *SQLiteStore) ListHoldings(userID int64) ([]model.Holding, error) {
+	rows, err := s.db.Query(`SELECT code, name, shares, cost, purchase_date, realized, updated_at
+		FROM holdings WHERE user_id = ? ORDER BY code`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("查询持仓失败: %v", err)
+	}
+	defer rows.Close()
+
+	holdings := []model.Holding{}
+	for rows.Next() {
+		holding, err := scanHolding(rows)
+		if err != nil {
+			return nil, fmt.Errorf("读取持仓失败: %v", err)
+		}
+		holdings = append(holdings, *holding)
+	}
+	return holdings, rows.Err()
+}
+
+// GetHolding 读取单只基金的持仓
+func (s *SQLiteStore) GetHolding(userID int64, code string) (*model.Holding, error) {
+	row := s.db.QueryRow(`SELECT code, name, shares, cost, purchase_date, realized, updated_at
+		FROM holdings WHERE user_id = ? AND code = ?`, userID, code)
+	holding, err := scanHolding(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("读取持仓失败: %v", err)
+	}
+	return holding, nil
+}
+
+// SaveHolding 新增或覆盖持仓
+func (s *SQLiteStore) SaveHolding(userID int64, holding model.Holding) error {
+	return s.withTx(func(tx *sql.Tx) error {
+		return saveHolding(tx, userID, holding)
+	})
+}
+
+// DeleteHolding 删除持仓及其交易记录
+func (s *SQLiteStore) DeleteHolding(userID int64, code string) error {
+	return s.withTx(func(tx *sql.Tx) error {
+		if _, err := tx.Exec(`DELETE FROM holdings WHERE user_id = ? AND code = ?`, userID, code); err != nil {
+			return err
+		}
+		_, err := tx.Exec(`DELETE FROM transactions WHERE user_id = ? AND code = ?`, userID, code)
+		return err
+	})
+}
+
+// RecordTransaction 追加交易记录并保存持仓
+func (s *SQLiteStore) RecordTransaction(userID int64, record model.Transaction, holding model.Holding) (model.Transaction, error) {
+	if record.Code != holding.Code {
+		return record, fmt.Errorf("交易记录与持仓的基金代码不一致: %s/%s", record.Code, holding.Code)
+	}
+	err := s.withTx(func(tx *sql.Tx) error {
+		result, err := tx.Exec(`INSERT INTO transactions (user_id, code, type, date, shares, price, amount, fee, note, created_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			userID, record.Code, record.Type, record.Date, record.Shares, record.Price, record.Amount, record.Fee,
+			record.Note, record.CreatedAt.Unix())
+		if err != nil {
+			return err
+		}
+		if record.ID, err = result.LastInsertId(); err != nil {
+			return err
+		}
+		return saveHolding(tx, userID, holding)
+	})
+	return record, err
+}
+
+// ListTransactions 读取交易记录
+func (s *SQLiteStore) ListTransactions(userID int64, code string) ([]model.Transaction, error) {
+	rows, err := s.db.Query(`SELECT id, code, type, date, shares, price, amount, fee, note, created_at
+		FROM transactions WHERE user_id = ? AND (? = '' OR code = ?) ORDER BY id`, userID, code, code)
+	if err != nil {
+		return nil, fmt.Errorf("查询交易记录失败: %v", err)
+	}
+	defer rows.Close()
+
+	transactions := []model.Transaction{}
+	for rows.Next() {
+		var record model.Transaction
+		var createdAt int64
+		if err := rows.Scan(&record.ID, &record.Code, &record.Type, &record.Date, &record.Shares, &record.Price,
+			&record.Amount, &record.Fee, &record.Note, &createdAt); err != nil {
+			return nil, fmt.Errorf("读取交易记录失败: %v", err)
+		}
+		record.CreatedAt = time.Unix(createdAt, 0)
+		transactions = append(transactions, record)
+	}
+	return transactions, rows.Err()
+}
+
+// ClaimLegacyPortfolio 将归属 LegacyUserID 的持仓和交易记录分配给用户
+func (s *SQLiteStore) ClaimLegacyPortfolio(userID int64) (bool, error) {
+	claimed := false
+	err := s.withTx(func(tx *sql.Tx) error {
+		var legacy, owned int
+		if err := tx.QueryRow(`SELECT (SELECT COUNT(*) FROM holdings WHERE user_id = ?) + (SELECT COUNT(*) FROM transactions WHERE user_id = ?)`,
+			LegacyUserID, LegacyUserID).Scan(&legacy); err != nil {
+			return err
+		}
+		if err := tx.QueryRow(`SELECT (SELECT COUNT(*) FROM holdings WHERE user_id = ?) + (SELECT COUNT(*) FROM transactions WHERE user_id = ?)`,
+			userID, userID).Scan(&owned); err != nil {
+			return err
+		}
+		if legacy == 0 || owned > 0 {
+			return nil
+		}
+		for _, table := range []string{"holdings", "transactions"} {
+			if _, err := tx.Exec(`UPDATE `+table+` SET user_id = ? WHERE user_id = ?`, userID, LegacyUserID); err != nil {
+				return err
+			}
+		}
+		claimed = true
+		return nil
+	})
+	return claimed, err
+}
+
+// scanHolding 读取一行持仓
+func scanHolding(row interface {
+	Scan(dest ...interface{}) error
+}) (*model.Holding, error) {
+	var holding model.Holding
+	var updatedAt int64
+	if err := row.Scan(&holding.Code, &holding.Name, &holding.Shares, &holding.Cost, &holding.PurchaseDate,
+		&holding.Realized, &updatedAt); err != nil {
+		return nil, err
+	}
+	holding.UpdatedAt = time.Unix(updatedAt, 0)
+	return &holding, nil
+}
+
+// saveHolding 在事务中新增或覆盖持仓
+func saveHolding(tx *sql.Tx, userID int64, holding model.Holding) error {
+	_, err := tx.Exec(`INSERT OR REPLACE INTO holdings (user_id, code, name, shares, cost, purchase_date, realized, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		userID, holding.Code, holding.Name, holding.Shares, holding.Cost, holding.PurchaseDate, holding.Realized,
+		holding.UpdatedAt.Unix())
+	return err
+}
+
+// CreateUser 创建用户
+func (s *SQLiteStore) CreateUser(user model.User) (model.User, error) {
+	var exists int
+	if err := s.db.QueryRow(`SELECT COUNT(*) FROM users WHERE username = ?`, user.Username).Scan(&exists); err != nil {
+		return user, fmt.Errorf("查询用户失败: %v", err)
+	}
+	if exists > 0 {
+		return user, ErrUserExists
+	}
+	result, err := s.db.Exec(`INSERT INTO users (username, password_hash, admin, created_at) VALUES (?, ?, ?, ?)`,
+		user.Username, user.PasswordHash, user.Admin, user.CreatedAt.Unix())
+	if err != nil {
+		return user, fmt.Errorf("创建用户失败: %v", err)
+	}
+	if user.ID, err = result.LastInsertId(); err != nil {
+		return user, fmt.Errorf("创建用户失败: %v", err)
+	}
+	return user, nil
+}
+
+// CountUsers 用户数量
+func (s *SQLiteStore) CountUsers() (int, error) {
+	var count int
+	if err := s.db.QueryRow(`SELECT COUNT(*) FROM users`).Scan(&count); err != nil {
+		return 0, fmt.Errorf("查询用户失败: %v", err)
+	}
+	return count, nil
+}
+
+// GetUser 按 ID 读取用户
+func (s *SQLiteStore) GetUser(id int64) (*model.User, error) {
+	return s.queryUser(`WHERE id = ?`, id)
+}
+
+// GetUserByName 按用户名读取用户
+func (s *SQLiteStore) GetUserByName(username string) (*model.User, error) {
+	return s.queryUser(`WHERE username = ?`, username)
+}
+
+// queryUser 按条件读取一个用户
+func (s *SQLiteStore) queryUser(where string, args ...interface{}) (*model.User, error) {
+	var user model.User
+	var createdAt int64
+	err := s.db.QueryRow(`SELECT id, username, password_hash, admin, created_at FROM users `+where, args...).
+		Scan(&user.ID, &user.Username, &user.PasswordHash, &user.Admin, &createdAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("读取用户失败: %v", err)
+	}
+	user.CreatedAt = time.Unix(createdAt, 0)
+	return &user, nil
+}
+
+// CreateToken 保存令牌
+func (s *SQLiteStore) CreateToken(token model.APIToken) (model.APIToken, error) {
+	result, err := s.db.Exec(`INSERT INTO api_tokens (user_id, name, prefix, token_hash, created_at, expires_at)
+		VALUES (?, ?, ?, ?, ?, ?)`,
+		token.UserID, token.Name, token.Prefix, token.TokenHash, token.CreatedAt.Unix(), unixOrZero(token.ExpiresAt))
+	if err != nil {
+		return token, fmt.Errorf("保存令牌失败: %v", err)
+	}
+	if token.ID, err = result.LastInsertId(); err != nil {
+		return token, fmt.Errorf("保存令牌失败: %v", err)
+	}
+	return token, nil
+}
+
+// GetTokenByHash 按令牌哈希读取令牌
+func (s *SQLiteStore) GetTokenByHash(hash string) (*model.APIToken, error) {
+	row := s.db.QueryRow(`SELECT id, user_id, name, prefix, token_hash, created_at, last_used_at, expires_at
+		FROM api_tokens WHERE token_hash = ?`, hash)
+	token, err := scanToken(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("读取令牌失败: %v", err)
+	}
+	return token, nil
+}
+
+// ListTokens 读取用户的全部令牌
+func (s *SQLiteStore) ListTokens(userID int64) ([]model.APIToken, error) {
+	rows, err := s.db.Query(`SELECT id, user_id, name, prefix, token_hash, created_at, last_used_at, expires_at
+		FROM api_tokens WHERE user_id = ? ORDER BY id`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("查询令牌失败: %v", err)
+	}
+	defer rows.Close()
+
+	tokens := []model.APIToken{}
+	for rows.Next() {
+		token, err := scanToken(rows)
+		if err != nil {
+			return nil, fmt.Errorf("读取令牌失败: %v", err)
+		}
+		tokens = append(tokens, *token)
+	}
+	return tokens, rows.Err()
+}
+
+// DeleteToken 删除用户的令牌
+func (s *SQLiteStore) DeleteToken(userID, id int64) (bool, error) {
+	result, err := s.db.Exec(`DELETE FROM api_tokens WHERE id = ? AND user_id = ?`, id, userID)
+	if err != nil {
+		return false, fmt.Errorf("删除令牌失败: %v", err)
+	}
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
+
+// TouchToken 更新令牌的最近使用时间
+func (s *SQLiteStore) TouchToken(id int64, usedAt time.Time) error {
+	if _, err := s.db.Exec(`UPDATE api_tokens SET last_used_at = ? WHERE id = ?`, usedAt.Unix(), id); err != nil {
+		return fmt.Errorf("更新令牌失败: %v", err)
+	}
+	return nil
+}
+
+// DeleteExpiredTokens 删除用户已过期的令牌（expires_at 为 0 表示长期有效）
+func (s *SQLiteStore) DeleteExpiredTokens(userID int64, now time.Time) (int, error) {
+	result, err := s.db.Exec(`DELETE FROM api_tokens WHERE user_id = ? AND expires_at > 0 AND expires_at < ?`, userID, now.Unix())
+	if err != nil {
+		return 0, fmt.Errorf("删除过期令牌失败: %v", err)
+	}
+	affected, err := result.RowsAffected()
+	return int(affected), err
+}
+
+// scanToken 读取一行令牌
+func scanToken(row interface {
+	Scan(dest ...interface{}) error
+}) (*model.APIToken, error) {
+	var token model.APIToken
+	var createdAt, lastUsedAt, expiresAt int64
+	if err := row.Scan(&token.ID, &token.UserID, &token.Name, &token.Prefix, &token.TokenHash,
+		&createdAt, &lastUsedAt, &expiresAt); err != nil {
+		return nil, err
+	}
+	token.CreatedAt = time.Unix(createdAt, 0)
+	token.LastUsedAt = timeOrNil(lastUsedAt)
+	token.ExpiresAt = timeOrNil(expiresAt)
+	return &token, nil
+}
+
+// ListWatchlists 读取用户的全部分组
+func (s *SQLiteStore) ListWatchlists(userID int64) ([]model.Watchlist, error) {
+	rows, err := s.db.Query(`SELECT id, name, position, created_at, updated_at
+		FROM watchlists WHERE user_id = ? ORDER BY position, id`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("查询自选分组失败: %v", err)
+	}
+	watchlists := []model.Watchlist{}
+	for rows.Next() {
+		watchlist, err := scanWatchlist(rows)
+		if err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("读取自选分组失败: %v", err)
+		}
+		watchlists = append(watchlists, *watchlist)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// 单连接下需先关闭上一个查询再读取基金代码
+	for i := range watchlists {
+		if watchlists[i].Codes, err = s.watchlistCodes(watchlists[i].ID); err != nil {
+			return nil, err
+		}
+	}
+	return watchlists, nil
+}
+
+// GetWatchlist 读取用户的分组
+func (s *SQLiteStore) GetWatchlist(userID, id int64) (*model.Watchlist, error) {
+	row := s.db.QueryRow(`SELECT id, name, position, created_at, updated_at
+		FROM watchlists WHERE user_id = ? AND id = ?`, userID, id)
+	watchlist, err := scanWatchlist(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("读取自选分组失败: %v", err)
+	}
+	if watchlist.Codes, err = s.watchlistCodes(id); err != nil {
+		return nil, err
+	}
+	return watchlist, nil
+}
+
+// SaveWatchlist 新增或覆盖分组
+func (s *SQLiteStore) SaveWatchlist(userID int64, watchlist model.Watchlist) (model.Watchlist, error) {
+	err := s.withTx(func(tx *sql.Tx) error {
+		if watchlist.ID == 0 {
+			result, err := tx.Exec(`INSERT INTO watchlists (user_id, name, position, created_at, updated_at)
+				VALUES (?, ?, ?, ?, ?)`,
+				userID, watchlist.Name, watchlist.Position, watchlist.CreatedAt.Unix(), watchlist.UpdatedAt.Unix())
+			if err != nil {
+				return err
+			}
+			if watchlist.ID, err = result.LastInsertId(); err != nil {
+				return err
+			}
+		} else {
+			result, err := tx.Exec(`UPDATE watchlists SET name = ?, position = ?, updated_at = ? WHERE id = ? AND user_id = ?`,
+				watchlist.Name, watchlist.Position, watchlist.UpdatedAt.Unix(), watchlist.ID, userID)
+			if err != nil {
+				return err
+			}
+			if affected, err := result.RowsAffected(); err != nil || affected == 0 {
+				return fmt.Errorf("自选分组 %d 不存在", watchlist.ID)
+			}
+			if _, err := tx.Exec(`DELETE FROM watchlist_codes WHERE watchlist_id = ?`, watchlist.ID); err != nil {
+				return err
+			}
+		}
+
+		stmt, err := tx.Prepare(`INSERT INTO watchlist_codes (watchlist_id, position, code) VALUES (?, ?, ?)`)
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+		for i, code := range watchlist.Codes {
+			if _, err := stmt.Exec(watchlist.ID, i, code); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return watchlist, err
+}
+
+// DeleteWatchlist 删除用户的分组
+func (s *SQLiteStore) DeleteWatchlist(userID, id int64) (bool, error) {
+	deleted := false
+	err := s.withTx(func(tx *sql.Tx) error {
+		result, err := tx.Exec(`DELETE FROM watchlists WHERE user_id = ? AND id = ?`, userID, id)
+		if err != nil {
+			return err
+		}
+		affected, err := result.RowsAffected()
+		if err != nil || affected == 0 {
+			return err
+		}
+		deleted = true
+		_, err = tx.Exec(`DELETE FROM watchlist_codes WHERE watchlist_id = ?`, id)
+		return err
+	})
+	return deleted, err
+}
+
+// AllWatchCodes 所有用户分组中基金代码的并集
+func (s *SQLiteStore) AllWatchCodes() ([]string, error) {
+	rows, err := s.db.Query(`SELECT DISTINCT code FROM watchlist_codes ORDER BY code`)
+	if err != nil {
+		return nil, fmt.Errorf("查询自选基金失败: %v", err)
+	}
+	defer rows.Close()
+
+	codes := []string{}
+	for rows.Next() {
+		var code string
+		if err := rows.Scan(&code); err != nil {
+			return nil, fmt.Errorf("读取自选基金失败: %v", err)
+		}
+		codes = append(codes, code)
+	}
+	return codes, rows.Err()
+}
+
+// watchlistCodes 读取分组中的基金代码（按位置升序）
+func (s *SQLiteStore) watchlistCodes(id int64) ([]string, error) {
+	rows, err := s.db.Query(`SELECT code FROM watchlist_codes WHERE watchlist_id = ? ORDER BY position`, id)
+	if err != nil {
+		return nil, fmt.Errorf("查询自选基金失败: %v", err)
+	}
+	defer rows.Close()
+
+	codes := []string{}
+	for rows.Next() {
+		var code string
+		if err := rows.Scan(&code); err != nil {
+			return nil, fmt.Errorf("读取自选基金失败: %v", err)
+		}
+		codes = append(codes, code)
+	}
+	return codes, rows.Err()
+}
+
+// scanWatchlist 读取一行分组（不含基金代码）
+func scanWatchlist(row interface {
+	Scan(dest ...interface{}) error
+}) (*model.Watchlist, error) {
+	var watchlist model.Watchlist
+	var createdAt, updatedAt int64
+	if err := row.Scan(&watchlist.ID, &watchlist.Name, &watchlist.Position, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+	watchlist.CreatedAt = time.Unix(createdAt, 0)
+	watchlist.UpdatedAt = time.Unix(updatedAt, 0)
+	return &watchlist, nil
+}
+
+// alertRuleColumns 提醒规则的查询列
+const alertRuleColumns = `id, user_id, code, type, threshold, enabled, note, created_at, updated_at`
+
+// ListAlertRules 读取用户的全部规则
+func (s *SQLiteStore) ListAlertRules(userID int64) ([]model.AlertRule, error) {
+	return s.queryAlertRules(`SELECT `+alertRuleColumns+` FROM alert_rules WHERE user_id = ? ORDER BY id`, userID)
+}
+
+// GetAlertRule 读取用户的规则
+func (s *SQLiteStore) GetAlertRule(userID, id int64) (*model.AlertRule, error) {
+	row := s.db.QueryRow(`SELECT `+alertRuleColumns+` FROM alert_rules WHERE user_id = ? AND id = ?`, userID, id)
+	rule, err := scanAlertRule(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("读取提醒规则失败: %v", err)
+	}
+	return rule, nil
+}
+
+// SaveAlertRule 新增或覆盖规则
+func (s *SQLiteStore) SaveAlertRule(rule model.AlertRule) (model.AlertRule, error) {
+	if rule.ID == 0 {
+		result, err := s.db.Exec(`INSERT INTO alert_rules (user_id, code, type, threshold, enabled, note, created_at, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+			rule.UserID, rule.Code, rule.Type, rule.Threshold, rule.Enabled, rule.Note, rule.CreatedAt.Unix(), rule.UpdatedAt.Unix())
+		if err != nil {
+			return rule, fmt.Errorf("保存提醒规则失败: %v", err)
+		}
+		if rule.ID, err = result.LastInsertId(); err != nil {
+			return rule, fmt.Errorf("保存提醒规则失败: %v", err)
+		}
+		return rule, nil
+	}
+
+	result, err := s.db.Exec(`UPDATE alert_rules SET code = ?, type = ?, threshold = ?, enabled = ?, note = ?, updated_at = ?
+		WHERE id = ? AND user_id = ?`,
+		rule.Code, rule.Type, rule.Threshold, rule.Enabled, rule.Note, rule.UpdatedAt.Unix(), rule.ID, rule.UserID)
+	if err != nil {
+		return rule, fmt.Errorf("保存提醒规则失败: %v", err)
+	}
+	if affected, err := result.RowsAffected(); err != nil || affected == 0 {
+		return rule, fmt.Errorf("提醒规则 %d 不存在", rule.ID)
+	}
+	return rule, nil
+}
+
+// DeleteAlertRule 删除用户的规则
+func (s *SQLiteStore) DeleteAlertRule(userID, id int64) (bool, error) {
+	result, err := s.db.Exec(`DELETE FROM alert_rules WHERE id = ? AND user_id = ?`, id, userID)
+	if err != nil {
+		return false, fmt.Errorf("删除提醒规则失败: %v", err)
+	}
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
+
+// EnabledAlertRules 所有用户已启用的规则
+func (s *SQLiteStore) EnabledAlertRules() ([]model.AlertRule, error) {
+	return s.queryAlertRules(`SELECT ` + alertRuleColumns + ` FROM alert_rules WHERE enabled = 1 ORDER BY id`)
+}
+
+// queryAlertRules 查询提醒规则列表
+func (s *SQLiteStore) queryAlertRules(query string, args ...interface{}) ([]model.AlertRule, error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("查询提醒规则失败: %v", err)
+	}
+	defer rows.Close()
+
+	rules := []model.AlertRule{}
+	for rows.Next() {
+		rule, err := scanAlertRule(rows)
+		if err != nil {
+			return nil, fmt.Errorf("读取提醒规则失败: %v", err)
+		}
+		rules = append(rules, *rule)
+	}
+	return rules, rows.Err()
+}
+
+// scanAlertRule 读取一行提醒规则
+func scanAlertRule(row interface {
+	Scan(dest ...interface{}) error
+}) (*model.AlertRule, error) {
+	var rule model.AlertRule
+	var createdAt, updatedAt int64
+	if err := row.Scan(&rule.ID, &rule.UserID, &rule.Code, &rule.Type, &rule.Threshold, &rule.Enabled, &rule.Note,
+		&createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+	rule.CreatedAt = time.Unix(createdAt, 0)
+	rule.UpdatedAt = time.Unix(updatedAt, 0)
+	return &rule, nil
+}
+
+// RecordAlert 保存触发的提醒，同一规则同一交易日只保存一次
+func (s *SQLiteStore) RecordAlert(alert model.Alert) (model.Alert, bool, error) {
+	result, err := s.db.Exec(`INSERT OR IGNORE INTO alerts
+		(rule_id, user_id, code, name, type, threshold, observed, date, time, value, rate, message, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		alert.RuleID, alert.UserID, alert.Code, alert.Name, alert.Type, alert.Threshold, alert.Observed,
+		alert.Date, alert.Time, alert.Value, alert.Rate, alert.Message, alert.CreatedAt.Unix())
+	if err != nil {
+		return alert, false, fmt.Errorf("保存提醒失败: %v", err)
+	}
+	if affected, err := result.RowsAffected(); err != nil || affected == 0 {
+		return alert, false, err
+	}
+	if alert.ID, err = result.LastInsertId(); err != nil {
+		return alert, false, fmt.Errorf("保存提醒失败: %v", err)
+	}
+	return alert, true, nil
+}
+
+// ListAlerts 读取用户的提醒（最新的在前）
+func (s *SQLiteStore) ListAlerts(userID int64, date string, limit int) ([]model.Alert, error) {
+	query := `SELECT id, rule_id, user_id, code, name, type, threshold, observed, date, time, value, rate, message, created_at
+		FROM alerts WHERE user_id = ?`
+	args := []interface{}{userID}
+	if date != "" {
+		query += ` AND date = ?`
+		args = append(args, date)
+	}
+	query += ` ORDER BY id DESC`
+	if limit > 0 {
+		query += ` LIMIT ?`
+		args = append(args, limit)
+	}
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("查询提醒失败: %v", err)
+	}
+	defer rows.Close()
+
+	alerts := []model.Alert{}
+	for rows.Next() {
+		var alert model.Alert
+		var createdAt int64
+		if err := rows.Scan(&alert.ID, &alert.RuleID, &alert.UserID, &alert.Code, &alert.Name, &alert.Type,
+			&alert.Threshold, &alert.Observed, &alert.Date, &alert.Time, &alert.Value, &alert.Rate, &alert.Message,
+			&createdAt); err != nil {
+			return nil, fmt.Errorf("读取提醒失败: %v", err)
+		}
+		alert.CreatedAt = time.Unix(createdAt, 0)
+		alerts = append(alerts, alert)
+	}
+	return alerts, rows.Err()
+}
+
+// channelColumns 通知渠道的查询列
+const channelColumns = `id, user_id, name, type, url, email, secret, enabled, created_at, updated_at`
+
+// ListChannels 读取用户的全部通知渠道
+func (s *SQLiteStore) ListChannels(userID int64) ([]model.NotifyChannel, error) {
+	rows, err := s.db.Query(`SELECT `+channelColumns+` FROM notify_channels WHERE user_id = ? ORDER BY id`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("查询通知渠道失败: %v", err)
+	}
+	defer rows.Close()
+
+	channels := []model.NotifyChannel{}
+	for rows.Next() {
+		channel, err := scanChannel(rows)
+		if err != nil {
+			return nil, fmt.Errorf("读取通知渠道失败: %v", err)
+		}
+		channels = append(channels, *channel)
+	}
+	return channels, rows.Err()
+}
+
+// GetChannel 读取用户的通知渠道
+func (s *SQLiteStore) GetChannel(userID, id int64) (*model.NotifyChannel, error) {
+	row := s.db.QueryRow(`SELECT `+channelColumns+` FROM notify_channels WHERE user_id = ? AND id = ?`, userID, id)
+	channel, err := scanChannel(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("读取通知渠道失败: %v", err)
+	}
+	return channel, nil
+}
+
+// SaveChannel 新增或覆盖通知渠道
+func (s *SQLiteStore) SaveChannel(channel model.NotifyChannel) (model.NotifyChannel, error) {
+	if channel.ID == 0 {
+		result, err := s.db.Exec(`INSERT INTO notify_channels (user_id, name, type, url, email, secret, enabled, created_at, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			channel.UserID, channel.Name, channel.Type, channel.URL, channel.Email, channel.Secret, channel.Enabled,
+			channel.CreatedAt.Unix(), channel.UpdatedAt.Unix())
+		if err != nil {
+			return channel, fmt.Errorf("保存通知渠道失败: %v", err)
+		}
+		if channel.ID, err = result.LastInsertId(); err != nil {
+			return channel, fmt.Errorf("保存通知渠道失败: %v", err)
+		}
+		return channel, nil
+	}
+
+	result, err := s.db.Exec(`UPDATE notify_channels SET name = ?, type = ?, url = ?, email = ?, secret = ?, enabled = ?, updated_at = ?
+		WHERE id = ? AND user_id = ?`,
+		channel.Name, channel.Type, channel.URL, channel.Email, channel.Secret, channel.Enabled, channel.UpdatedAt.Unix(),
+		channel.ID, channel.UserID)
+	if err != nil {
+		return channel, fmt.Errorf("保存通知渠道失败: %v", err)
+	}
+	if affected, err := result.RowsAffected(); err != nil || affected == 0 {
+		return channel, fmt.Errorf("通知渠道 %d 不存在", channel.ID)
+	}
+	return channel, nil
+}
+
+// DeleteChannel 删除用户的通知渠道
+func (s *SQLiteStore) DeleteChannel(userID, id int64) (bool, error) {
+	result, err := s.db.Exec(`DELETE FROM notify_channels WHERE id = ? AND user_id = ?`, id, userID)
+	if err != nil {
+		return false, fmt.Errorf("删除通知渠道失败: %v", err)
+	}
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
+
+// scanChannel 读取一行通知渠道
+func scanChannel(row interface {
+	Scan(dest ...interface{}) error
+}) (*model.NotifyChannel, error) {
+	var channel model.NotifyChannel
+	var createdAt, updatedAt int64
+	if err := row.Scan(&channel.ID, &channel.UserID, &channel.Name, &channel.Type, &channel.URL, &channel.Email,
+		&channel.Secret, &channel.Enabled, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+	channel.CreatedAt = time.Unix(createdAt, 0)
+	channel.UpdatedAt = time.Unix(updatedAt, 0)
+	return &channel, nil
+}
+
+// RecordDelivery 保存投递记录
+func (s *SQLiteStore) RecordDelivery(delivery model.NotifyDelivery) (model.NotifyDelivery, error) {
+	result, err := s.db.Exec(`INSERT INTO notify_deliveries
+		(user_id, channel_id, channel_type, alert_id, status, attempts, error, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		delivery.UserID, delivery.ChannelID, delivery.ChannelType, delivery.AlertID, delivery.Status, delivery.Attempts,
+		delivery.Error, delivery.CreatedAt.Unix())
+	if err != nil {
+		return delivery, fmt.Errorf("保存投递记录失败: %v", err)
+	}
+	if delivery.ID, err = result.LastInsertId(); err != nil {
+		return delivery, fmt.Errorf("保存投递记录失败: %v", err)
+	}
+	return delivery, nil
+}
+
+// ListDeliveries 读取用户的投递记录（最新的在前）
+func (s *SQLiteStore) ListDeliveries(userID int64, limit int) ([]model.NotifyDelivery, error) {
+	query := `SELECT id, user_id, channel_id, channel_type, alert_id, status, attempts, error, created_at
+		FROM notify_deliveries WHERE user_id = ? ORDER BY id DESC`
+	args := []interface{}{userID}
+	if limit > 0 {
+		query += ` LIMIT ?`
+		args = append(args, limit)
+	}
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("查询投递记录失败: %v", err)
+	}
+	defer rows.Close()
+
+	deliveries := []model.NotifyDelivery{}
+	for rows.Next() {
+		var delivery model.NotifyDelivery
+		var createdAt int64
+		if err := rows.Scan(&delivery.ID, &delivery.UserID, &delivery.ChannelID, &delivery.ChannelType, &delivery.AlertID,
+			&delivery.Status, &delivery.Attempts, &delivery.Error, &createdAt); err != nil {
+			return nil, fmt.Errorf("读取投递记录失败: %v", err)
+		}
+		delivery.CreatedAt = time.Unix(createdAt, 0)
+		deliveries = append(deliveries, delivery)
+	}
+	return deliveries, rows.Err()
+}
+
+// unixOrZero 可选时间转为 Unix 秒，为空时返回 0
+func unixOrZero(t *time.Time) int64 {
+	if t == nil {
+		return 0
+	}
+	return t.Unix()
+}
+
+// timeOrNil Unix 秒转为可选时间，0 表示为空
+func timeOrNil(sec int64) *time.Time {
+	if sec == 0 {
+		return nil
+	}
+	t := time.Unix(sec, 0)
+	return &t
+}
+
+// Close 关闭数据库
+func (s *SQLiteStore) Close() error {
+	return s.db.Close()
+}
+
+// withTx 在事务中执行
+func (s *SQLiteStore) withTx(fn func(tx *sql.Tx) error) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %v", err)
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("写入数据库失败: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("提交事务失败: %v", err)
+	}
+	return nil
+}
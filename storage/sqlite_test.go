@@ -0,0 +1,123 @@
+package storage
+
+import (
+	"database/sql"
+	"fund/model"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// TestSQLiteStore 测试 SQLite 存储的日内数据、基金列表和历史净值读写
+func TestSQLiteStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fund.db")
+	store, err := OpenSQLite(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 日内数据：同一时间点后写入的覆盖先写入的
+	if err := store.Append(
+		Record{Code: "000001", Name: "华夏成长混合", Date: "2025-06-30", Point: point("14:59", 1.0)},
+		Record{Code: "000001", Name: "华夏成长混合", Date: "2025-07-01", Point: point("10:31", 1.12)},
+		Record{Code: "000001", Name: "华夏成长混合", Date: "2025-07-01", Point: point("10:30", 1.11)},
+		Record{Code: "000001", Name: "华夏成长混合", Date: "2025-07-01",
+			Point: model.IntradayPoint{Time: "10:30", Value: 1.115, Source: "eastmoney", Divergent: true}},
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	fundData, err := store.Day("000001", "2025-07-01")
+	if err != nil || fundData == nil || len(fundData.Data) != 2 {
+		t.Fatalf("❌ 日内数据异常: %+v %v", fundData, err)
+	}
+	if first := fundData.Data[0]; first.Time != "10:30" || first.Value != 1.115 || !first.Divergent || first.Source != "eastmoney" {
+		t.Errorf("❌ 数据点异常: %+v", first)
+	}
+
+	latest, err := store.Latest()
+	if err != nil || len(latest) != 1 || latest["000001"].Date != "2025-07-01" {
+		t.Errorf("❌ 最近交易日数据异常: %+v %v", latest, err)
+	}
+
+	if err := store.Prune("2025-07-01"); err != nil {
+		t.Fatal(err)
+	}
+	if dates, err := store.Dates(); err != nil || len(dates) != 1 || dates[0] != "2025-07-01" {
+		t.Errorf("❌ 清理后交易日异常: %v %v", dates, err)
+	}
+
+	// 基金列表和历史净值
+	funds := []model.FundBasicInfo{
+		{Code: "000001", Name: "华夏成长混合", Type: "混合型-偏股"},
+		{Code: "110022", Name: "易方达消费行业股票", Type: "股票型"},
+	}
+	if err := store.SaveFundList(funds, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	trend := &model.FundTrend{Code: "000001", Name: "华夏成长混合", Data: []model.TrendPoint{
+		{Date: "2025-06-27", Value: 1.101},
+		{Date: "2025-06-30", Value: 1.1112, AccValue: 3.6012, DailyReturn: 0.49,
+			Dividend: &model.Dividend{Cash: 0.05, Text: "每份派现金0.0500元"}},
+	}}
+	if err := store.SaveNAVHistory(trend, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// 重新打开后数据仍在
+	store, err = OpenSQLite(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	loaded, updatedAt, err := store.LoadFundList()
+	if err != nil || len(loaded) != 2 || loaded[1] != funds[1] || updatedAt.IsZero() {
+		t.Errorf("❌ 基金列表异常: %+v %v %v", loaded, updatedAt, err)
+	}
+
+	history, _, err := store.LoadNAVHistory("000001")
+	if err != nil || history == nil || history.Name != "华夏成长混合" || len(history.Data) != 2 || history.Data[1].Value != 1.1112 {
+		t.Errorf("❌ 历史净值异常: %+v %v", history, err)
+	}
+	if last := history.Data[len(history.Data)-1]; last.AccValue != 3.6012 || last.DailyReturn != 0.49 ||
+		last.Dividend == nil || last.Dividend.Cash != 0.05 || history.Data[0].Dividend != nil {
+		t.Errorf("❌ 累计净值和分红信息异常: %+v", history.Data)
+	}
+	if missing, _, err := store.LoadNAVHistory("110022"); err != nil || missing != nil {
+		t.Errorf("❌ 未保存的基金应返回 nil: %+v %v", missing, err)
+	}
+}
+
+// TestSQLiteMigration 测试打开旧版本数据库时补齐新增的列
+func TestSQLiteMigration(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fund.db")
+	db, err := sql.Open("sqlite", "file:"+path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`CREATE TABLE nav_history (code TEXT NOT NULL, date TEXT NOT NULL, value REAL NOT NULL,
+		PRIMARY KEY (code, date)) WITHOUT ROWID;
+		INSERT INTO nav_history (code, date, value) VALUES ('000001', '2025-06-30', 1.1112)`); err != nil {
+		t.Fatal(err)
+	}
+	db.Close()
+
+	store, err := OpenSQLite(path)
+	if err != nil {
+		t.Fatalf("❌ 打开旧版本数据库失败: %v", err)
+	}
+	defer store.Close()
+
+	trend := &model.FundTrend{Code: "000001", Data: []model.TrendPoint{{Date: "2025-07-01", Value: 1.12, AccValue: 3.61}}}
+	if err := store.SaveNAVHistory(trend, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	history, _, err := store.LoadNAVHistory("000001")
+	if err != nil || len(history.Data) != 2 || history.Data[0].Value != 1.1112 || history.Data[1].AccValue != 3.61 {
+		t.Errorf("❌ 升级后数据异常: %+v %v", history, err)
+	}
+}
@@ -0,0 +1,145 @@
+// Package storage 数据持久化存储（基金列表、历史净值、日内数据、持仓、用户、自选、提醒、通知）
+package storage
+
+import (
+	"errors"
+	"fund/model"
+	"time"
+)
+
+// ErrUserExists 用户名已存在
+var ErrUserExists = errors.New("用户名已存在")
+
+// LegacyUserID 单用户版本的持仓数据在分配给管理员之前的归属（不对应任何用户），见 PortfolioStore.ClaimLegacyPortfolio
+const LegacyUserID int64 = 0
+
+// Record 一条日内数据写入记录
+type Record struct {
+	Code  string              `json:"c"` // 基金代码
+	Name  string              `json:"n"` // 基金名称
+	Date  string              `json:"d"` // 交易日 YYYY-MM-DD
+	Point model.IntradayPoint `json:"p"` // 数据点
+}
+
+// IntradayStore 日内数据存储引擎
+// 同一基金、同一交易日、同一时间点的数据以最后一次写入为准
+type IntradayStore interface {
+	// Append 追加写入数据点
+	Append(records ...Record) error
+	// Day 读取指定基金某个交易日的数据，按时间升序，无数据时返回 nil
+	Day(code, date string) (*model.FundIntradayData, error)
+	// Latest 读取最近一个交易日的数据（用于启动时恢复内存状态）
+	Latest() (map[string]*model.FundIntradayData, error)
+	// Dates 已有数据的交易日（升序）
+	Dates() ([]string, error)
+	// Sync 将已写入的数据刷到磁盘
+	Sync() error
+	// Compact 合并预写日志到分段文件
+	Compact() error
+	// Prune 删除早于 before（YYYY-MM-DD）的数据
+	Prune(before string) error
+	// Close 关闭存储
+	Close() error
+}
+
+// FundStore 基金列表和历史净值存储
+type FundStore interface {
+	// SaveFundList 保存全量基金列表（替换旧列表），updatedAt 为获取时间
+	SaveFundList(funds []model.FundBasicInfo, updatedAt time.Time) error
+	// LoadFundList 读取基金列表及其更新时间，无数据时返回空列表和零值时间
+	LoadFundList() ([]model.FundBasicInfo, time.Time, error)
+	// SaveNAVHistory 保存基金历史净值（按日期合并，同一日期覆盖），updatedAt 为刷新时间
+	SaveNAVHistory(trend *model.FundTrend, updatedAt time.Time) error
+	// LoadNAVHistory 读取基金历史净值及其更新时间，无数据时返回 nil
+	LoadNAVHistory(code string) (*model.FundTrend, time.Time, error)
+}
+
+// PortfolioStore 持仓和交易记录存储（按用户隔离）
+type PortfolioStore interface {
+	// ListHoldings 读取用户的全部持仓（按基金代码升序）
+	ListHoldings(userID int64) ([]model.Holding, error)
+	// GetHolding 读取用户单只基金的持仓，不存在时返回 nil
+	GetHolding(userID int64, code string) (*model.Holding, error)
+	// SaveHolding 新增或覆盖持仓
+	SaveHolding(userID int64, holding model.Holding) error
+	// DeleteHolding 删除持仓及其交易记录
+	DeleteHolding(userID int64, code string) error
+	// RecordTransaction 追加交易记录并保存更新后的持仓（原子操作），返回分配了 ID 的记录
+	RecordTransaction(userID int64, tx model.Transaction, holding model.Holding) (model.Transaction, error)
+	// ListTransactions 按记录顺序读取交易记录，code 为空时返回全部
+	ListTransactions(userID int64, code string) ([]model.Transaction, error)
+	// ClaimLegacyPortfolio 将单用户版本的持仓和交易记录分配给用户，没有旧数据或该用户已有数据时不迁移，返回是否迁移
+	ClaimLegacyPortfolio(userID int64) (bool, error)
+}
+
+// UserStore 用户和访问令牌存储
+type UserStore interface {
+	// CreateUser 创建用户并分配 ID，用户名（不区分大小写）已存在时返回 ErrUserExists
+	CreateUser(user model.User) (model.User, error)
+	// CountUsers 用户数量
+	CountUsers() (int, error)
+	// GetUser 按 ID 读取用户，不存在时返回 nil
+	GetUser(id int64) (*model.User, error)
+	// GetUserByName 按用户名（不区分大小写）读取用户，不存在时返回 nil
+	GetUserByName(username string) (*model.User, error)
+	// CreateToken 保存令牌并分配 ID
+	CreateToken(token model.APIToken) (model.APIToken, error)
+	// GetTokenByHash 按令牌哈希读取令牌，不存在时返回 nil
+	GetTokenByHash(hash string) (*model.APIToken, error)
+	// ListTokens 读取用户的全部令牌（按创建顺序）
+	ListTokens(userID int64) ([]model.APIToken, error)
+	// DeleteToken 删除用户的令牌，返回是否存在
+	DeleteToken(userID, id int64) (bool, error)
+	// TouchToken 更新令牌的最近使用时间
+	TouchToken(id int64, usedAt time.Time) error
+	// DeleteExpiredTokens 删除用户在 now 之前已过期的令牌，返回删除数量
+	DeleteExpiredTokens(userID int64, now time.Time) (int, error)
+}
+
+// WatchlistStore 用户自选分组存储
+type WatchlistStore interface {
+	// ListWatchlists 读取用户的全部分组（按 Position、ID 升序）
+	ListWatchlists(userID int64) ([]model.Watchlist, error)
+	// GetWatchlist 读取用户的分组，不存在时返回 nil
+	GetWatchlist(userID, id int64) (*model.Watchlist, error)
+	// SaveWatchlist 新增（ID 为 0 时分配 ID）或修改用户已有的分组
+	SaveWatchlist(userID int64, watchlist model.Watchlist) (model.Watchlist, error)
+	// DeleteWatchlist 删除用户的分组，返回是否存在
+	DeleteWatchlist(userID, id int64) (bool, error)
+	// AllWatchCodes 所有用户所有分组中基金代码的并集（升序）
+	AllWatchCodes() ([]string, error)
+}
+
+// AlertStore 提醒规则和已触发提醒存储
+type AlertStore interface {
+	// ListAlertRules 读取用户的全部规则（按 ID 升序）
+	ListAlertRules(userID int64) ([]model.AlertRule, error)
+	// GetAlertRule 读取用户的规则，不存在时返回 nil
+	GetAlertRule(userID, id int64) (*model.AlertRule, error)
+	// SaveAlertRule 新增（ID 为 0 时分配 ID）或修改 rule.UserID 已有的规则
+	SaveAlertRule(rule model.AlertRule) (model.AlertRule, error)
+	// DeleteAlertRule 删除用户的规则，返回是否存在（已触发的提醒保留）
+	DeleteAlertRule(userID, id int64) (bool, error)
+	// EnabledAlertRules 所有用户已启用的规则（按 ID 升序）
+	EnabledAlertRules() ([]model.AlertRule, error)
+	// RecordAlert 保存触发的提醒并分配 ID，同一规则同一交易日已有提醒时不保存并返回 false
+	RecordAlert(alert model.Alert) (model.Alert, bool, error)
+	// ListAlerts 读取用户的提醒（最新的在前），date 非空时只返回该交易日，limit <= 0 表示不限
+	ListAlerts(userID int64, date string, limit int) ([]model.Alert, error)
+}
+
+// NotifyStore 提醒通知渠道和投递记录存储
+type NotifyStore interface {
+	// ListChannels 读取用户的全部通知渠道（按 ID 升序）
+	ListChannels(userID int64) ([]model.NotifyChannel, error)
+	// GetChannel 读取用户的通知渠道，不存在时返回 nil
+	GetChannel(userID, id int64) (*model.NotifyChannel, error)
+	// SaveChannel 新增（ID 为 0 时分配 ID）或修改 channel.UserID 已有的通知渠道
+	SaveChannel(channel model.NotifyChannel) (model.NotifyChannel, error)
+	// DeleteChannel 删除用户的通知渠道，返回是否存在（投递记录保留）
+	DeleteChannel(userID, id int64) (bool, error)
+	// RecordDelivery 保存投递记录并分配 ID
+	RecordDelivery(delivery model.NotifyDelivery) (model.NotifyDelivery, error)
+	// ListDeliveries 读取用户的投递记录（最新的在前），limit <= 0 表示不限
+	ListDeliveries(userID int64, limit int) ([]model.NotifyDelivery, error)
+}
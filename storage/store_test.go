@@ -0,0 +1,35 @@
+package storage
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+// forEachStore 分别以文件存储和 SQLite 存储运行同一组测试
+// newFile 在数据目录下创建文件存储；test 中的 open 打开存储，再次调用时关闭当前存储并在同一位置重新打开，用于模拟重启
+func forEachStore[S any](t *testing.T, newFile func(dir string) S, test func(t *testing.T, open func() S)) {
+	t.Run("file", func(t *testing.T) {
+		dir := t.TempDir()
+		test(t, func() S { return newFile(dir) })
+	})
+	t.Run("sqlite", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "fund.db")
+		var current *SQLiteStore
+		t.Cleanup(func() {
+			if current != nil {
+				current.Close()
+			}
+		})
+		test(t, func() S {
+			if current != nil {
+				current.Close()
+			}
+			store, err := OpenSQLite(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			current = store
+			return any(store).(S)
+		})
+	})
+}
@@ -0,0 +1,538 @@
+package storage
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"fund/model"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 目录结构:
+//
+//	<dir>/wal.log                    预写日志，每行一条 Record（JSON）
+//	<dir>/YYYY-MM-DD/<基金代码>.seg   分段文件，首行为段头，其后每行一个 IntradayPoint（JSON）
+//
+// 写入只追加到预写日志，同时保存在内存中；日志超过阈值或显式调用 Compact 时，
+// 按基金、交易日归并追加到分段文件，然后清空日志。分段文件中重复时间点的行数
+// 超过有效数据点时整体重写一次，因此每个数据点的写放大有上界。
+
+const (
+	walFileName   = "wal.log"
+	segmentSuffix = ".seg"
+
+	// DefaultCompactThreshold 默认预写日志合并阈值（字节）
+	DefaultCompactThreshold = 8 << 20
+)
+
+// segmentKey 分段文件标识
+type segmentKey struct {
+	date string
+	code string
+}
+
+// segmentHeader 分段文件首行
+type segmentHeader struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+	Date string `json:"date"`
+}
+
+// TSDB 基于预写日志 + 按基金分段文件的日内数据存储引擎
+type TSDB struct {
+	mu               sync.Mutex
+	dir              string
+	wal              *os.File
+	walSize          int64
+	compactThreshold int64
+	pending          map[segmentKey][]Record // 尚未合并到分段文件的记录（与预写日志内容一致）
+}
+
+// OpenTSDB 打开（或创建）存储目录，回放预写日志恢复未合并的数据
+// compactThreshold 为预写日志合并阈值（字节），小于等于0时使用默认值
+func OpenTSDB(dir string, compactThreshold int64) (*TSDB, error) {
+	if compactThreshold <= 0 {
+		compactThreshold = DefaultCompactThreshold
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("创建存储目录失败: %v", err)
+	}
+
+	db := &TSDB{
+		dir:              dir,
+		compactThreshold: compactThreshold,
+		pending:          make(map[segmentKey][]Record),
+	}
+	if err := db.replayWAL(); err != nil {
+		return nil, err
+	}
+
+	wal, err := os.OpenFile(db.walPath(), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("打开预写日志失败: %v", err)
+	}
+	db.wal = wal
+
+	return db, nil
+}
+
+// walPath 预写日志路径
+func (db *TSDB) walPath() string {
+	return filepath.Join(db.dir, walFileName)
+}
+
+// segmentPath 分段文件路径
+func (db *TSDB) segmentPath(key segmentKey) string {
+	return filepath.Join(db.dir, key.date, key.code+segmentSuffix)
+}
+
+// replayWAL 回放预写日志
+// 进程崩溃可能留下写了一半的最后一行，遇到无法解析的行时截断日志
+func (db *TSDB) replayWAL() error {
+	data, err := os.ReadFile(db.walPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("读取预写日志失败: %v", err)
+	}
+
+	var offset int64
+	count := 0
+	for len(data) > 0 {
+		end := bytes.IndexByte(data, '\n')
+		if end < 0 {
+			break // 未写完的最后一行
+		}
+
+		var record Record
+		if err := json.Unmarshal(data[:end], &record); err != nil || !validRecord(record) {
+			break
+		}
+		key := segmentKey{date: record.Date, code: record.Code}
+		db.pending[key] = append(db.pending[key], record)
+
+		offset += int64(end + 1)
+		data = data[end+1:]
+		count++
+	}
+
+	if len(data) > 0 {
+		log.Printf("⚠️  预写日志在偏移 %d 处损坏, 丢弃 %d 字节", offset, len(data))
+		if err := os.Truncate(db.walPath(), offset); err != nil {
+			return fmt.Errorf("截断预写日志失败: %v", err)
+		}
+	}
+	db.walSize = offset
+
+	if count > 0 {
+		log.Printf("♻️  从预写日志恢复了 %d 条日内数据", count)
+	}
+	return nil
+}
+
+// validRecord 校验记录字段，避免非法代码、日期生成异常的文件路径
+func validRecord(record Record) bool {
+	if record.Code == "" || strings.ContainsAny(record.Code, `/\.`) {
+		return false
+	}
+	_, err := time.Parse("2006-01-02", record.Date)
+	return err == nil
+}
+
+// Append 追加写入数据点
+func (db *TSDB) Append(records ...Record) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	for _, record := range records {
+		if !validRecord(record) {
+			return fmt.Errorf("非法的日内数据记录: 基金 %q 日期 %q", record.Code, record.Date)
+		}
+		line, err := json.Marshal(record)
+		if err != nil {
+			return fmt.Errorf("编码日内数据失败: %v", err)
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if db.wal == nil {
+		return fmt.Errorf("存储已关闭")
+	}
+	if _, err := db.wal.Write(buf.Bytes()); err != nil {
+		// 回滚写了一半的内容，避免后续记录接在损坏的行之后
+		db.wal.Truncate(db.walSize)
+		return fmt.Errorf("写入预写日志失败: %v", err)
+	}
+	db.walSize += int64(buf.Len())
+
+	for _, record := range records {
+		key := segmentKey{date: record.Date, code: record.Code}
+		db.pending[key] = append(db.pending[key], record)
+	}
+
+	if db.walSize >= db.compactThreshold {
+		return db.compactLocked()
+	}
+	return nil
+}
+
+// Sync 将预写日志刷到磁盘
+func (db *TSDB) Sync() error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if db.wal == nil {
+		return nil
+	}
+	return db.wal.Sync()
+}
+
+// Compact 合并预写日志到分段文件
+func (db *TSDB) Compact() error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return db.compactLocked()
+}
+
+// compactLocked 合并预写日志（调用方需持有锁）
+// 所有分段文件写入并同步后才清空日志；中途失败时日志保留，重放产生的重复数据点读取时去重
+func (db *TSDB) compactLocked() error {
+	if len(db.pending) == 0 {
+		return nil
+	}
+
+	start := time.Now()
+	var appended, rewritten int
+	for key, records := range db.pending {
+		rewrite, err := db.writeSegment(key, records)
+		if err != nil {
+			return err
+		}
+		if rewrite {
+			rewritten++
+		} else {
+			appended++
+		}
+	}
+
+	if db.wal != nil {
+		if err := db.wal.Truncate(0); err != nil {
+			return fmt.Errorf("清空预写日志失败: %v", err)
+		}
+	} else if err := os.Truncate(db.walPath(), 0); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("清空预写日志失败: %v", err)
+	}
+	db.walSize = 0
+	db.pending = make(map[segmentKey][]Record)
+
+	log.Printf("🗜️  日内数据合并完成: 追加 %d 个分段, 重写 %d 个分段, 耗时 %v", appended, rewritten, time.Since(start))
+	return nil
+}
+
+// writeSegment 将记录写入分段文件，重复行过多时整体重写
+// 返回是否进行了重写
+func (db *TSDB) writeSegment(key segmentKey, records []Record) (bool, error) {
+	path := db.segmentPath(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return false, fmt.Errorf("创建分段目录失败: %v", err)
+	}
+
+	header, points, lines, clean, err := readSegment(path)
+	if err != nil {
+		return false, err
+	}
+	headerChanged := false
+	if name := records[len(records)-1].Name; name != "" && name != header.Name {
+		header.Name = name
+		headerChanged = true
+	}
+	header.Code, header.Date = key.code, key.date
+
+	for _, record := range records {
+		points = append(points, record.Point)
+	}
+	merged := mergePoints(points)
+
+	// 新文件、重复行超过有效数据点、段头变化或文件末尾不完整时整体重写
+	if lines+len(records) > 2*len(merged) || headerChanged || !clean {
+		return true, writeSegmentFile(path, header, merged)
+	}
+
+	// 追加写入
+	var buf bytes.Buffer
+	for _, record := range records {
+		line, err := json.Marshal(record.Point)
+		if err != nil {
+			return false, fmt.Errorf("编码日内数据失败: %v", err)
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return false, fmt.Errorf("打开分段文件失败: %v", err)
+	}
+	defer file.Close()
+	if _, err := file.Write(buf.Bytes()); err != nil {
+		return false, fmt.Errorf("写入分段文件失败: %v", err)
+	}
+	if err := file.Sync(); err != nil {
+		return false, fmt.Errorf("同步分段文件失败: %v", err)
+	}
+	return false, nil
+}
+
+// writeSegmentFile 重写分段文件（先写临时文件再原子替换）
+func writeSegmentFile(path string, header segmentHeader, points []model.IntradayPoint) error {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	if err := encoder.Encode(header); err != nil {
+		return fmt.Errorf("编码分段头失败: %v", err)
+	}
+	for _, point := range points {
+		if err := encoder.Encode(point); err != nil {
+			return fmt.Errorf("编码日内数据失败: %v", err)
+		}
+	}
+
+	tmpFile := path + ".tmp"
+	file, err := os.Create(tmpFile)
+	if err != nil {
+		return fmt.Errorf("创建临时文件失败: %v", err)
+	}
+	if _, err := file.Write(buf.Bytes()); err != nil {
+		file.Close()
+		os.Remove(tmpFile)
+		return fmt.Errorf("写入分段文件失败: %v", err)
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		os.Remove(tmpFile)
+		return fmt.Errorf("同步分段文件失败: %v", err)
+	}
+	file.Close()
+
+	if err := os.Rename(tmpFile, path); err != nil {
+		os.Remove(tmpFile)
+		return fmt.Errorf("替换分段文件失败: %v", err)
+	}
+	return nil
+}
+
+// readSegment 读取分段文件，返回段头、数据点（未去重）、数据行数，以及文件是否完整
+// 文件不存在时返回空数据且视为不完整；损坏的行（如崩溃时写了一半的行）会被跳过
+func readSegment(path string) (segmentHeader, []model.IntradayPoint, int, bool, error) {
+	var header segmentHeader
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return header, nil, 0, false, nil
+		}
+		return header, nil, 0, false, fmt.Errorf("读取分段文件失败: %v", err)
+	}
+
+	clean := len(data) > 0 && data[len(data)-1] == '\n'
+	lines := bytes.Split(bytes.TrimSuffix(data, []byte{'\n'}), []byte{'\n'})
+	if err := json.Unmarshal(lines[0], &header); err != nil {
+		// 段头损坏时整个文件不可用，重写时只保留新数据
+		log.Printf("⚠️  分段文件 %s 段头损坏: %v", path, err)
+		return header, nil, 0, false, nil
+	}
+
+	points := make([]model.IntradayPoint, 0, len(lines)-1)
+	for _, line := range lines[1:] {
+		var point model.IntradayPoint
+		if err := json.Unmarshal(line, &point); err != nil {
+			clean = false
+			continue
+		}
+		points = append(points, point)
+	}
+
+	return header, points, len(lines) - 1, clean, nil
+}
+
+// mergePoints 按时间去重（后写入的覆盖先写入的）并升序排列
+func mergePoints(points []model.IntradayPoint) []model.IntradayPoint {
+	index := make(map[string]int, len(points))
+	merged := make([]model.IntradayPoint, 0, len(points))
+	for _, point := range points {
+		if i, exists := index[point.Time]; exists {
+			merged[i] = point
+			continue
+		}
+		index[point.Time] = len(merged)
+		merged = append(merged, point)
+	}
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].Time < merged[j].Time
+	})
+	return merged
+}
+
+// Day 读取指定基金某个交易日的数据
+func (db *TSDB) Day(code, date string) (*model.FundIntradayData, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return db.dayLocked(segmentKey{date: date, code: code})
+}
+
+// dayLocked 读取分段文件并合并未落盘的记录（调用方需持有锁）
+func (db *TSDB) dayLocked(key segmentKey) (*model.FundIntradayData, error) {
+	if !validRecord(Record{Code: key.code, Date: key.date}) {
+		return nil, nil
+	}
+
+	header, points, _, _, err := readSegment(db.segmentPath(key))
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range db.pending[key] {
+		points = append(points, record.Point)
+		if record.Name != "" {
+			header.Name = record.Name
+		}
+	}
+	if len(points) == 0 {
+		return nil, nil
+	}
+
+	return &model.FundIntradayData{
+		Code: key.code,
+		Name: header.Name,
+		Date: key.date,
+		Data: mergePoints(points),
+	}, nil
+}
+
+// Latest 读取最近一个交易日的数据
+// 每只基金取其在最近一个交易日或预写日志中最新一天的数据
+func (db *TSDB) Latest() (map[string]*model.FundIntradayData, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	latest := make(map[string]string) // 基金代码 -> 交易日
+	for key := range db.pending {
+		if key.date > latest[key.code] {
+			latest[key.code] = key.date
+		}
+	}
+
+	dates, err := db.datesLocked()
+	if err != nil {
+		return nil, err
+	}
+	if len(dates) > 0 {
+		lastDate := dates[len(dates)-1]
+		entries, err := os.ReadDir(filepath.Join(db.dir, lastDate))
+		if err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("读取存储目录失败: %v", err)
+		}
+		for _, entry := range entries {
+			code, ok := strings.CutSuffix(entry.Name(), segmentSuffix)
+			if ok && lastDate > latest[code] {
+				latest[code] = lastDate
+			}
+		}
+	}
+
+	result := make(map[string]*model.FundIntradayData, len(latest))
+	for code, date := range latest {
+		fundData, err := db.dayLocked(segmentKey{date: date, code: code})
+		if err != nil {
+			return nil, err
+		}
+		if fundData != nil {
+			result[code] = fundData
+		}
+	}
+	return result, nil
+}
+
+// Dates 已有数据的交易日（升序）
+func (db *TSDB) Dates() ([]string, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return db.datesLocked()
+}
+
+// datesLocked 已有数据的交易日，包含尚未合并的数据（调用方需持有锁）
+func (db *TSDB) datesLocked() ([]string, error) {
+	entries, err := os.ReadDir(db.dir)
+	if err != nil {
+		return nil, fmt.Errorf("读取存储目录失败: %v", err)
+	}
+
+	seen := make(map[string]bool)
+	for _, entry := range entries {
+		if _, err := time.Parse("2006-01-02", entry.Name()); entry.IsDir() && err == nil {
+			seen[entry.Name()] = true
+		}
+	}
+	for key := range db.pending {
+		seen[key.date] = true
+	}
+
+	dates := make([]string, 0, len(seen))
+	for date := range seen {
+		dates = append(dates, date)
+	}
+	sort.Strings(dates)
+	return dates, nil
+}
+
+// Prune 删除早于 before 的数据
+// 先合并预写日志，避免被删除的数据在重放时重新出现
+func (db *TSDB) Prune(before string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if err := db.compactLocked(); err != nil {
+		return err
+	}
+
+	dates, err := db.datesLocked()
+	if err != nil {
+		return err
+	}
+	for _, date := range dates {
+		if date >= before {
+			break
+		}
+		if err := os.RemoveAll(filepath.Join(db.dir, date)); err != nil {
+			return fmt.Errorf("删除过期数据失败: %v", err)
+		}
+		log.Printf("🗑️  已删除过期日内数据: %s", date)
+	}
+	return nil
+}
+
+// Close 合并预写日志并关闭存储
+func (db *TSDB) Close() error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if db.wal == nil {
+		return nil
+	}
+	err := db.compactLocked()
+	if closeErr := db.wal.Close(); err == nil {
+		err = closeErr
+	}
+	db.wal = nil
+	return err
+}
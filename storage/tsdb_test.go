@@ -0,0 +1,158 @@
+package storage
+
+import (
+	"fmt"
+	"fund/model"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// point 构造数据点
+func point(hhmm string, value float64) model.IntradayPoint {
+	return model.IntradayPoint{Time: hhmm, Value: value}
+}
+
+// TestTSDBRecovery 测试未合并的数据在重新打开后从预写日志恢复，写了一半的行被丢弃
+func TestTSDBRecovery(t *testing.T) {
+	dir := t.TempDir()
+	db, err := OpenTSDB(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Append(
+		Record{Code: "000001", Name: "华夏成长混合", Date: "2025-07-01", Point: point("10:30", 1.11)},
+		Record{Code: "000001", Name: "华夏成长混合", Date: "2025-07-01", Point: point("10:31", 1.12)},
+		Record{Code: "110022", Name: "易方达消费行业股票", Date: "2025-07-01", Point: point("10:30", 3.2)},
+	); err != nil {
+		t.Fatal(err)
+	}
+	// 模拟进程崩溃：不调用 Close，并在日志末尾留下写了一半的记录
+	db.wal.Write([]byte(`{"c":"000001","n":"华夏成长混合","d":"2025-07-01","p":{"ti`))
+	db.wal.Close()
+
+	db, err = OpenTSDB(dir, 0)
+	if err != nil {
+		t.Fatalf("❌ 重新打开失败: %v", err)
+	}
+	defer db.Close()
+
+	fundData, err := db.Day("000001", "2025-07-01")
+	if err != nil || fundData == nil || len(fundData.Data) != 2 || fundData.Name != "华夏成长混合" {
+		t.Fatalf("❌ 恢复数据异常: %+v %v", fundData, err)
+	}
+
+	// 截断后可以继续正常写入
+	if err := db.Append(Record{Code: "000001", Date: "2025-07-01", Point: point("10:32", 1.13)}); err != nil {
+		t.Fatal(err)
+	}
+	db.wal.Close()
+	db, err = OpenTSDB(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if fundData, _ := db.Day("000001", "2025-07-01"); fundData == nil || len(fundData.Data) != 3 {
+		t.Errorf("❌ 截断后写入的数据丢失: %+v", fundData)
+	}
+}
+
+// TestTSDBCompaction 测试合并后去重、重复写入时分段文件大小有界
+func TestTSDBCompaction(t *testing.T) {
+	dir := t.TempDir()
+	db, err := OpenTSDB(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	// 同一时间点反复写入（采集周期短于1分钟时的常见情况）
+	for round := 0; round < 50; round++ {
+		for minute := 0; minute < 10; minute++ {
+			record := Record{
+				Code:  "000001",
+				Name:  "华夏成长混合",
+				Date:  "2025-07-01",
+				Point: point(fmt.Sprintf("10:%02d", 30+minute), float64(round)),
+			}
+			if err := db.Append(record); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := db.Compact(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fundData, err := db.Day("000001", "2025-07-01")
+	if err != nil || len(fundData.Data) != 10 {
+		t.Fatalf("❌ 合并后数据异常: %+v %v", fundData, err)
+	}
+	for _, p := range fundData.Data {
+		if p.Value != 49 {
+			t.Errorf("❌ 应保留最后一次写入的值: %+v", p)
+		}
+	}
+
+	// 分段文件行数不超过有效数据点的2倍（加上段头）
+	data, err := os.ReadFile(filepath.Join(dir, "2025-07-01", "000001.seg"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lines := strings.Count(string(data), "\n"); lines > 2*10+1 {
+		t.Errorf("❌ 分段文件重复行过多: %d 行", lines)
+	}
+
+	// 合并后预写日志被清空
+	if info, err := os.Stat(filepath.Join(dir, walFileName)); err != nil || info.Size() != 0 {
+		t.Errorf("❌ 合并后预写日志未清空: %v %v", info, err)
+	}
+}
+
+// TestTSDBLatestAndPrune 测试恢复最近交易日数据和过期清理
+func TestTSDBLatestAndPrune(t *testing.T) {
+	dir := t.TempDir()
+	db, err := OpenTSDB(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	records := []Record{
+		{Code: "000001", Date: "2025-06-30", Point: point("14:59", 1.0)},
+		{Code: "110022", Date: "2025-06-30", Point: point("14:59", 3.0)},
+	}
+	if err := db.Append(records...); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Compact(); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Append(Record{Code: "000001", Date: "2025-07-01", Point: point("10:30", 1.1)}); err != nil {
+		t.Fatal(err)
+	}
+
+	latest, err := db.Latest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if latest["000001"] == nil || latest["000001"].Date != "2025-07-01" {
+		t.Errorf("❌ 000001 应恢复到 2025-07-01: %+v", latest["000001"])
+	}
+	if latest["110022"] != nil {
+		t.Errorf("❌ 110022 最近交易日无数据, 不应恢复: %+v", latest["110022"])
+	}
+
+	if err := db.Prune("2025-07-01"); err != nil {
+		t.Fatal(err)
+	}
+	dates, err := db.Dates()
+	if err != nil || len(dates) != 1 || dates[0] != "2025-07-01" {
+		t.Errorf("❌ 清理后交易日异常: %v %v", dates, err)
+	}
+
+	if err := db.Append(Record{Code: "../x", Date: "2025-07-01"}); err == nil {
+		t.Error("❌ 非法基金代码应拒绝写入")
+	}
+}
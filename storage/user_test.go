@@ -0,0 +1,101 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"fund/model"
+	"testing"
+	"time"
+)
+
+// TestUserStore 测试文件和 SQLite 两种用户存储
+func TestUserStore(t *testing.T) {
+	forEachStore(t, func(dir string) UserStore { return NewFileUserStore(dir) }, func(t *testing.T, open func() UserStore) {
+		testUserStore(t, open())
+	})
+}
+
+// testUserStore 用户存储的通用测试
+func testUserStore(t *testing.T, store UserStore) {
+	now := time.Date(2025, 7, 1, 10, 30, 0, 0, time.UTC)
+
+	alice, err := store.CreateUser(model.User{Username: "Alice", PasswordHash: "hash-a", Admin: true, CreatedAt: now})
+	if err != nil || alice.ID == 0 {
+		t.Fatalf("❌ 创建用户失败: %+v %v", alice, err)
+	}
+	if _, err := store.CreateUser(model.User{Username: "alice", PasswordHash: "hash-b", CreatedAt: now}); !errors.Is(err, ErrUserExists) {
+		t.Errorf("❌ 用户名不区分大小写重复应返回 ErrUserExists: %v", err)
+	}
+	bob, err := store.CreateUser(model.User{Username: "bob", PasswordHash: "hash-b", CreatedAt: now})
+	if err != nil || bob.ID == alice.ID {
+		t.Fatalf("❌ 创建用户失败: %+v %v", bob, err)
+	}
+	if count, err := store.CountUsers(); err != nil || count != 2 {
+		t.Errorf("❌ 用户数量异常: %d %v", count, err)
+	}
+
+	user, err := store.GetUserByName("ALICE")
+	if err != nil || user == nil || user.ID != alice.ID || user.PasswordHash != "hash-a" || !user.Admin || !user.CreatedAt.Equal(now) {
+		t.Fatalf("❌ 按用户名读取异常: %+v %v", user, err)
+	}
+	if user, err := store.GetUser(bob.ID); err != nil || user == nil || user.Username != "bob" || user.Admin {
+		t.Errorf("❌ 按 ID 读取异常: %+v %v", user, err)
+	}
+	if user, err := store.GetUser(999); err != nil || user != nil {
+		t.Errorf("❌ 不存在的用户应返回 nil: %+v %v", user, err)
+	}
+
+	expires := now.Add(time.Hour)
+	token, err := store.CreateToken(model.APIToken{UserID: alice.ID, Name: "login", Prefix: "fund_abc", TokenHash: "h1", CreatedAt: now, ExpiresAt: &expires})
+	if err != nil || token.ID == 0 {
+		t.Fatalf("❌ 保存令牌失败: %+v %v", token, err)
+	}
+	if _, err := store.CreateToken(model.APIToken{UserID: bob.ID, Name: "ci", Prefix: "fund_def", TokenHash: "h2", CreatedAt: now}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.TouchToken(token.ID, now.Add(time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	found, err := store.GetTokenByHash("h1")
+	if err != nil || found == nil || found.UserID != alice.ID || found.TokenHash != "h1" ||
+		found.ExpiresAt == nil || !found.ExpiresAt.Equal(expires) || found.LastUsedAt == nil || !found.LastUsedAt.Equal(now.Add(time.Minute)) {
+		t.Fatalf("❌ 按哈希读取令牌异常: %+v %v", found, err)
+	}
+	if found, err := store.GetTokenByHash("missing"); err != nil || found != nil {
+		t.Errorf("❌ 不存在的令牌应返回 nil: %+v %v", found, err)
+	}
+
+	if tokens, err := store.ListTokens(alice.ID); err != nil || len(tokens) != 1 || tokens[0].Name != "login" {
+		t.Errorf("❌ 令牌列表异常: %+v %v", tokens, err)
+	}
+	if deleted, err := store.DeleteToken(bob.ID, token.ID); err != nil || deleted {
+		t.Errorf("❌ 不能删除其他用户的令牌: %v %v", deleted, err)
+	}
+	if deleted, err := store.DeleteToken(alice.ID, token.ID); err != nil || !deleted {
+		t.Errorf("❌ 删除令牌失败: %v %v", deleted, err)
+	}
+	if found, _ := store.GetTokenByHash("h1"); found != nil {
+		t.Errorf("❌ 删除后仍能读取令牌: %+v", found)
+	}
+
+	// 只删除该用户已过期的令牌，长期有效的令牌保留
+	expired := now.Add(-time.Minute)
+	for i, expiresAt := range []*time.Time{&expired, &expires, nil} {
+		if _, err := store.CreateToken(model.APIToken{UserID: alice.ID, Name: "login", Prefix: "fund_x", TokenHash: fmt.Sprintf("h%d", i+3), CreatedAt: now, ExpiresAt: expiresAt}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := store.CreateToken(model.APIToken{UserID: bob.ID, Name: "login", Prefix: "fund_y", TokenHash: "h6", CreatedAt: now, ExpiresAt: &expired}); err != nil {
+		t.Fatal(err)
+	}
+	if deleted, err := store.DeleteExpiredTokens(alice.ID, now); err != nil || deleted != 1 {
+		t.Errorf("❌ 清理过期令牌异常: %d %v", deleted, err)
+	}
+	if tokens, _ := store.ListTokens(alice.ID); len(tokens) != 2 {
+		t.Errorf("❌ 未过期的令牌应保留: %+v", tokens)
+	}
+	if found, _ := store.GetTokenByHash("h6"); found == nil {
+		t.Error("❌ 不应删除其他用户的令牌")
+	}
+}
@@ -0,0 +1,73 @@
+package storage
+
+import (
+	"fund/model"
+	"testing"
+	"time"
+)
+
+// TestWatchlistStore 测试文件和 SQLite 两种自选分组存储
+func TestWatchlistStore(t *testing.T) {
+	forEachStore(t, func(dir string) WatchlistStore { return NewFileWatchlistStore(dir) }, func(t *testing.T, open func() WatchlistStore) {
+		testWatchlistStore(t, open())
+	})
+}
+
+// testWatchlistStore 自选分组存储的通用测试
+func testWatchlistStore(t *testing.T, store WatchlistStore) {
+	now := time.Date(2025, 7, 1, 10, 30, 0, 0, time.UTC)
+
+	tech, err := store.SaveWatchlist(1, model.Watchlist{Name: "科技", Position: 1, Codes: []string{"161725", "000001"}, CreatedAt: now, UpdatedAt: now})
+	if err != nil || tech.ID == 0 {
+		t.Fatalf("❌ 新增分组失败: %+v %v", tech, err)
+	}
+	if _, err := store.SaveWatchlist(1, model.Watchlist{Name: "消费", Position: 0, Codes: []string{"110022"}, CreatedAt: now, UpdatedAt: now}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.SaveWatchlist(2, model.Watchlist{Name: "默认", Codes: []string{"000001", "519674"}, CreatedAt: now, UpdatedAt: now}); err != nil {
+		t.Fatal(err)
+	}
+
+	watchlists, err := store.ListWatchlists(1)
+	if err != nil || len(watchlists) != 2 || watchlists[0].Name != "消费" || watchlists[1].Codes[0] != "161725" {
+		t.Fatalf("❌ 分组列表应按 Position 排序、保留基金顺序: %+v %v", watchlists, err)
+	}
+
+	tech.Codes = []string{"000001", "161725", "003834"}
+	tech.Name = "科技成长"
+	if _, err := store.SaveWatchlist(1, tech); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := store.GetWatchlist(1, tech.ID); err != nil || got == nil || got.Name != "科技成长" ||
+		len(got.Codes) != 3 || got.Codes[2] != "003834" || !got.CreatedAt.Equal(now) {
+		t.Errorf("❌ 修改分组异常: %+v %v", got, err)
+	}
+	if got, err := store.GetWatchlist(2, tech.ID); err != nil || got != nil {
+		t.Errorf("❌ 不能读取其他用户的分组: %+v %v", got, err)
+	}
+	if _, err := store.SaveWatchlist(2, tech); err == nil {
+		t.Error("❌ 不能修改其他用户的分组")
+	}
+
+	codes, err := store.AllWatchCodes()
+	want := []string{"000001", "003834", "110022", "161725", "519674"}
+	if err != nil || len(codes) != len(want) {
+		t.Fatalf("❌ 自选基金并集异常: %v %v", codes, err)
+	}
+	for i := range want {
+		if codes[i] != want[i] {
+			t.Errorf("❌ 自选基金并集 %v, 期望 %v", codes, want)
+			break
+		}
+	}
+
+	if deleted, err := store.DeleteWatchlist(2, tech.ID); err != nil || deleted {
+		t.Errorf("❌ 不能删除其他用户的分组: %v %v", deleted, err)
+	}
+	if deleted, err := store.DeleteWatchlist(1, tech.ID); err != nil || !deleted {
+		t.Errorf("❌ 删除分组失败: %v %v", deleted, err)
+	}
+	if codes, _ := store.AllWatchCodes(); len(codes) != 3 {
+		t.Errorf("❌ 删除分组后并集异常: %v", codes)
+	}
+}